@@ -31,6 +31,14 @@ type InteractiveServiceClient interface {
 	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	GetByIds(ctx context.Context, in *GetByIdsRequest, opts ...grpc.CallOption) (*GetByIdsResponse, error)
+	// IncrCommentCnt 评论数加上 delta，删除评论的时候 delta 是负数
+	IncrCommentCnt(ctx context.Context, in *IncrCommentCntRequest, opts ...grpc.CallOption) (*IncrCommentCntResponse, error)
+	// Delete 删除资源的计数以及点赞、收藏记录，资源被彻底删除的时候调用
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// MergeUser 把 src_uid 的点赞、收藏记录和收藏夹转给 dst_uid，合并账号的时候调用，可以重复调用
+	MergeUser(ctx context.Context, in *MergeUserRequest, opts ...grpc.CallOption) (*MergeUserResponse, error)
+	// ListUserRecords 用户的点赞和收藏记录，导出个人数据的时候调用
+	ListUserRecords(ctx context.Context, in *ListUserRecordsRequest, opts ...grpc.CallOption) (*ListUserRecordsResponse, error)
 }
 
 type interactiveServiceClient struct {
@@ -95,6 +103,42 @@ func (c *interactiveServiceClient) GetByIds(ctx context.Context, in *GetByIdsReq
 	return out, nil
 }
 
+func (c *interactiveServiceClient) IncrCommentCnt(ctx context.Context, in *IncrCommentCntRequest, opts ...grpc.CallOption) (*IncrCommentCntResponse, error) {
+	out := new(IncrCommentCntResponse)
+	err := c.cc.Invoke(ctx, "/intr.v1.InteractiveService/IncrCommentCnt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/intr.v1.InteractiveService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) MergeUser(ctx context.Context, in *MergeUserRequest, opts ...grpc.CallOption) (*MergeUserResponse, error) {
+	out := new(MergeUserResponse)
+	err := c.cc.Invoke(ctx, "/intr.v1.InteractiveService/MergeUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListUserRecords(ctx context.Context, in *ListUserRecordsRequest, opts ...grpc.CallOption) (*ListUserRecordsResponse, error) {
+	out := new(ListUserRecordsResponse)
+	err := c.cc.Invoke(ctx, "/intr.v1.InteractiveService/ListUserRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InteractiveServiceServer is the server API for InteractiveService service.
 // All implementations must embed UnimplementedInteractiveServiceServer
 // for forward compatibility
@@ -108,6 +152,14 @@ type InteractiveServiceServer interface {
 	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error)
+	// IncrCommentCnt 评论数加上 delta，删除评论的时候 delta 是负数
+	IncrCommentCnt(context.Context, *IncrCommentCntRequest) (*IncrCommentCntResponse, error)
+	// Delete 删除资源的计数以及点赞、收藏记录，资源被彻底删除的时候调用
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// MergeUser 把 src_uid 的点赞、收藏记录和收藏夹转给 dst_uid，合并账号的时候调用，可以重复调用
+	MergeUser(context.Context, *MergeUserRequest) (*MergeUserResponse, error)
+	// ListUserRecords 用户的点赞和收藏记录，导出个人数据的时候调用
+	ListUserRecords(context.Context, *ListUserRecordsRequest) (*ListUserRecordsResponse, error)
 	mustEmbedUnimplementedInteractiveServiceServer()
 }
 
@@ -133,6 +185,18 @@ func (UnimplementedInteractiveServiceServer) Get(context.Context, *GetRequest) (
 func (UnimplementedInteractiveServiceServer) GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByIds not implemented")
 }
+func (UnimplementedInteractiveServiceServer) IncrCommentCnt(context.Context, *IncrCommentCntRequest) (*IncrCommentCntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrCommentCnt not implemented")
+}
+func (UnimplementedInteractiveServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedInteractiveServiceServer) MergeUser(context.Context, *MergeUserRequest) (*MergeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeUser not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListUserRecords(context.Context, *ListUserRecordsRequest) (*ListUserRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRecords not implemented")
+}
 func (UnimplementedInteractiveServiceServer) mustEmbedUnimplementedInteractiveServiceServer() {}
 
 // UnsafeInteractiveServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +318,78 @@ func _InteractiveService_GetByIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractiveService_IncrCommentCnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrCommentCntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).IncrCommentCnt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intr.v1.InteractiveService/IncrCommentCnt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).IncrCommentCnt(ctx, req.(*IncrCommentCntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intr.v1.InteractiveService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_MergeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).MergeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intr.v1.InteractiveService/MergeUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).MergeUser(ctx, req.(*MergeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListUserRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListUserRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intr.v1.InteractiveService/ListUserRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListUserRecords(ctx, req.(*ListUserRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InteractiveService_ServiceDesc is the grpc.ServiceDesc for InteractiveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +421,22 @@ var InteractiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByIds",
 			Handler:    _InteractiveService_GetByIds_Handler,
 		},
+		{
+			MethodName: "IncrCommentCnt",
+			Handler:    _InteractiveService_IncrCommentCnt_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _InteractiveService_Delete_Handler,
+		},
+		{
+			MethodName: "MergeUser",
+			Handler:    _InteractiveService_MergeUser_Handler,
+		},
+		{
+			MethodName: "ListUserRecords",
+			Handler:    _InteractiveService_ListUserRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "intr/v1/intr.proto",
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // Interactive 这个是总体交互的计数
 type Interactive struct {
 	Biz        string
@@ -7,8 +9,18 @@ type Interactive struct {
 	ReadCnt    int64 `json:"read_cnt"`
 	LikeCnt    int64 `json:"like_cnt"`
 	CollectCnt int64 `json:"collect_cnt"`
+	CommentCnt int64 `json:"comment_cnt"`
 	// 这个是当下这个资源，你有没有点赞或者收集
 	// 你也可以考虑把这两个字段分离出去，作为一个单独的结构体
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
 }
+
+// UserRecord 用户点赞或者收藏过的资源，导出个人数据的时候用
+type UserRecord struct {
+	Biz   string
+	BizId int64
+	// Cid 收藏夹，点赞记录没有
+	Cid   int64
+	Ctime time.Time
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -72,6 +73,35 @@ func (i InteractiveServiceServer) GetByIds(ctx context.Context, request *intrv1.
 	}, nil
 }
 
+func (i InteractiveServiceServer) IncrCommentCnt(ctx context.Context, request *intrv1.IncrCommentCntRequest) (*intrv1.IncrCommentCntResponse, error) {
+	if request.GetDelta() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "delta 不能为 0")
+	}
+	err := i.svc.IncrCommentCnt(ctx, request.GetBiz(), request.GetBizId(), request.GetDelta())
+	return &intrv1.IncrCommentCntResponse{}, err
+}
+
+func (i InteractiveServiceServer) Delete(ctx context.Context, request *intrv1.DeleteRequest) (*intrv1.DeleteResponse, error) {
+	err := i.svc.Delete(ctx, request.GetBiz(), request.GetBizId())
+	return &intrv1.DeleteResponse{}, err
+}
+
+func (i InteractiveServiceServer) MergeUser(ctx context.Context, request *intrv1.MergeUserRequest) (*intrv1.MergeUserResponse, error) {
+	err := i.svc.MergeUser(ctx, request.GetSrcUid(), request.GetDstUid())
+	return &intrv1.MergeUserResponse{}, err
+}
+
+func (i InteractiveServiceServer) ListUserRecords(ctx context.Context, request *intrv1.ListUserRecordsRequest) (*intrv1.ListUserRecordsResponse, error) {
+	likes, collects, err := i.svc.ListUserRecords(ctx, request.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListUserRecordsResponse{
+		Likes:    slice.Map(likes, i.toRecordDTO),
+		Collects: slice.Map(collects, i.toRecordDTO),
+	}, nil
+}
+
 // DTO data transfer object
 func (i *InteractiveServiceServer) toDTO(intr domain2.Interactive) *intrv1.Interactive {
 	return &intrv1.Interactive{
@@ -82,5 +112,15 @@ func (i *InteractiveServiceServer) toDTO(intr domain2.Interactive) *intrv1.Inter
 		LikeCnt:    intr.LikeCnt,
 		Liked:      intr.Liked,
 		ReadCnt:    intr.ReadCnt,
+		CommentCnt: intr.CommentCnt,
+	}
+}
+
+func (i *InteractiveServiceServer) toRecordDTO(idx int, r domain2.UserRecord) *intrv1.UserRecord {
+	return &intrv1.UserRecord{
+		Biz:   r.Biz,
+		BizId: r.BizId,
+		Cid:   r.Cid,
+		Ctime: r.Ctime.UnixMilli(),
 	}
 }
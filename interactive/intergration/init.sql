@@ -8,6 +8,7 @@ create table if not exists webook.interactives
     read_cnt    bigint       null,
     collect_cnt bigint       null,
     like_cnt    bigint       null,
+    comment_cnt bigint       null,
     ctime       bigint       null,
     utime       bigint       null,
     constraint biz_type_id
@@ -19,6 +19,7 @@ const (
 	fieldReadCnt    = "read_cnt"
 	fieldCollectCnt = "collect_cnt"
 	fieldLikeCnt    = "like_cnt"
+	fieldCommentCnt = "comment_cnt"
 )
 
 //go:generate mockgen -source=./interactive.go -package=cachemocks -destination=mock/interactive.mock.go InteractiveCache
@@ -28,9 +29,12 @@ type InteractiveCache interface {
 	IncrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	DecrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	// IncrCommentCntIfPresent 删除评论的时候 delta 是负数
+	IncrCommentCntIfPresent(ctx context.Context, biz string, bizId int64, delta int64) error
 	// Get 查询缓存中数据
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error
+	Del(ctx context.Context, biz string, bizId int64) error
 }
 
 type RedisInteractiveCache struct {
@@ -70,6 +74,12 @@ func (r *RedisInteractiveCache) IncrCollectCntIfPresent(ctx context.Context, biz
 		fieldCollectCnt, 1).Err()
 }
 
+func (r *RedisInteractiveCache) IncrCommentCntIfPresent(ctx context.Context, biz string, bizId int64, delta int64) error {
+	return r.client.Eval(ctx, luaIncrCnt,
+		[]string{r.key(biz, bizId)},
+		fieldCommentCnt, delta).Err()
+}
+
 func (r *RedisInteractiveCache) Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error) {
 	// 直接使用 HMGet，即便缓存中没有对应的 key，也不会返回 error
 	// 直接使用 HMGet，即便缓存中没有对应的 key，也不会返回 error
@@ -87,11 +97,13 @@ func (r *RedisInteractiveCache) Get(ctx context.Context, biz string, bizId int64
 	collectCnt, _ := strconv.ParseInt(data[fieldCollectCnt], 10, 64)
 	likeCnt, _ := strconv.ParseInt(data[fieldLikeCnt], 10, 64)
 	readCnt, _ := strconv.ParseInt(data[fieldReadCnt], 10, 64)
+	commentCnt, _ := strconv.ParseInt(data[fieldCommentCnt], 10, 64)
 
 	return domain.Interactive{
 		CollectCnt: collectCnt,
 		LikeCnt:    likeCnt,
 		ReadCnt:    readCnt,
+		CommentCnt: commentCnt,
 	}, err
 }
 
@@ -100,13 +112,18 @@ func (r *RedisInteractiveCache) Set(ctx context.Context, biz string, bizId int64
 	err := r.client.HMSet(ctx, key,
 		fieldLikeCnt, intr.LikeCnt,
 		fieldCollectCnt, intr.CollectCnt,
-		fieldReadCnt, intr.ReadCnt).Err()
+		fieldReadCnt, intr.ReadCnt,
+		fieldCommentCnt, intr.CommentCnt).Err()
 	if err != nil {
 		return err
 	}
 	return r.client.Expire(ctx, key, time.Minute*15).Err()
 }
 
+func (r *RedisInteractiveCache) Del(ctx context.Context, biz string, bizId int64) error {
+	return r.client.Del(ctx, r.key(biz, bizId)).Err()
+}
+
 func (r *RedisInteractiveCache) key(biz string, bizId int64) string {
 	return fmt.Sprintf("interactive:%s:%d", biz, bizId)
 }
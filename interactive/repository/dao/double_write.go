@@ -98,6 +98,31 @@ func (d *DoubleWriteDAO) GetByIds(ctx context.Context, biz string, ids []int64)
 	panic("implement me")
 }
 
+func (d *DoubleWriteDAO) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (d *DoubleWriteDAO) Delete(ctx context.Context, biz string, bizId int64) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (d *DoubleWriteDAO) MergeUser(ctx context.Context, srcUid, dstUid int64) ([]Interactive, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (d *DoubleWriteDAO) ListLikes(ctx context.Context, uid int64) ([]UserLikeBiz, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (d *DoubleWriteDAO) ListCollects(ctx context.Context, uid int64) ([]UserCollectionBiz, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func NewDoubleWriteDAOV1(src *gorm.DB, dst *gorm.DB) *DoubleWriteDAO {
 	return &DoubleWriteDAO{src: NewGORMInteractiveDAO(src),
 		pattern: atomicx.NewValueOf(patternSrcOnly),
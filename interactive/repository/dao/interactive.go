@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
@@ -19,6 +20,16 @@ type InteractiveDAO interface {
 	GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (UserCollectionBiz, error)
 	BatchIncrReadCnt(ctx context.Context, bizs []string, ids []int64) error
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]Interactive, error)
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	// Delete 删除计数以及所有用户的点赞和收藏记录
+	Delete(ctx context.Context, biz string, bizId int64) error
+	// MergeUser 把 srcUid 的点赞、收藏记录和收藏夹转给 dstUid
+	// 返回计数变了的资源，只有 Biz 和 BizId
+	MergeUser(ctx context.Context, srcUid, dstUid int64) ([]Interactive, error)
+	// ListLikes 用户有效的点赞记录，最新的在前面
+	ListLikes(ctx context.Context, uid int64) ([]UserLikeBiz, error)
+	// ListCollects 用户的收藏记录，最新的在前面
+	ListCollects(ctx context.Context, uid int64) ([]UserCollectionBiz, error)
 }
 
 type GORMInteractiveDAO struct {
@@ -69,6 +80,28 @@ func (dao GORMInteractiveDAO) IncrReadCnt(ctx context.Context, biz string, bizId
 	}).Error
 }
 
+// IncrCommentCnt 评论数加上 delta，删除评论的时候 delta 是负数
+func (dao GORMInteractiveDAO) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	now := time.Now().UnixMilli()
+	cnt := delta
+	if cnt < 0 {
+		// 第一次插入的时候不可能是删除评论，兜底一下
+		cnt = 0
+	}
+	return dao.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"comment_cnt": gorm.Expr("comment_cnt + ?", delta),
+			"utime":       now,
+		}),
+	}).Create(&Interactive{
+		Biz:        biz,
+		BizId:      bizId,
+		CommentCnt: cnt,
+		Ctime:      now,
+		Utime:      now,
+	}).Error
+}
+
 func (dao GORMInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
 	// 要更新对于该资源的点赞数
 	// 要记录用户对该资源点过赞
@@ -182,6 +215,144 @@ func (dao GORMInteractiveDAO) GetCollectionInfo(ctx context.Context, biz string,
 	return res, err
 }
 
+func (dao GORMInteractiveDAO) Delete(ctx context.Context, biz string, bizId int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("biz = ? AND biz_id = ?", biz, bizId).Delete(&Interactive{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("biz = ? AND biz_id = ?", biz, bizId).Delete(&UserLikeBiz{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("biz = ? AND biz_id = ?", biz, bizId).Delete(&UserCollectionBiz{}).Error
+	})
+}
+
+// MergeUser 两个账号都点赞或者收藏过同一个资源的话，只保留一条记录，计数也要减掉一次
+// 重复调用的时候 srcUid 已经没有记录了，什么都不会做
+func (dao GORMInteractiveDAO) MergeUser(ctx context.Context, srcUid, dstUid int64) ([]Interactive, error) {
+	var changed []Interactive
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		var likes []UserLikeBiz
+		err := tx.Where("uid = ?", srcUid).Find(&likes).Error
+		if err != nil {
+			return err
+		}
+		for _, src := range likes {
+			var dst UserLikeBiz
+			err = tx.Where("biz = ? AND biz_id = ? AND uid = ?", src.Biz, src.BizId, dstUid).
+				First(&dst).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				err = tx.Model(&UserLikeBiz{}).Where("id = ?", src.Id).
+					Updates(map[string]any{
+						"uid":   dstUid,
+						"utime": now,
+					}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if src.Status == 1 {
+				if dst.Status == 1 {
+					// 两个账号都点过赞，点赞数多算了一次
+					err = tx.Model(&Interactive{}).
+						Where("biz = ? AND biz_id = ?", src.Biz, src.BizId).
+						Updates(map[string]any{
+							"like_cnt": gorm.Expr("like_cnt - 1"),
+							"utime":    now,
+						}).Error
+					changed = append(changed, Interactive{Biz: src.Biz, BizId: src.BizId})
+				} else {
+					// 点赞数里面算的是 srcUid 的那一次，记录转给 dstUid 就可以了
+					err = tx.Model(&UserLikeBiz{}).Where("id = ?", dst.Id).
+						Updates(map[string]any{
+							"status": 1,
+							"utime":  now,
+						}).Error
+				}
+				if err != nil {
+					return err
+				}
+			}
+			err = tx.Where("id = ?", src.Id).Delete(&UserLikeBiz{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		var collects []UserCollectionBiz
+		err = tx.Where("uid = ?", srcUid).Find(&collects).Error
+		if err != nil {
+			return err
+		}
+		for _, src := range collects {
+			var cnt int64
+			err = tx.Model(&UserCollectionBiz{}).
+				Where("biz = ? AND biz_id = ? AND uid = ?", src.Biz, src.BizId, dstUid).
+				Count(&cnt).Error
+			if err != nil {
+				return err
+			}
+			if cnt == 0 {
+				err = tx.Model(&UserCollectionBiz{}).Where("id = ?", src.Id).
+					Updates(map[string]any{
+						"uid":   dstUid,
+						"utime": now,
+					}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ?", src.Biz, src.BizId).
+				Updates(map[string]any{
+					"collect_cnt": gorm.Expr("collect_cnt - 1"),
+					"utime":       now,
+				}).Error
+			if err != nil {
+				return err
+			}
+			changed = append(changed, Interactive{Biz: src.Biz, BizId: src.BizId})
+			err = tx.Where("id = ?", src.Id).Delete(&UserCollectionBiz{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		// 收藏夹整个转过去，里面的收藏记录上面已经处理过了
+		return tx.Model(&Collection{}).Where("uid = ?", srcUid).
+			Updates(map[string]any{
+				"uid":   dstUid,
+				"utime": now,
+			}).Error
+	})
+	return changed, err
+}
+
+func (dao GORMInteractiveDAO) ListLikes(ctx context.Context, uid int64) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	err := dao.db.WithContext(ctx).
+		Where("uid = ? AND status = ?", uid, 1).
+		Order("utime DESC").
+		Find(&res).Error
+	return res, err
+}
+
+func (dao GORMInteractiveDAO) ListCollects(ctx context.Context, uid int64) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := dao.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Order("utime DESC").
+		Find(&res).Error
+	return res, err
+}
+
 // Interactive 对于一个biz的交互信息表
 type Interactive struct {
 	Id         int64  `gorm:"primaryKey,autoIncrement"`
@@ -190,6 +361,7 @@ type Interactive struct {
 	ReadCnt    int64
 	LikeCnt    int64
 	CollectCnt int64
+	CommentCnt int64
 	Ctime      int64
 	Utime      int64
 }
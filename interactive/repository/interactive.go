@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"github.com/ecodeclub/ekit/slice"
+	"time"
 	"webookpro/interactive/domain"
 	"webookpro/interactive/repository/cache"
 	"webookpro/interactive/repository/dao"
@@ -22,6 +23,11 @@ type InteractiveRepository interface {
 	BatchIncrReadCnt(ctx context.Context, bizs []string, ids []int64) error
 	AddRecord(ctx context.Context, aid int64, uid int64) error
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error)
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	Delete(ctx context.Context, biz string, bizId int64) error
+	MergeUser(ctx context.Context, srcUid, dstUid int64) error
+	// ListUserRecords 用户的点赞记录和收藏记录
+	ListUserRecords(ctx context.Context, uid int64) ([]domain.UserRecord, []domain.UserRecord, error)
 }
 
 type CachedIntrRepository struct {
@@ -66,6 +72,66 @@ func (c *CachedIntrRepository) IncrReadCnt(ctx context.Context, biz string, bizI
 	return c.cache.IncrReadCntIfPresent(ctx, biz, bizId)
 }
 
+func (c *CachedIntrRepository) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	err := c.dao.IncrCommentCnt(ctx, biz, bizId, delta)
+	if err != nil {
+		return err
+	}
+	return c.cache.IncrCommentCntIfPresent(ctx, biz, bizId, delta)
+}
+
+// Delete 先删数据库再删缓存，删缓存失败的话最多也就是过期之前还能看到旧的计数
+func (c *CachedIntrRepository) Delete(ctx context.Context, biz string, bizId int64) error {
+	err := c.dao.Delete(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.cache.Del(ctx, biz, bizId)
+}
+
+// MergeUser 计数变了的资源直接删缓存，下次查询的时候再从数据库加载
+func (c *CachedIntrRepository) MergeUser(ctx context.Context, srcUid, dstUid int64) error {
+	changed, err := c.dao.MergeUser(ctx, srcUid, dstUid)
+	if err != nil {
+		return err
+	}
+	for _, intr := range changed {
+		er := c.cache.Del(ctx, intr.Biz, intr.BizId)
+		if er != nil {
+			c.l.Error("合并账号之后删除计数缓存失败",
+				logger.String("biz", intr.Biz),
+				logger.Int64("biz_id", intr.BizId),
+				logger.Error(er))
+		}
+	}
+	return nil
+}
+
+func (c *CachedIntrRepository) ListUserRecords(ctx context.Context, uid int64) ([]domain.UserRecord, []domain.UserRecord, error) {
+	likes, err := c.dao.ListLikes(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	collects, err := c.dao.ListCollects(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return slice.Map(likes, func(idx int, src dao.UserLikeBiz) domain.UserRecord {
+			return domain.UserRecord{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Ctime: time.UnixMilli(src.Ctime),
+			}
+		}), slice.Map(collects, func(idx int, src dao.UserCollectionBiz) domain.UserRecord {
+			return domain.UserRecord{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Cid:   src.Cid,
+				Ctime: time.UnixMilli(src.Ctime),
+			}
+		}), nil
+}
+
 func (c *CachedIntrRepository) IncrLike(ctx context.Context, biz string, bizId, uid int64) error {
 	// 先插入点赞 然后更新点赞计数  然后更新缓存
 	err := c.dao.InsertLikeInfo(ctx, biz, bizId, uid)
@@ -146,5 +212,6 @@ func (c *CachedIntrRepository) toDomain(intr dao.Interactive) domain.Interactive
 		LikeCnt:    intr.LikeCnt,
 		CollectCnt: intr.CollectCnt,
 		ReadCnt:    intr.ReadCnt,
+		CommentCnt: intr.CommentCnt,
 	}
 }
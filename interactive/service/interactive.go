@@ -19,6 +19,14 @@ type InteractiveService interface {
 	Collect(ctx context.Context, biz string, bizId, cid, uid int64) error
 	Get(ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error)
 	GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// IncrCommentCnt 评论数加上 delta，删除评论的时候 delta 是负数
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	// Delete 资源被彻底删除之后，计数和点赞、收藏记录也没有意义了
+	Delete(ctx context.Context, biz string, bizId int64) error
+	// MergeUser 合并账号的时候把 srcUid 的点赞、收藏转给 dstUid，可以重复调用
+	MergeUser(ctx context.Context, srcUid, dstUid int64) error
+	// ListUserRecords 用户的点赞记录和收藏记录，导出个人数据的时候用
+	ListUserRecords(ctx context.Context, uid int64) ([]domain.UserRecord, []domain.UserRecord, error)
 }
 
 type interactiveService struct {
@@ -49,6 +57,22 @@ func (i *interactiveService) IncrReadCnt(ctx context.Context, biz string, bizId
 	return i.repo.IncrReadCnt(ctx, biz, bizId)
 }
 
+func (i *interactiveService) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	return i.repo.IncrCommentCnt(ctx, biz, bizId, delta)
+}
+
+func (i *interactiveService) Delete(ctx context.Context, biz string, bizId int64) error {
+	return i.repo.Delete(ctx, biz, bizId)
+}
+
+func (i *interactiveService) MergeUser(ctx context.Context, srcUid, dstUid int64) error {
+	return i.repo.MergeUser(ctx, srcUid, dstUid)
+}
+
+func (i *interactiveService) ListUserRecords(ctx context.Context, uid int64) ([]domain.UserRecord, []domain.UserRecord, error) {
+	return i.repo.ListUserRecords(ctx, uid)
+}
+
 func (i *interactiveService) Like(ctx context.Context, biz string, bizId int64, uid int64) error {
 	return i.repo.IncrLike(ctx, biz, bizId, uid)
 }
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// PersonalData 导出的个人数据，包括资料、自己创作的帖子、点赞、收藏和阅读记录
+type PersonalData struct {
+	User     User
+	Articles []Article
+	Likes    []InteractionRecord
+	Collects []InteractionRecord
+	History  []HistoryRecord
+	// ExportedAt 导出的时间
+	ExportedAt time.Time
+}
+
+// InteractionRecord 点赞或者收藏过的资源
+type InteractionRecord struct {
+	Biz   string
+	BizId int64
+	// Cid 收藏夹，点赞记录没有
+	Cid   int64
+	Ctime time.Time
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+type AccountMergeStatus uint8
+
+const (
+	AccountMergeStatusUnknown AccountMergeStatus = iota
+	// AccountMergeStatusPending 刚创建，本地的数据还没有动
+	AccountMergeStatusPending
+	// AccountMergeStatusMoved 本地的数据已经转过去了，点赞、收藏还没有
+	AccountMergeStatusMoved
+	AccountMergeStatusDone
+	// AccountMergeStatusFailed 本地事务失败了，什么都没有动
+	AccountMergeStatusFailed
+)
+
+func (s AccountMergeStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s AccountMergeStatus) String() string {
+	switch s {
+	case AccountMergeStatusPending:
+		return "pending"
+	case AccountMergeStatusMoved:
+		return "moved"
+	case AccountMergeStatusDone:
+		return "done"
+	case AccountMergeStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// AccountMerge 一次合并账号的记录，Secondary 的数据都转给 Primary
+// 合并之后 Secondary 不能再登录
+type AccountMerge struct {
+	Id        int64
+	Primary   int64
+	Secondary int64
+	Status    AccountMergeStatus
+	// Reason 最近一次失败的原因
+	Reason string
+	Ctime  time.Time
+	Utime  time.Time
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+type ArticleExportStatus uint8
+
+const (
+	ArticleExportStatusUnknown ArticleExportStatus = iota
+	// ArticleExportStatusPending 等着定时任务来打包
+	ArticleExportStatusPending
+	ArticleExportStatusRunning
+	ArticleExportStatusDone
+	ArticleExportStatusFailed
+)
+
+func (s ArticleExportStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+// InProgress 还没有打包完，这个时候不能再发起新的导出
+func (s ArticleExportStatus) InProgress() bool {
+	return s == ArticleExportStatusPending || s == ArticleExportStatusRunning
+}
+
+// ArticleExport 创作者导出全部帖子的任务，打包好的 zip 放在对象存储里面
+type ArticleExport struct {
+	Id     int64
+	Uid    int64
+	Status ArticleExportStatus
+	// Key 对象存储里面的 key，打包完了才有
+	Key string
+	// Cnt 打包了多少篇帖子
+	Cnt   int
+	Size  int64
+	Ctime time.Time
+	Utime time.Time
+}
+
+type ArticleImportStatus uint8
+
+const (
+	ArticleImportStatusCreated ArticleImportStatus = iota + 1
+	// ArticleImportStatusDuplicate 内容和已有的帖子一模一样，跳过了
+	ArticleImportStatusDuplicate
+	ArticleImportStatusFailed
+)
+
+func (s ArticleImportStatus) String() string {
+	switch s {
+	case ArticleImportStatusCreated:
+		return "created"
+	case ArticleImportStatusDuplicate:
+		return "duplicate"
+	case ArticleImportStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// ArticleImportResult 导入的时候每一篇帖子的结果
+type ArticleImportResult struct {
+	File   string
+	Title  string
+	Status ArticleImportStatus
+	// ArticleId 新建的草稿，重复的时候是已有的那篇
+	ArticleId int64
+	// Reason 失败的原因
+	Reason string
+}
@@ -1,6 +1,19 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+	"webookpro/pkg/diffx"
+	"webookpro/pkg/markdownx"
+)
+
+const (
+	// MaxTagCnt 一篇帖子最多这么多个标签
+	MaxTagCnt = 5
+	// MaxTagLen 标签和分类的最大长度，按照字符算
+	MaxTagLen = 20
+)
 
 type Article struct {
 	Id      int64
@@ -8,20 +21,84 @@ type Article struct {
 	Content string
 	Author  Author
 	Status  ArticleStatus
+	// PublishAt 定时发表的时间，只有 ArticleStatusScheduled 状态下才有意义
+	PublishAt time.Time
+	// Category 分类，一篇帖子只有一个分类
+	Category string
+	// Tags 标签，一篇帖子可以有多个
+	Tags []string
+	// HTML 和 TOC 是发表的时候由 Content 渲染出来的，只有线上库有
+	HTML string
+	TOC  []markdownx.Heading
+	// Dtime 删除的时间，只有 ArticleStatusDeleted 状态下才有意义
+	Dtime time.Time
+	// Version 制作库的版本号，每保存一次加一，用来发现并发的修改
+	// 为 0 的时候不检查版本，比如恢复历史版本
+	Version int64
 	Ctime   time.Time
 	Utime   time.Time
 }
 
+// ValidTaxonomy 校验分类和标签，标签要先用 NormalizeTags 处理过
+func (a Article) ValidTaxonomy() bool {
+	if utf8.RuneCountInString(a.Category) > MaxTagLen || len(a.Tags) > MaxTagCnt {
+		return false
+	}
+	for _, tag := range a.Tags {
+		if utf8.RuneCountInString(tag) > MaxTagLen {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeTags 去掉首尾空格、空标签和重复的标签，保持原来的顺序
+func NormalizeTags(tags []string) []string {
+	var res []string
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+	return res
+}
+
+// ArticleCursor 列表按照更新时间倒序翻页的游标，是上一页最后一篇帖子的 Utime 和 Id
+// 零值代表第一页
+type ArticleCursor struct {
+	Utime time.Time
+	Id    int64
+}
+
+func (c ArticleCursor) IsZero() bool {
+	return c.Utime.IsZero() && c.Id == 0
+}
+
+// Cursor 以这篇帖子作为游标，一般是这一页的最后一篇，用来查下一页
+func (a Article) Cursor() ArticleCursor {
+	return ArticleCursor{Utime: a.Utime, Id: a.Id}
+}
+
+// TagCount 某个标签下已发表的帖子数量
+type TagCount struct {
+	Tag string
+	Cnt int64
+}
+
 func (a Article) Abstract() string {
 	// 摘要我们取前几句。
-	// 要考虑一个中文问题
-	cs := []rune(a.Content)
-	if len(cs) < 100 {
-		return a.Content
-	}
+	// Content 是 Markdown，要先去掉语法，不然摘要里面全是 # 和 **
+	// 要考虑一个中文问题，按字截取
 	// 英文怎么截取一个完整的单词，我的看法是……不需要纠结，就截断拉到
 	// 词组、介词，往后找标点符号
-	return string(cs[:100])
+	return markdownx.Summary(a.Content, 100)
 }
 
 type Author struct {
@@ -37,6 +114,14 @@ const (
 	ArticleStatusUnpublished
 	ArticleStatusPublished
 	ArticleStatusPrivate
+	// ArticleStatusScheduled 定时发表，到了 PublishAt 才会同步到线上库
+	ArticleStatusScheduled
+	// ArticleStatusDeleted 在回收站里面，过了保留期限就会被彻底删除
+	ArticleStatusDeleted
+	// ArticleStatusReviewing 发表的时候没有通过自动审核，等管理员人工审核
+	ArticleStatusReviewing
+	// ArticleStatusHidden 作者停用或者注销了账号，只会出现在线上库，恢复账号之后变回 ArticleStatusPublished
+	ArticleStatusHidden
 )
 
 func (s ArticleStatus) ToUint8() uint8 {
@@ -47,6 +132,16 @@ func (s ArticleStatus) NonPublished() bool {
 	return s != ArticleStatusPublished
 }
 
+// Shareable 只有还没有公开的帖子需要分享链接，删除了的帖子不能再分享
+func (s ArticleStatus) Shareable() bool {
+	switch s {
+	case ArticleStatusUnpublished, ArticleStatusPrivate, ArticleStatusScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s ArticleStatus) String() string {
 	switch s {
 	case ArticleStatusPrivate:
@@ -55,7 +150,34 @@ func (s ArticleStatus) String() string {
 		return "unpublished"
 	case ArticleStatusPublished:
 		return "published"
+	case ArticleStatusScheduled:
+		return "scheduled"
+	case ArticleStatusDeleted:
+		return "deleted"
+	case ArticleStatusReviewing:
+		return "reviewing"
+	case ArticleStatusHidden:
+		return "hidden"
 	default:
 		return "unknown"
 	}
 }
+
+// ArticleRevision 帖子的一个历史版本
+// 制作库每保存或者发表一次，就会生成一条，生成之后不会再修改
+type ArticleRevision struct {
+	Id        int64
+	ArticleId int64
+	Title     string
+	Content   string
+	Status    ArticleStatus
+	AuthorId  int64
+	Utime     time.Time
+}
+
+// ArticleRevisionDiff 两个历史版本之间的行级 diff
+type ArticleRevisionDiff struct {
+	From  ArticleRevision
+	To    ArticleRevision
+	Lines []diffx.Line
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// AttachmentURLPrefix 附件对外的地址前缀，不管底下用的是哪种对象存储，地址都不会变
+// 帖子内容里面引用附件用的也是这个地址，保存帖子的时候按照它来找帖子引用了哪些附件
+const AttachmentURLPrefix = "/attachments/files/"
+
+// Attachment 作者上传的图片
+type Attachment struct {
+	Id  int64
+	Uid int64
+	// Key 原图在对象存储里面的 key
+	Key string
+	// ThumbKey 缩略图的 key，原图本来就很小的话，和 Key 是一样的
+	ThumbKey string
+	MimeType string
+	Size     int64
+	Width    int
+	Height   int
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+func (a Attachment) URL() string {
+	return AttachmentURLPrefix + a.Key
+}
+
+func (a Attachment) ThumbURL() string {
+	return AttachmentURLPrefix + a.ThumbKey
+}
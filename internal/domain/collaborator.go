@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// ArticleRole 用户在一篇帖子上的角色，后面的角色包含前面角色的全部权限
+type ArticleRole uint8
+
+const (
+	// ArticleRoleNone 和帖子没有关系
+	ArticleRoleNone ArticleRole = iota
+	// ArticleRoleViewer 能看制作库里面的帖子，不能改
+	ArticleRoleViewer
+	// ArticleRoleEditor 能编辑、发表和撤回
+	ArticleRoleEditor
+	// ArticleRoleOwner 帖子的创作者，只有创作者能管理协作者、删除帖子
+	ArticleRoleOwner
+)
+
+func (r ArticleRole) ToUint8() uint8 {
+	return uint8(r)
+}
+
+// Invitable 只能邀请别人成为编辑或者查看者，创作者只有一个
+func (r ArticleRole) Invitable() bool {
+	return r == ArticleRoleViewer || r == ArticleRoleEditor
+}
+
+func (r ArticleRole) String() string {
+	switch r {
+	case ArticleRoleViewer:
+		return "viewer"
+	case ArticleRoleEditor:
+		return "editor"
+	case ArticleRoleOwner:
+		return "owner"
+	default:
+		return "none"
+	}
+}
+
+// ArticleCollaborator 创作者邀请来一起写帖子的人
+type ArticleCollaborator struct {
+	ArticleId int64
+	Uid       int64
+	Role      ArticleRole
+	Ctime     time.Time
+	Utime     time.Time
+}
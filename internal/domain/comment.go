@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type Comment struct {
+	Id  int64
+	Biz string
+	// 比如说评论的是帖子，那么就是帖子的 ID
+	BizId       int64
+	Commentator Commentator
+	Content     string
+	// RootId 是这条评论所在的根评论，根评论自己是 0
+	RootId int64
+	// ParentId 是直接回复的那条评论，根评论是 0
+	ParentId int64
+	// Replies 根评论下面的前几条回复，只有列表页的根评论才有
+	Replies []Comment
+	// ReplyCnt 根评论下面有多少条回复
+	ReplyCnt int64
+	// Deleted 已经被删除了，但是下面还有回复，所以占个位置
+	Deleted bool
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+// IsRoot 是不是根评论
+func (c Comment) IsRoot() bool {
+	return c.RootId == 0
+}
+
+type Commentator struct {
+	Id int64
+}
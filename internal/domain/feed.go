@@ -0,0 +1,10 @@
+package domain
+
+// FeedKind 对外输出的订阅源种类
+type FeedKind string
+
+const (
+	FeedKindRSS     FeedKind = "rss"
+	FeedKindAtom    FeedKind = "atom"
+	FeedKindSitemap FeedKind = "sitemap"
+)
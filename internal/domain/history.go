@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// HistoryRecord 阅读记录，同一个用户同一篇帖子只有一条，记的是最后一次阅读的时间
+type HistoryRecord struct {
+	Uid      int64
+	Biz      string
+	BizId    int64
+	ReadTime time.Time
+	// Title 资源的标题，展示用的，帖子已经撤回了就是空的
+	Title string
+}
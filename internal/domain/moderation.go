@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// ModerationVerdict 自动审核的结论，越往后越严重
+type ModerationVerdict uint8
+
+const (
+	ModerationPass ModerationVerdict = iota
+	// ModerationReview 拿不准，要管理员人工审核
+	ModerationReview
+	// ModerationBlock 直接拦下来，不能发表
+	ModerationBlock
+)
+
+func (v ModerationVerdict) String() string {
+	switch v {
+	case ModerationPass:
+		return "pass"
+	case ModerationReview:
+		return "review"
+	case ModerationBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// ModerationResult 一次自动审核的结果
+type ModerationResult struct {
+	Verdict ModerationVerdict
+	// Reasons 没有通过的原因，作者能看到
+	Reasons []string
+}
+
+type ArticleReviewStatus uint8
+
+const (
+	ArticleReviewStatusUnknown ArticleReviewStatus = iota
+	// ArticleReviewStatusPending 等管理员审核
+	ArticleReviewStatusPending
+	ArticleReviewStatusApproved
+	ArticleReviewStatusRejected
+	// ArticleReviewStatusBlocked 自动审核直接拦下来的，不需要人工审核
+	ArticleReviewStatusBlocked
+	// ArticleReviewStatusOutdated 审核之前作者又修改或者重新发表了
+	ArticleReviewStatusOutdated
+)
+
+func (s ArticleReviewStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s ArticleReviewStatus) String() string {
+	switch s {
+	case ArticleReviewStatusPending:
+		return "pending"
+	case ArticleReviewStatusApproved:
+		return "approved"
+	case ArticleReviewStatusRejected:
+		return "rejected"
+	case ArticleReviewStatusBlocked:
+		return "blocked"
+	case ArticleReviewStatusOutdated:
+		return "outdated"
+	default:
+		return "unknown"
+	}
+}
+
+// ArticleReview 一次发表的审核记录
+// 每次没有通过自动审核的发表都会生成一条，Title 和 Content 是当时的快照
+type ArticleReview struct {
+	Id        int64
+	ArticleId int64
+	Author    Author
+	Title     string
+	Content   string
+	Status    ArticleReviewStatus
+	// Reasons 自动审核给出的原因
+	Reasons []string
+	// Reviewer 人工审核的管理员
+	Reviewer int64
+	// Reason 管理员驳回的理由
+	Reason string
+	Ctime  time.Time
+	Utime  time.Time
+}
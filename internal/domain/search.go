@@ -0,0 +1,15 @@
+package domain
+
+type ArticleSearchResult struct {
+	// Total 命中的总数，用来分页
+	Total int
+	Hits  []ArticleSearchHit
+}
+
+type ArticleSearchHit struct {
+	Id int64
+	// Title 和 Snippet 已经是高亮过的 HTML 片段
+	Title   string
+	Snippet string
+	Score   float64
+}
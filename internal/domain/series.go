@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// Series 创作者把自己已经发表的帖子组织成的系列，比如一个多篇的教程
+// 一篇帖子最多属于一个系列
+type Series struct {
+	Id          int64
+	Author      Author
+	Title       string
+	Description string
+	// Articles 按照顺序排好的帖子
+	Articles []SeriesArticle
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+// SeriesArticle 系列里面的一篇帖子，只带目录要用的信息
+type SeriesArticle struct {
+	Id     int64
+	Title  string
+	Status ArticleStatus
+}
+
+// Published 只保留还处于发表状态的帖子，撤回了的帖子读者不应该看到
+func (s Series) Published() Series {
+	arts := make([]SeriesArticle, 0, len(s.Articles))
+	for _, art := range s.Articles {
+		if art.Status == ArticleStatusPublished {
+			arts = append(arts, art)
+		}
+	}
+	s.Articles = arts
+	return s
+}
+
+// Neighbors 帖子在系列里面的上一篇和下一篇，没有的话对应的返回值是 nil
+func (s Series) Neighbors(artId int64) (prev *SeriesArticle, next *SeriesArticle) {
+	for i := range s.Articles {
+		if s.Articles[i].Id != artId {
+			continue
+		}
+		if i > 0 {
+			prev = &s.Articles[i-1]
+		}
+		if i < len(s.Articles)-1 {
+			next = &s.Articles[i+1]
+		}
+		return
+	}
+	return
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// ArticleShare 创作者给还没有公开的帖子生成的分享链接，拿到链接的人不用登录就能看
+type ArticleShare struct {
+	Id        int64
+	ArticleId int64
+	Author    Author
+	// MaxViews 最多能看多少次，0 代表不限制
+	MaxViews int64
+	Views    int64
+	Expire   time.Time
+	Revoked  bool
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+// Available 没有撤销、没有过期，次数也还没有用完
+func (s ArticleShare) Available(now time.Time) bool {
+	return !s.Revoked && now.Before(s.Expire) &&
+		(s.MaxViews == 0 || s.Views < s.MaxViews)
+}
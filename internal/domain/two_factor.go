@@ -0,0 +1,10 @@
+package domain
+
+// TwoFactorEnrollment 开启两步验证的时候返回给用户的东西，恢复码只会出现这一次
+type TwoFactorEnrollment struct {
+	// URI otpauth:// 链接，前端转成二维码给认证器 App 扫
+	URI string
+	// Secret 扫不了二维码的时候手动输入
+	Secret        string
+	RecoveryCodes []string
+}
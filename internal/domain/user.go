@@ -9,5 +9,90 @@ type User struct {
 	Ctime    time.Time
 	Phone    string
 	Nickname string
-	Wechat   WeChatInfo
+	// Bio 个人简介
+	Bio string
+	// Birthday 零值就是没有填
+	Birthday time.Time
+	// Avatar 头像的地址，可以是上传的图片，也可以是外部的链接
+	Avatar string
+	Wechat WeChatInfo
+	Status UserStatus
+	// DeleteAt 注销冷静期结束的时间，只有 UserStatusDeleting 状态下才有意义
+	DeleteAt time.Time
+}
+
+// Inactive 停用或者注销中，不能登录，恢复之后才能用
+// 加字段之前缓存里面的用户是 UserStatusUnknown，当成正常的
+func (u User) Inactive() bool {
+	return u.Status == UserStatusDeactivated || u.Status == UserStatusDeleting
+}
+
+// UserStatus 账号的状态，停用和注销中的账号都不能登录，发表的帖子读者也看不到
+type UserStatus uint8
+
+const (
+	UserStatusUnknown UserStatus = iota
+	UserStatusActive
+	// UserStatusDeactivated 用户自己停用的，随时可以恢复
+	UserStatusDeactivated
+	// UserStatusDeleting 申请了注销，冷静期内还可以恢复
+	UserStatusDeleting
+	// UserStatusDeleted 冷静期过了，个人信息已经抹掉，不能再恢复
+	UserStatusDeleted
+)
+
+func (s UserStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s UserStatus) String() string {
+	switch s {
+	case UserStatusActive:
+		return "active"
+	case UserStatusDeactivated:
+		return "deactivated"
+	case UserStatusDeleting:
+		return "deleting"
+	case UserStatusDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// LoginMethod 能用来登录的方式，至少要绑定一种
+type LoginMethod uint8
+
+const (
+	LoginMethodUnknown LoginMethod = iota
+	LoginMethodEmail
+	LoginMethodPhone
+	LoginMethodWechat
+)
+
+func (m LoginMethod) String() string {
+	switch m {
+	case LoginMethodEmail:
+		return "email"
+	case LoginMethodPhone:
+		return "phone"
+	case LoginMethodWechat:
+		return "wechat"
+	default:
+		return "unknown"
+	}
+}
+
+// Bound 有没有绑定这种登录方式
+func (u User) Bound(m LoginMethod) bool {
+	switch m {
+	case LoginMethodEmail:
+		return u.Email != ""
+	case LoginMethodPhone:
+		return u.Phone != ""
+	case LoginMethodWechat:
+		return u.Wechat.OpenID != ""
+	default:
+		return false
+	}
 }
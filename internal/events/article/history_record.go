@@ -3,21 +3,27 @@ package article
 import (
 	"context"
 	"github.com/IBM/sarama"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
 	"webookpro/pkg/logger"
 	"webookpro/pkg/saramax"
 )
 
 type HistoryReadEventConsumer struct {
 	client sarama.Client
+	repo   repository.HistoryRecordRepository
 	l      logger.Logger
 }
 
 func NewHistoryReadEventConsumer(
 	client sarama.Client,
+	repo repository.HistoryRecordRepository,
 	l logger.Logger,
 ) *HistoryReadEventConsumer {
 	return &HistoryReadEventConsumer{
 		client: client,
+		repo:   repo,
 		l:      l,
 	}
 }
@@ -39,10 +45,23 @@ func (r *HistoryReadEventConsumer) Start() error {
 	return err
 }
 
-// Consume 这个不是幂等的
+// Consume 是幂等的，同一篇帖子只留一条记录，阅读时间只会往后挪
 func (r *HistoryReadEventConsumer) Consume(msg *sarama.ConsumerMessage, t ReadEvent) error {
-	//ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	//defer cancel()
-	//return r.repo.AddRecord(ctx, t.Aid, t.Uid)
-	panic("implement me")
+	if t.Uid <= 0 || t.Aid <= 0 {
+		// 没登录的读者，或者是错误的消息，直接丢掉
+		return nil
+	}
+	readTime := time.Now()
+	if t.Time > 0 {
+		// 老版本的消息里面没有阅读时间
+		readTime = time.UnixMilli(t.Time)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return r.repo.AddRecord(ctx, domain.HistoryRecord{
+		Uid:      t.Uid,
+		Biz:      "article",
+		BizId:    t.Aid,
+		ReadTime: readTime,
+	})
 }
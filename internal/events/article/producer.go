@@ -6,8 +6,11 @@ import (
 	"github.com/IBM/sarama"
 )
 
+//go:generate mockgen -source=producer.go -package=evtmocks -destination=mocks/producer.mock.go Producer
 type Producer interface {
 	ProduceReadEvent(ctx context.Context, evt ReadEvent) error
+	// ProducePublishEvent 帖子发表或者撤回之后发出来，搜索之类的下游自己去线上库查最新的数据
+	ProducePublishEvent(ctx context.Context, evt PublishEvent) error
 }
 
 type KafkaProducer struct {
@@ -28,6 +31,18 @@ func (k *KafkaProducer) ProduceReadEvent(ctx context.Context, evt ReadEvent) err
 	return err
 }
 
+func (k *KafkaProducer) ProducePublishEvent(ctx context.Context, evt PublishEvent) error {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = k.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: TopicPublishEvent,
+		Value: sarama.ByteEncoder(data),
+	})
+	return err
+}
+
 func NewKafkaProducer(pc sarama.SyncProducer) Producer {
 	return &KafkaProducer{
 		producer: pc,
@@ -37,4 +52,15 @@ func NewKafkaProducer(pc sarama.SyncProducer) Producer {
 type ReadEvent struct {
 	Uid int64
 	Aid int64
+	// Time 阅读的时间，毫秒数。消息重复或者乱序的时候，以这个时间为准
+	Time int64
+}
+
+const TopicPublishEvent = "article_publish"
+
+type PublishEvent struct {
+	Aid int64
+	Uid int64
+	// Withdrawn 为 true 代表撤回，否则就是发表
+	Withdrawn bool
 }
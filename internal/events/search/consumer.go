@@ -0,0 +1,61 @@
+package search
+
+import (
+	"context"
+	"github.com/IBM/sarama"
+	"os"
+	"time"
+	"webookpro/internal/events/article"
+	"webookpro/internal/service"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/saramax"
+)
+
+// ArticleIndexConsumer 消费帖子发表和撤回的事件，维护搜索索引
+type ArticleIndexConsumer struct {
+	client sarama.Client
+	svc    service.SearchService
+	l      logger.Logger
+}
+
+func NewArticleIndexConsumer(client sarama.Client,
+	svc service.SearchService,
+	l logger.Logger) *ArticleIndexConsumer {
+	return &ArticleIndexConsumer{
+		client: client,
+		svc:    svc,
+		l:      l,
+	}
+}
+
+func (c *ArticleIndexConsumer) Start() error {
+	// 索引在进程内，每个实例都要收到全部的消息，所以每个实例用自己的消费者组
+	host, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	cg, err := sarama.NewConsumerGroupFromClient("search_index_"+host,
+		c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{article.TopicPublishEvent},
+			saramax.NewHandler[article.PublishEvent](c.l, c.Consume))
+		if err != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(err))
+		}
+	}()
+	return err
+}
+
+// Consume 是幂等的，发表的时候总是用线上库最新的数据覆盖索引
+func (c *ArticleIndexConsumer) Consume(msg *sarama.ConsumerMessage, evt article.PublishEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if evt.Withdrawn {
+		return c.svc.RemoveArticle(ctx, evt.Aid)
+	}
+	return c.svc.IndexArticle(ctx, evt.Aid)
+}
@@ -0,0 +1,11 @@
+package startup
+
+import (
+	"webookpro/internal/service/email"
+	"webookpro/internal/service/email/memory"
+	"webookpro/pkg/logger"
+)
+
+func InitEmailService(l logger.Logger) email.Service {
+	return memory.NewService(l)
+}
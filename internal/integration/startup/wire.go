@@ -17,7 +17,6 @@ import (
 	article2 "webookpro/internal/repository/dao/article"
 	"webookpro/internal/service"
 	"webookpro/internal/web"
-	ijwt "webookpro/internal/web/jwt"
 )
 
 var thirdProvider = wire.NewSet(InitDB, InitRDB, ioc.InitLogger)
@@ -40,10 +39,10 @@ func InitWebServer() *gin.Engine {
 		// repo层
 		repository.NewCachedUserRepository, repository.NewCachedCodeRepository,
 		// service 层
-		service.NewUserService, service.NewSMSCodeService,
-		ioc.InitSMSService, ioc.InitWechatService,
+		service.NewUserService, service.NewSMSCodeService, service.NewBindingService,
+		ioc.InitSMSService, ioc.InitWechatService, InitEmailService,
 		// handlers
-		web.NewUserHandler, web.NewOAuth2WechatHandler, ijwt.NewRedisJWTHandler,
+		web.NewUserHandler, web.NewOAuth2WechatHandler, ioc.InitJWTHandler,
 		// middlewares
 		ioc.InitMiddlewares,
 		ioc.InitWebServer,
@@ -57,7 +56,11 @@ func InitArticleHandler(dao article2.ArticleDAO) *web.ArticleHandler {
 		service.NewArticleService,
 		web.NewArticleHandler,
 		article.NewCachedArticleRepository,
+		article.NewGORMReviewRepository,
+		article2.NewGORMReviewDAO,
+		ioc.InitModerator,
 		cache.NewRedisArticleCache,
+		cache.NewRedisFeedCache,
 		interactiveSvcProvider,
 	)
 	return &web.ArticleHandler{}
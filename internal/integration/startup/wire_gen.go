@@ -20,7 +20,6 @@ import (
 	"webookpro/internal/repository/dao/article"
 	"webookpro/internal/service"
 	"webookpro/internal/web"
-	"webookpro/internal/web/jwt"
 )
 
 // Injectors from wire.go:
@@ -28,7 +27,7 @@ import (
 func InitWebServer() *gin.Engine {
 	cmdable := InitRDB()
 	limiter := ioc.InitLimiter(cmdable)
-	jwtHandler := jwt.NewRedisJWTHandler(cmdable)
+	jwtHandler := ioc.InitJWTHandler(cmdable)
 	logger := ioc.InitLogger()
 	v := ioc.InitMiddlewares(limiter, jwtHandler, logger)
 	db := InitDB()
@@ -40,10 +39,12 @@ func InitWebServer() *gin.Engine {
 	codeRepository := repository.NewCachedCodeRepository(codeCache)
 	smsService := ioc.InitSMSService()
 	codeService := service.NewSMSCodeService(codeRepository, smsService)
-	userHandler := web.NewUserHandler(userService, codeService, jwtHandler)
+	userHandler := web.NewUserHandler(userService, codeService, nil, nil, jwtHandler)
 	oauth2Service := ioc.InitWechatService()
-	oAuth2WechatHandler := web.NewOAuth2WechatHandler(oauth2Service, userService, jwtHandler)
-	engine := ioc.InitWebServer(v, userHandler, oAuth2WechatHandler, nil)
+	emailService := InitEmailService(logger)
+	bindingService := service.NewBindingService(userRepository, codeRepository, codeService, emailService, logger)
+	oAuth2WechatHandler := web.NewOAuth2WechatHandler(oauth2Service, userService, bindingService, nil, jwtHandler)
+	engine := ioc.InitWebServer(v, userHandler, oAuth2WechatHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	return engine
 }
 
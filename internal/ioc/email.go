@@ -0,0 +1,35 @@
+package ioc
+
+import (
+	"fmt"
+	"github.com/spf13/viper"
+	"webookpro/internal/service/email"
+	"webookpro/internal/service/email/memory"
+	"webookpro/internal/service/email/smtp"
+	"webookpro/pkg/logger"
+)
+
+// InitEmailService 内存实现只把邮件写到日志里面，只适合本地开发，要显式配置成 memory
+func InitEmailService(l logger.Logger) email.Service {
+	type Config struct {
+		// Type 是 smtp 或者 memory
+		Type     string `yaml:"type"`
+		Addr     string `yaml:"addr"`
+		Username string `yaml:"username"`
+		Password string `yaml:"password"`
+		From     string `yaml:"from"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("email", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	switch cfg.Type {
+	case "smtp":
+		return smtp.NewService(cfg.Addr, cfg.Username, cfg.Password, cfg.From)
+	case "memory":
+		return memory.NewService(l)
+	default:
+		panic(fmt.Sprintf("不支持的邮件服务类型 %q", cfg.Type))
+	}
+}
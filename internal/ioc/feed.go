@@ -0,0 +1,23 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"webookpro/internal/repository/article"
+	"webookpro/internal/service"
+	"webookpro/pkg/logger"
+)
+
+// InitFeedService 没有配置的时候用本地前端的地址
+func InitFeedService(repo article.FeedRepository, artRepo article.ArticleRepository, l logger.Logger) service.FeedService {
+	cfg := service.FeedConfig{
+		Title:       "webook",
+		Description: "webook 最新发表的帖子",
+		Site:        "http://localhost:3000",
+		ArticleURL:  "http://localhost:3000/articles/view?id=%d",
+	}
+	err := viper.UnmarshalKey("feed", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewFeedService(repo, artRepo, cfg, l)
+}
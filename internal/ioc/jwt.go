@@ -0,0 +1,23 @@
+package ioc
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	ijwt "webookpro/internal/web/jwt"
+)
+
+// InitJWTHandler 没有配置的时候一个用户最多同时登录五个设备
+func InitJWTHandler(cmd redis.Cmdable) ijwt.JwtHandler {
+	type Config struct {
+		// MaxSessions 0 代表不限制
+		MaxSessions int `yaml:"maxSessions"`
+	}
+	cfg := Config{
+		MaxSessions: 5,
+	}
+	err := viper.UnmarshalKey("session", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return ijwt.NewRedisJWTHandler(cmd, cfg.MaxSessions)
+}
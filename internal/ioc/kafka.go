@@ -5,6 +5,8 @@ import (
 	"github.com/spf13/viper"
 	events2 "webookpro/interactive/events"
 	"webookpro/internal/events"
+	"webookpro/internal/events/article"
+	"webookpro/internal/events/search"
 )
 
 func InitKafka() sarama.Client {
@@ -34,6 +36,8 @@ func NewSyncProducer(client sarama.Client) sarama.SyncProducer {
 }
 
 // NewConsumers 面临的问题依旧是所有的 Consumer 在这里注册一下
-func NewConsumers(c1 *events2.InteractiveReadEventBatchConsumer) []events.Consumer {
-	return []events.Consumer{c1}
+func NewConsumers(c1 *events2.InteractiveReadEventBatchConsumer,
+	c2 *search.ArticleIndexConsumer,
+	c3 *article.HistoryReadEventConsumer) []events.Consumer {
+	return []events.Consumer{c1, c2, c3}
 }
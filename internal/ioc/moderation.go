@@ -0,0 +1,34 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"webookpro/internal/domain"
+	"webookpro/internal/service/moderation"
+	"webookpro/internal/service/moderation/chain"
+	"webookpro/internal/service/moderation/links"
+	"webookpro/internal/service/moderation/memory"
+	"webookpro/internal/service/moderation/words"
+	"webookpro/pkg/logger"
+)
+
+// InitModerator 先查本地的词库和链接黑名单，都过了再交给第三方审核
+// 第三方审核服务还没有接入，先用内存实现占位，全部通过
+func InitModerator(l logger.Logger) moderation.Checker {
+	type Config struct {
+		// BlockWords 出现了就不能发表
+		BlockWords []string `yaml:"blockWords"`
+		// ReviewWords 出现了要人工审核
+		ReviewWords    []string `yaml:"reviewWords"`
+		BlockedDomains []string `yaml:"blockedDomains"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("moderation", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return chain.NewChecker(l,
+		words.NewChecker(domain.ModerationBlock, cfg.BlockWords),
+		links.NewChecker(domain.ModerationBlock, cfg.BlockedDomains),
+		words.NewChecker(domain.ModerationReview, cfg.ReviewWords),
+		memory.NewChecker())
+}
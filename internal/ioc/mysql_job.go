@@ -5,19 +5,84 @@ import (
 	"time"
 	"webookpro/internal/domain"
 	"webookpro/internal/job"
+	"webookpro/internal/repository"
 	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
 	"webookpro/pkg/logger"
 )
 
+func InitJobService(repo repository.JobRepository, l logger.Logger) service.JobService {
+	return service.NewCronJobService(repo, time.Minute, l)
+}
+
 func InitScheduler(l logger.Logger,
 	local *job.LocalFuncExecutor,
 	svc service.JobService) *job.Scheduler {
 	res := job.NewScheduler(svc, l)
 	res.RegisterExecutor(local)
+	// 定时发表每分钟扫一次，同名任务已经存在的话不会重复插入
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	err := svc.AddJob(ctx, domain.Job{
+		Name:     "scheduled_publish",
+		Executor: local.Name(),
+		Cron:     "* * * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 每个小时清理一次没有帖子引用的附件
+	err = svc.AddJob(ctx, domain.Job{
+		Name:     "attachment_gc",
+		Executor: local.Name(),
+		Cron:     "0 * * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 导出的帖子每分钟打包一次
+	err = svc.AddJob(ctx, domain.Job{
+		Name:     "article_export",
+		Executor: local.Name(),
+		Cron:     "* * * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 每天凌晨清理一次回收站里面过期的帖子
+	err = svc.AddJob(ctx, domain.Job{
+		Name:     "article_purge",
+		Executor: local.Name(),
+		Cron:     "0 4 * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 合并账号的时候点赞、收藏没有转成功的，每分钟补偿一次
+	err = svc.AddJob(ctx, domain.Job{
+		Name:     "account_merge_resume",
+		Executor: local.Name(),
+		Cron:     "* * * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 每天凌晨抹掉一次冷静期已经结束的注销账号
+	err = svc.AddJob(ctx, domain.Job{
+		Name:     "account_purge",
+		Executor: local.Name(),
+		Cron:     "0 3 * * *",
+	})
+	if err != nil {
+		panic(err)
+	}
 	return res
 }
 
-func InitLocalFuncExecutor(svc service.RankingService) *job.LocalFuncExecutor {
+func InitLocalFuncExecutor(svc service.RankingService, artSvc service.ArticleService,
+	attachSvc service.AttachmentService, archiveSvc service.ArchiveService,
+	mergeSvc service.AccountMergeService, accountSvc service.AccountService,
+	jwtHdl ijwt.JwtHandler) *job.LocalFuncExecutor {
 	res := job.NewLocalFuncExecutor()
 	// 要在数据库里面插入一条记录。
 	// ranking job 的记录，通过管理任务接口来插入
@@ -26,5 +91,46 @@ func InitLocalFuncExecutor(svc service.RankingService) *job.LocalFuncExecutor {
 		defer cancel()
 		return svc.TopN(ctx)
 	})
+	res.RegisterFunc("scheduled_publish", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+		defer cancel()
+		return artSvc.PublishDue(ctx)
+	})
+	res.RegisterFunc("attachment_gc", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		return attachSvc.CleanOrphans(ctx)
+	})
+	res.RegisterFunc("article_purge", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		return artSvc.PurgeDeleted(ctx)
+	})
+	res.RegisterFunc("article_export", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+		defer cancel()
+		return archiveSvc.RunExports(ctx)
+	})
+	res.RegisterFunc("account_merge_resume", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		return mergeSvc.Resume(ctx)
+	})
+	res.RegisterFunc("account_purge", func(ctx context.Context, j domain.Job) error {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		uids, err := accountSvc.PurgeDeleted(ctx)
+		if err != nil {
+			return err
+		}
+		// 注销的时候已经清过一次，这里再兜个底
+		for _, uid := range uids {
+			err = jwtHdl.ClearSessions(ctx, uid)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	return res
 }
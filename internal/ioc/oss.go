@@ -0,0 +1,82 @@
+package ioc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/ecodeclub/ekit"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"os"
+	"webookpro/internal/repository/dao/article"
+	"webookpro/pkg/ossx"
+	"webookpro/pkg/ossx/memory"
+	s3x "webookpro/pkg/ossx/s3"
+)
+
+type ossConfig struct {
+	// Type 是 s3 或者 memory，不配置就是不用对象存储，内容还是放在数据库里面
+	Type     string `yaml:"type"`
+	Endpoint string `yaml:"endpoint"`
+	Region   string `yaml:"region"`
+	Bucket   string `yaml:"bucket"`
+}
+
+// InitOSS 线上库和附件共用一个对象存储
+// 没有配置的话，用内存实现，只适合本地开发，重启之后附件就没了
+func InitOSS() ossx.Store {
+	cfg := ossCfg()
+	if cfg.Type == "" {
+		return memory.NewStore()
+	}
+	return initOSS(cfg)
+}
+
+// InitArticleDAO 配置了对象存储，线上库的内容就放到对象存储里面
+func InitArticleDAO(db *gorm.DB, store ossx.Store) article.ArticleDAO {
+	if ossCfg().Type == "" {
+		return article.NewGORMArticleDAO(db)
+	}
+	return article.NewS3ArticleDAO(store, db)
+}
+
+func ossCfg() ossConfig {
+	var cfg ossConfig
+	err := viper.UnmarshalKey("oss", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+func initOSS(cfg ossConfig) ossx.Store {
+	switch cfg.Type {
+	case "memory":
+		// 本地开发用的，重启之后内容就没了
+		return memory.NewStore()
+	case "s3":
+		// 密钥不要放在配置文件里面
+		id, ok := os.LookupEnv("COS_APP_ID")
+		if !ok {
+			panic("没有找到环境变量 COS_APP_ID ")
+		}
+		key, ok := os.LookupEnv("COS_APP_SECRET")
+		if !ok {
+			panic("没有找到环境变量 COS_APP_SECRET")
+		}
+		sess, err := session.NewSession(&aws.Config{
+			Credentials: credentials.NewStaticCredentials(id, key, ""),
+			Region:      ekit.ToPtr[string](cfg.Region),
+			Endpoint:    ekit.ToPtr[string](cfg.Endpoint),
+			// 强制使用 /bucket/key 的形态，MinIO 之类的本地替身也要这样
+			S3ForcePathStyle: ekit.ToPtr[bool](true),
+		})
+		if err != nil {
+			panic(err)
+		}
+		return s3x.NewStore(s3.New(sess), cfg.Bucket)
+	default:
+		panic("未知的对象存储类型 " + cfg.Type)
+	}
+}
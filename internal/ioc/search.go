@@ -0,0 +1,30 @@
+package ioc
+
+import (
+	"context"
+	"time"
+	"webookpro/internal/repository/article"
+	"webookpro/internal/service"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/searchx"
+	"webookpro/pkg/searchx/memory"
+)
+
+func InitSearchIndex() searchx.Index {
+	return memory.NewInvertedIndex()
+}
+
+func InitSearchService(idx searchx.Index, repo article.ArticleRepository, l logger.Logger) service.SearchService {
+	svc := service.NewArticleSearchService(idx, repo, l)
+	// 进程内的索引启动的时候是空的，要从线上库重建一遍
+	// 重建期间搜索结果不全，但是不影响启动
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*10)
+		defer cancel()
+		err := svc.Rebuild(ctx)
+		if err != nil {
+			l.Error("重建搜索索引失败", logger.Error(err))
+		}
+	}()
+	return svc
+}
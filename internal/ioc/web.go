@@ -6,9 +6,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"strings"
 	"time"
+	"webookpro/internal/domain"
 	"webookpro/internal/web"
 	ijwt "webookpro/internal/web/jwt"
 	"webookpro/internal/web/middleware"
@@ -23,7 +25,23 @@ import (
 func InitWebServer(middlewares []gin.HandlerFunc,
 	userHdl *web.UserHandler,
 	wechatHdl *web.OAuth2WechatHandler,
-	articleHdl *web.ArticleHandler) *gin.Engine {
+	articleHdl *web.ArticleHandler,
+	searchHdl *web.SearchHandler,
+	commentHdl *web.CommentHandler,
+	historyHdl *web.HistoryHandler,
+	attachmentHdl *web.AttachmentHandler,
+	seriesHdl *web.SeriesHandler,
+	shareHdl *web.ShareHandler,
+	collabHdl *web.CollaboratorHandler,
+	feedHdl *web.FeedHandler,
+	archiveHdl *web.ArchiveHandler,
+	reviewHdl *web.ReviewHandler,
+	passwordHdl *web.PasswordHandler,
+	bindingHdl *web.BindingHandler,
+	mergeHdl *web.AccountMergeHandler,
+	accountHdl *web.AccountHandler,
+	twoFactorHdl *web.TwoFactorHandler,
+	sessionHdl *web.SessionHandler) *gin.Engine {
 	server := gin.Default()
 
 	// 注册中间件
@@ -33,6 +51,22 @@ func InitWebServer(middlewares []gin.HandlerFunc,
 	userHdl.RegisterRoutes(server)
 	wechatHdl.RegisterRoutes(server)
 	articleHdl.RegisterRoutes(server)
+	searchHdl.RegisterRoutes(server)
+	commentHdl.RegisterRoutes(server)
+	historyHdl.RegisterRoutes(server)
+	attachmentHdl.RegisterRoutes(server)
+	seriesHdl.RegisterRoutes(server)
+	shareHdl.RegisterRoutes(server)
+	collabHdl.RegisterRoutes(server)
+	feedHdl.RegisterRoutes(server)
+	archiveHdl.RegisterRoutes(server)
+	reviewHdl.RegisterRoutes(server)
+	passwordHdl.RegisterRoutes(server)
+	bindingHdl.RegisterRoutes(server)
+	mergeHdl.RegisterRoutes(server)
+	accountHdl.RegisterRoutes(server)
+	twoFactorHdl.RegisterRoutes(server)
+	sessionHdl.RegisterRoutes(server)
 
 	// 设置session
 	//store := cookie.NewStore([]byte("secret"))
@@ -54,6 +88,7 @@ func InitMiddlewares(limiter limit.Limiter, jwtHdl ijwt.JwtHandler, l logger.Log
 		//logMiddleware(l),
 		corsMiddleware(),
 		jwtMiddleware(jwtHdl),
+		adminMiddleware(),
 		metricsMiddleware(),
 		otelgin.Middleware("webookpro"),
 	}
@@ -84,16 +119,41 @@ func corsMiddleware() gin.HandlerFunc {
 func jwtMiddleware(jwtHdl ijwt.JwtHandler) gin.HandlerFunc {
 	return middleware.NewLoginJWTMiddlewareBuilder(jwtHdl).
 		IgorePath("/users/login").
+		IgorePath("/users/login/2fa").
 		IgorePath("/users/signup").
 		IgorePath("/users/login_sms/code/send").
 		IgorePath("/users/login_sms").
 		IgorePath("/users/refresh_token").
+		IgorePathPrefix("/users/password/reset").
+		IgorePath("/users/account/restore").
 		IgorePath("/oauth2/wechat/authurl").
 		IgorePath("/oauth2/wechat/callback").
-		IgorePath("/articles/edit").
+		IgorePath("/articles/pub/tag").
+		IgorePath("/articles/pub/category").
+		IgorePath("/articles/pub/tags").
+		IgorePath("/articles/pub/search").
+		IgorePathPrefix("/articles/pub/share/").
+		IgorePathPrefix("/feeds/").
+		IgorePath("/sitemap.xml").
+		IgorePath("/comments/list").
+		IgorePath("/comments/replies").
+		IgorePathPrefix(domain.AttachmentURLPrefix).
 		Build()
 }
 
+// adminMiddleware 管理员在配置文件里面指定，没有配置的话谁都不能访问管理后台
+func adminMiddleware() gin.HandlerFunc {
+	type Config struct {
+		Uids []int64 `yaml:"uids"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("admin", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return middleware.NewAdminMiddlewareBuilder(cfg.Uids).Build()
+}
+
 // rateLimitMiddleware 限流中间件
 func rateLimitMiddleware(limiter limit.Limiter) gin.HandlerFunc {
 	return ratelimit.NewBuilder(InitRDB(), limiter).Build()
@@ -0,0 +1,102 @@
+package ioc
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	"webookpro/internal/web"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+// TestArticleEdit_JWT 编辑帖子要经过登录校验，claims 是中间件放进去的
+func TestArticleEdit_JWT(t *testing.T) {
+	const userAgent = "webook-test"
+	testCases := []struct {
+		name    string
+		reqBody string
+		mock    func(ctrl *gomock.Controller) (service.ArticleService, service.CollaboratorService)
+
+		wantCode int
+		wantRes  web.Result
+	}{
+		{
+			name:    "新建帖子",
+			reqBody: `{"title":"我的标题","content":"我的内容"}`,
+			mock: func(ctrl *gomock.Controller) (service.ArticleService, service.CollaboratorService) {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Store(gomock.Any(), domain.Article{
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+				}).Return(int64(1), nil)
+				return artSvc, svcmocks.NewMockCollaboratorService(ctrl)
+			},
+			wantCode: http.StatusOK,
+			wantRes:  web.Result{Code: 2, Msg: "OK", Data: float64(1)},
+		},
+		{
+			name:    "编辑别人的帖子",
+			reqBody: `{"id":5,"title":"我的标题","content":"我的内容","version":1}`,
+			mock: func(ctrl *gomock.Controller) (service.ArticleService, service.CollaboratorService) {
+				collabSvc := svcmocks.NewMockCollaboratorService(ctrl)
+				collabSvc.EXPECT().Authorize(gomock.Any(), int64(123), int64(5), domain.ArticleRoleEditor).
+					Return(domain.Article{}, service.ErrArticleForbidden)
+				return svcmocks.NewMockArticleService(ctrl), collabSvc
+			},
+			wantCode: http.StatusOK,
+			wantRes:  web.Result{Code: 4, Msg: "没有编辑这篇帖子的权限"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS512, ijwt.UserClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				},
+				Uid:       123,
+				Ssid:      "ssid",
+				UserAgent: userAgent,
+			}).SignedString(ijwt.AtKey)
+			require.NoError(t, err)
+			jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+			jwtHdl.EXPECT().ExtractToken(gomock.Any()).Return(token)
+			jwtHdl.EXPECT().CheckSession(gomock.Any(), "ssid").Return(nil)
+
+			artSvc, collabSvc := tc.mock(ctrl)
+			attachSvc := svcmocks.NewMockAttachmentService(ctrl)
+			attachSvc.EXPECT().BindArticle(gomock.Any(), int64(123), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+			server := gin.Default()
+			server.Use(jwtMiddleware(jwtHdl))
+			web.NewArticleHandler(artSvc, attachSvc, nil, collabSvc, nil, &logger.NopLogger{}).
+				RegisterRoutes(server)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/articles/edit", bytes.NewBufferString(tc.reqBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", userAgent)
+			server.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.wantCode, recorder.Code)
+			var res web.Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
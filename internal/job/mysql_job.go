@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
 	"net/http"
 	"time"
 	"webookpro/internal/domain"
@@ -115,7 +116,13 @@ func (s *Scheduler) Schedule(ctx context.Context) error {
 		j, err := s.svc.Preempt(dbCtx)
 		cancel()
 		if err != nil {
-			s.l.Error("抢占任务失败", logger.Error(err))
+			// 没有抢到任务，要把令牌还回去，并且歇一下，不然会一直空转
+			s.limiter.Release(1)
+			if err != gorm.ErrRecordNotFound {
+				s.l.Error("抢占任务失败", logger.Error(err))
+			}
+			time.Sleep(time.Second)
+			continue
 		}
 		// 拿到这个任务的执行器
 		exec, ok := s.execs[j.Executor]
@@ -124,6 +131,8 @@ func (s *Scheduler) Schedule(ctx context.Context) error {
 			// 线上就继续
 			s.l.Error("未找到对应的执行器",
 				logger.String("executor", j.Executor))
+			s.limiter.Release(1)
+			_ = j.CancelFunc()
 			continue
 		}
 		// 任务有了， 执行器有了，接下来考虑怎么执行这个任务
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao"
+)
+
+var (
+	ErrUserStatusConflict    = dao.ErrUserStatusConflict
+	ErrRestoreTicketNotFound = cache.ErrRestoreTicketNotFound
+)
+
+//go:generate mockgen -source=account.go -package=repomocks -destination=mock/account.mock.go AccountRepository
+type AccountRepository interface {
+	// UpdateStatus 当前状态不在 from 里面的话返回 ErrUserStatusConflict
+	UpdateStatus(ctx context.Context, id int64, from []domain.UserStatus, to domain.UserStatus, deleteAt time.Time) error
+	// FindDeleting 冷静期已经结束的注销中账号
+	FindDeleting(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	// Anonymise 抹掉个人信息，缓存里面的也一起删掉
+	Anonymise(ctx context.Context, id int64, now time.Time) error
+	StoreRestoreTicket(ctx context.Context, ticket string, uid int64) error
+	// ConsumeRestoreTicket 凭证只能用一次，不存在的时候返回 ErrRestoreTicketNotFound
+	ConsumeRestoreTicket(ctx context.Context, ticket string) (int64, error)
+}
+
+type CachedAccountRepository struct {
+	dao       dao.AccountDAO
+	cache     cache.AccountCache
+	userCache cache.UserCache
+}
+
+func NewCachedAccountRepository(dao dao.AccountDAO,
+	cache cache.AccountCache,
+	userCache cache.UserCache) AccountRepository {
+	return &CachedAccountRepository{
+		dao:       dao,
+		cache:     cache,
+		userCache: userCache,
+	}
+}
+
+// UpdateStatus 缓存里面的用户带着状态，一样要删掉
+func (repo *CachedAccountRepository) UpdateStatus(ctx context.Context, id int64,
+	from []domain.UserStatus, to domain.UserStatus, deleteAt time.Time) error {
+	var at int64
+	if !deleteAt.IsZero() {
+		at = deleteAt.UnixMilli()
+	}
+	err := repo.dao.UpdateStatus(ctx, id, slice.Map(from, func(idx int, src domain.UserStatus) uint8 {
+		return src.ToUint8()
+	}), to.ToUint8(), at)
+	if err != nil {
+		return err
+	}
+	return repo.userCache.Del(ctx, id)
+}
+
+func (repo *CachedAccountRepository) FindDeleting(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	return repo.dao.FindDeleting(ctx, now.UnixMilli(), limit)
+}
+
+func (repo *CachedAccountRepository) Anonymise(ctx context.Context, id int64, now time.Time) error {
+	err := repo.dao.Anonymise(ctx, id, now.UnixMilli())
+	if err != nil {
+		return err
+	}
+	return repo.userCache.Del(ctx, id)
+}
+
+func (repo *CachedAccountRepository) StoreRestoreTicket(ctx context.Context, ticket string, uid int64) error {
+	return repo.cache.Set(ctx, ticket, uid)
+}
+
+func (repo *CachedAccountRepository) ConsumeRestoreTicket(ctx context.Context, ticket string) (int64, error) {
+	return repo.cache.GetDel(ctx, ticket)
+}
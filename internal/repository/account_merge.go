@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao"
+)
+
+var (
+	ErrMergeTicketNotFound = cache.ErrMergeTicketNotFound
+	ErrUserMerged          = dao.ErrUserMerged
+	ErrMergeTwoFactor      = dao.ErrMergeTwoFactor
+)
+
+//go:generate mockgen -source=account_merge.go -package=repomocks -destination=mock/account_merge.mock.go AccountMergeRepository
+type AccountMergeRepository interface {
+	StoreTicket(ctx context.Context, ticket string, uid int64) error
+	// ConsumeTicket 凭证只能用一次，不存在的时候返回 ErrMergeTicketNotFound
+	ConsumeTicket(ctx context.Context, ticket string) (int64, error)
+	// Create 创建一条待合并的记录，返回 id
+	Create(ctx context.Context, m domain.AccountMerge) (int64, error)
+	// Move 转移本地的数据，成功之后状态是 moved
+	Move(ctx context.Context, m domain.AccountMerge) error
+	UpdateStatus(ctx context.Context, id int64, status domain.AccountMergeStatus, reason string) error
+	// FindByStatus 找 utime 早于 before 的记录
+	FindByStatus(ctx context.Context, status domain.AccountMergeStatus, before time.Time, limit int) ([]domain.AccountMerge, error)
+}
+
+type CachedAccountMergeRepository struct {
+	dao       dao.AccountMergeDAO
+	cache     cache.AccountMergeCache
+	userCache cache.UserCache
+	artCache  cache.ArticleCache
+}
+
+func NewCachedAccountMergeRepository(dao dao.AccountMergeDAO,
+	cache cache.AccountMergeCache,
+	userCache cache.UserCache,
+	artCache cache.ArticleCache) AccountMergeRepository {
+	return &CachedAccountMergeRepository{
+		dao:       dao,
+		cache:     cache,
+		userCache: userCache,
+		artCache:  artCache,
+	}
+}
+
+func (repo *CachedAccountMergeRepository) StoreTicket(ctx context.Context, ticket string, uid int64) error {
+	return repo.cache.Set(ctx, ticket, uid)
+}
+
+func (repo *CachedAccountMergeRepository) ConsumeTicket(ctx context.Context, ticket string) (int64, error) {
+	return repo.cache.GetDel(ctx, ticket)
+}
+
+func (repo *CachedAccountMergeRepository) Create(ctx context.Context, m domain.AccountMerge) (int64, error) {
+	return repo.dao.Insert(ctx, dao.AccountMerge{
+		PrimaryId:   m.Primary,
+		SecondaryId: m.Secondary,
+		Status:      m.Status.ToUint8(),
+		Reason:      m.Reason,
+	})
+}
+
+// Move 两个账号的登录方式和帖子都变了，用户缓存和帖子列表的第一页缓存都删掉
+func (repo *CachedAccountMergeRepository) Move(ctx context.Context, m domain.AccountMerge) error {
+	err := repo.dao.Move(ctx, m.Id, m.Primary, m.Secondary)
+	if err != nil {
+		return err
+	}
+	for _, uid := range []int64{m.Primary, m.Secondary} {
+		_ = repo.userCache.Del(ctx, uid)
+		_ = repo.artCache.DelFirstPage(ctx, uid)
+	}
+	return nil
+}
+
+func (repo *CachedAccountMergeRepository) UpdateStatus(ctx context.Context, id int64, status domain.AccountMergeStatus, reason string) error {
+	return repo.dao.UpdateStatus(ctx, id, status.ToUint8(), reason)
+}
+
+func (repo *CachedAccountMergeRepository) FindByStatus(ctx context.Context, status domain.AccountMergeStatus, before time.Time, limit int) ([]domain.AccountMerge, error) {
+	res, err := repo.dao.FindByStatus(ctx, status.ToUint8(), before.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.AccountMerge) domain.AccountMerge {
+		return repo.toDomain(src)
+	}), nil
+}
+
+func (repo *CachedAccountMergeRepository) toDomain(m dao.AccountMerge) domain.AccountMerge {
+	return domain.AccountMerge{
+		Id:        m.Id,
+		Primary:   m.PrimaryId,
+		Secondary: m.SecondaryId,
+		Status:    domain.AccountMergeStatus(m.Status),
+		Reason:    m.Reason,
+		Ctime:     time.UnixMilli(m.Ctime),
+		Utime:     time.UnixMilli(m.Utime),
+	}
+}
@@ -3,7 +3,6 @@ package article
 import (
 	"context"
 	"github.com/ecodeclub/ekit/slice"
-	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 	"time"
 	"webookpro/internal/domain"
@@ -11,6 +10,15 @@ import (
 	"webookpro/internal/repository/cache"
 	"webookpro/internal/repository/dao/article"
 	"webookpro/pkg/logger"
+	"webookpro/pkg/markdownx"
+)
+
+var (
+	ErrScheduleNotFound = article.ErrScheduleNotFound
+	ErrArticleNotFound  = article.ErrArticleNotFound
+	ErrVersionConflict  = article.ErrVersionConflict
+	// ErrRecordNotFound GetById 和 GetPublishedById 查不到帖子，比如从来没有发表过
+	ErrRecordNotFound = article.ErrRecordNotFound
 )
 
 type ArticleRepository interface {
@@ -19,10 +27,31 @@ type ArticleRepository interface {
 	Sync(ctx context.Context, article domain.Article) (int64, error)
 	SyncV1(ctx context.Context, article domain.Article) (int64, error)
 	SyncStatus(ctx context.Context, art domain.Article, status domain.ArticleStatus) error
+	// SetAuthorPubStatus 停用、注销和恢复账号的时候改作者线上库帖子的状态，返回改了的帖子
+	SetAuthorPubStatus(ctx context.Context, uid int64, ids []int64, from, to domain.ArticleStatus) ([]int64, error)
 	List(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// ListByCursor 游标翻页，不走第一页的缓存
+	ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, artId int64) (domain.Article, error)
-	GetPublishedById(ctx *gin.Context, artId int64) (domain.Article, error)
+	GetPublishedById(ctx context.Context, artId int64) (domain.Article, error)
 	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error)
+	ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	ListRevisions(ctx context.Context, artId int64, uid int64, offset int, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, id int64) (domain.ArticleRevision, error)
+	ListScheduled(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]domain.Article, error)
+	Reschedule(ctx context.Context, artId int64, uid int64, publishAt time.Time) error
+	CancelSchedule(ctx context.Context, artId int64, uid int64) error
+	ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error)
+	ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error)
+	CountPubTags(ctx context.Context, limit int) ([]domain.TagCount, error)
+	// Delete 放进回收站，线上库和缓存里面都删掉
+	Delete(ctx context.Context, artId int64, uid int64) error
+	ListDeleted(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// Restore after 之前删除的帖子已经不能恢复了
+	Restore(ctx context.Context, artId int64, uid int64, after time.Time) error
+	ListPurgeable(ctx context.Context, before time.Time, limit int) ([]domain.Article, error)
+	Purge(ctx context.Context, ids []int64) error
 }
 
 type CachedArticleRepository struct {
@@ -30,6 +59,8 @@ type CachedArticleRepository struct {
 	cache    cache.ArticleCache
 	userRepo repository.UserRepository
 	l        logger.Logger
+	// feedCache 线上库变了，订阅源和 sitemap 都要跟着失效
+	feedCache cache.FeedCache
 
 	// SyncV1  操作两个 DAO
 	authorDAO ArticleAuthorRepository
@@ -39,13 +70,15 @@ type CachedArticleRepository struct {
 
 func NewCachedArticleRepository(dao article.ArticleDAO,
 	cache cache.ArticleCache,
+	feedCache cache.FeedCache,
 	userRepo repository.UserRepository,
 	l logger.Logger) ArticleRepository {
 	return &CachedArticleRepository{
-		dao:      dao,
-		cache:    cache,
-		userRepo: userRepo,
-		l:        l,
+		dao:       dao,
+		cache:     cache,
+		feedCache: feedCache,
+		userRepo:  userRepo,
+		l:         l,
 	}
 }
 
@@ -59,8 +92,73 @@ func (r *CachedArticleRepository) ListPub(ctx context.Context, start time.Time,
 	}), nil
 }
 
+func (r *CachedArticleRepository) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	res, err := r.dao.ListPubCursor(ctx, r.toDAOCursor(cursor), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src article.PublishedArticle) domain.Article {
+		return r.entityPubtoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	res, err := r.dao.GetByAuthorCursor(ctx, uid, r.toDAOCursor(cursor), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src article.Article) domain.Article {
+		return r.entitytoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) toDAOCursor(cursor domain.ArticleCursor) article.Cursor {
+	if cursor.Utime.IsZero() {
+		return article.Cursor{}
+	}
+	return article.Cursor{
+		Utime: cursor.Utime.UnixMilli(),
+		Id:    cursor.Id,
+	}
+}
+
+// ListPubByTag 线上库某个标签下的帖子
+func (r *CachedArticleRepository) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error) {
+	res, err := r.dao.ListPubByTag(ctx, tag, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src article.PublishedArticle) domain.Article {
+		return r.entityPubtoDomain(src)
+	}), nil
+}
+
+// ListPubByCategory 线上库某个分类下的帖子
+func (r *CachedArticleRepository) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error) {
+	res, err := r.dao.ListPubByCategory(ctx, category, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src article.PublishedArticle) domain.Article {
+		return r.entityPubtoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) CountPubTags(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	res, err := r.dao.CountPubTags(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src article.TagCount) domain.TagCount {
+		return domain.TagCount{
+			Tag: src.Tag,
+			Cnt: src.Cnt,
+		}
+	}), nil
+}
+
 // GetPublishedById 获取线上库文章详情
-func (r *CachedArticleRepository) GetPublishedById(ctx *gin.Context, artId int64) (domain.Article, error) {
+func (r *CachedArticleRepository) GetPublishedById(ctx context.Context, artId int64) (domain.Article, error) {
 	art, err := r.dao.GetPubById(ctx, artId)
 	if err != nil {
 		return domain.Article{}, err
@@ -73,11 +171,16 @@ func (r *CachedArticleRepository) GetPublishedById(ctx *gin.Context, artId int64
 		Status:  domain.ArticleStatus(art.Status),
 		Content: art.Content,
 		Author: domain.Author{
-			Id:   usr.Id,
+			// 查不到作者的时候也要带上 id，昵称就空着
+			Id:   art.AuthorId,
 			Name: usr.Nickname,
 		},
-		Ctime: time.UnixMilli(art.Ctime),
-		Utime: time.UnixMilli(art.Utime),
+		Category: art.Category,
+		Tags:     art.Tags,
+		HTML:     art.Html,
+		TOC:      r.tocToDomain(art.Toc),
+		Ctime:    time.UnixMilli(art.Ctime),
+		Utime:    time.UnixMilli(art.Utime),
 	}
 	return res, nil
 }
@@ -127,13 +230,37 @@ func (r *CachedArticleRepository) List(ctx context.Context, uid int64, offset in
 
 // SyncStatus 同步帖子状态
 func (r *CachedArticleRepository) SyncStatus(ctx context.Context, art domain.Article, status domain.ArticleStatus) error {
-	return r.dao.SyncStatus(ctx, r.domainToEntity(art), status)
+	err := r.dao.SyncStatus(ctx, r.domainToEntity(art), status)
+	if err == nil {
+		r.delFeeds(ctx, art.Author.Id)
+	}
+	return err
+}
+
+// SetAuthorPubStatus 订阅源、sitemap 和帖子缓存里面都可能还有这些帖子
+func (r *CachedArticleRepository) SetAuthorPubStatus(ctx context.Context, uid int64, ids []int64,
+	from, to domain.ArticleStatus) ([]int64, error) {
+	res, err := r.dao.SetAuthorPubStatus(ctx, uid, ids, from, to)
+	if err != nil || len(res) == 0 {
+		return res, err
+	}
+	r.delFeeds(ctx, uid)
+	for _, id := range res {
+		er := r.cache.Del(ctx, id)
+		if er != nil {
+			r.l.Error("删除帖子缓存失败",
+				logger.Int64("art_id", id),
+				logger.Error(er))
+		}
+	}
+	return res, nil
 }
 
 // Sync 帖子发表接口同步数据
 func (r *CachedArticleRepository) Sync(ctx context.Context, article domain.Article) (int64, error) {
-	id, err := r.dao.Sync(ctx, r.domainToEntity(article))
+	id, err := r.dao.Sync(ctx, r.domainToPubEntity(article))
 	if err == nil {
+		r.delFeeds(ctx, article.Author.Id)
 		err = r.cache.DelFirstPage(ctx, article.Author.Id)
 		if err != nil {
 			r.l.Error("删除第一页帖子缓存失败", logger.Error(err))
@@ -173,9 +300,7 @@ func (r *CachedArticleRepository) SyncV1(ctx context.Context, art domain.Article
 		return artId, err
 	}
 	// upsert 线上库
-	err = readerDAO.Upsert(ctx, article.PublishedArticle{
-		Article: r.domainToEntity(art),
-	})
+	err = readerDAO.Upsert(ctx, r.domainToPubEntity(art))
 	if err != nil {
 		return artId, err
 	}
@@ -205,6 +330,131 @@ func (r *CachedArticleRepository) Update(ctx context.Context, article domain.Art
 	return r.dao.UpdateById(ctx, r.domainToEntity(article))
 }
 
+// ListRevisions 创作者查看某篇帖子的历史版本
+func (r *CachedArticleRepository) ListRevisions(ctx context.Context, artId int64, uid int64, offset int, limit int) ([]domain.ArticleRevision, error) {
+	revs, err := r.dao.ListRevisions(ctx, artId, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(revs, func(idx int, src article.ArticleRevision) domain.ArticleRevision {
+		return r.revisionToDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) GetRevision(ctx context.Context, id int64) (domain.ArticleRevision, error) {
+	rev, err := r.dao.GetRevision(ctx, id)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return r.revisionToDomain(rev), nil
+}
+
+// ListScheduled 创作者的定时发表列表
+func (r *CachedArticleRepository) ListScheduled(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	arts, err := r.dao.ListScheduled(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src article.Article) domain.Article {
+		return r.entitytoDomain(src)
+	}), nil
+}
+
+// ListDueScheduled 已经到点，可以发表的帖子
+func (r *CachedArticleRepository) ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]domain.Article, error) {
+	arts, err := r.dao.ListDueScheduled(ctx, now.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src article.Article) domain.Article {
+		return r.entitytoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) Reschedule(ctx context.Context, artId int64, uid int64, publishAt time.Time) error {
+	err := r.dao.Reschedule(ctx, artId, uid, publishAt.UnixMilli())
+	if err != nil {
+		return err
+	}
+	r.delFirstPage(ctx, uid)
+	return nil
+}
+
+func (r *CachedArticleRepository) CancelSchedule(ctx context.Context, artId int64, uid int64) error {
+	err := r.dao.CancelSchedule(ctx, artId, uid)
+	if err != nil {
+		return err
+	}
+	r.delFirstPage(ctx, uid)
+	return nil
+}
+
+func (r *CachedArticleRepository) Delete(ctx context.Context, artId int64, uid int64) error {
+	err := r.dao.Delete(ctx, artId, uid)
+	if err != nil {
+		return err
+	}
+	r.delFirstPage(ctx, uid)
+	r.delFeeds(ctx, uid)
+	err = r.cache.Del(ctx, artId)
+	if err != nil {
+		r.l.Error("删除帖子缓存失败",
+			logger.Int64("art_id", artId),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (r *CachedArticleRepository) ListDeleted(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	arts, err := r.dao.ListDeleted(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src article.Article) domain.Article {
+		return r.entitytoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) Restore(ctx context.Context, artId int64, uid int64, after time.Time) error {
+	err := r.dao.Restore(ctx, artId, uid, after.UnixMilli())
+	if err != nil {
+		return err
+	}
+	r.delFirstPage(ctx, uid)
+	return nil
+}
+
+func (r *CachedArticleRepository) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]domain.Article, error) {
+	arts, err := r.dao.ListPurgeable(ctx, before.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src article.Article) domain.Article {
+		return r.entitytoDomain(src)
+	}), nil
+}
+
+func (r *CachedArticleRepository) Purge(ctx context.Context, ids []int64) error {
+	return r.dao.Purge(ctx, ids)
+}
+
+// delFirstPage 缓存删除失败只记录日志，不影响业务
+func (r *CachedArticleRepository) delFirstPage(ctx context.Context, uid int64) {
+	err := r.cache.DelFirstPage(ctx, uid)
+	if err != nil {
+		r.l.Error("清空第一页帖子缓存失败", logger.Error(err))
+	}
+}
+
+func (r *CachedArticleRepository) delFeeds(ctx context.Context, uid int64) {
+	err := r.feedCache.Del(ctx, uid)
+	if err != nil {
+		r.l.Error("清空订阅源缓存失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+}
+
 // PreCache 业务预加载，提前加载好第一条数据
 func (r *CachedArticleRepository) PreCache(ctx context.Context, art domain.Article) {
 	err := r.cache.Set(ctx, art)
@@ -214,17 +464,48 @@ func (r *CachedArticleRepository) PreCache(ctx context.Context, art domain.Artic
 }
 
 func (r *CachedArticleRepository) domainToEntity(art domain.Article) article.Article {
-	return article.Article{
+	res := article.Article{
 		Id:       art.Id,
 		Title:    art.Title,
 		Content:  art.Content,
 		AuthorId: art.Author.Id,
 		Status:   art.Status.ToUint8(),
+		Category: art.Category,
+		Tags:     art.Tags,
+		Version:  art.Version,
 	}
+	if !art.PublishAt.IsZero() {
+		res.PublishAt = art.PublishAt.UnixMilli()
+	}
+	return res
+}
+
+func (r *CachedArticleRepository) domainToPubEntity(art domain.Article) article.PublishedArticle {
+	return article.PublishedArticle{
+		Article: r.domainToEntity(art),
+		Html:    art.HTML,
+		Toc: slice.Map(art.TOC, func(idx int, src markdownx.Heading) article.TocItem {
+			return article.TocItem{
+				Level: src.Level,
+				Id:    src.Id,
+				Title: src.Title,
+			}
+		}),
+	}
+}
+
+func (r *CachedArticleRepository) tocToDomain(toc article.Toc) []markdownx.Heading {
+	return slice.Map(toc, func(idx int, src article.TocItem) markdownx.Heading {
+		return markdownx.Heading{
+			Level: src.Level,
+			Id:    src.Id,
+			Title: src.Title,
+		}
+	})
 }
 
 func (repo *CachedArticleRepository) entitytoDomain(art article.Article) domain.Article {
-	return domain.Article{
+	res := domain.Article{
 		Id:      art.Id,
 		Title:   art.Title,
 		Status:  domain.ArticleStatus(art.Status),
@@ -232,9 +513,19 @@ func (repo *CachedArticleRepository) entitytoDomain(art article.Article) domain.
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
-		Ctime: time.UnixMilli(art.Ctime),
-		Utime: time.UnixMilli(art.Utime),
+		Category: art.Category,
+		Tags:     art.Tags,
+		Version:  art.Version,
+		Ctime:    time.UnixMilli(art.Ctime),
+		Utime:    time.UnixMilli(art.Utime),
+	}
+	if art.PublishAt > 0 {
+		res.PublishAt = time.UnixMilli(art.PublishAt)
 	}
+	if art.Dtime > 0 {
+		res.Dtime = time.UnixMilli(art.Dtime)
+	}
+	return res
 }
 
 func (repo *CachedArticleRepository) entityPubtoDomain(art article.PublishedArticle) domain.Article {
@@ -246,7 +537,23 @@ func (repo *CachedArticleRepository) entityPubtoDomain(art article.PublishedArti
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
-		Ctime: time.UnixMilli(art.Ctime),
-		Utime: time.UnixMilli(art.Utime),
+		Category: art.Category,
+		Tags:     art.Tags,
+		HTML:     art.Html,
+		TOC:      repo.tocToDomain(art.Toc),
+		Ctime:    time.UnixMilli(art.Ctime),
+		Utime:    time.UnixMilli(art.Utime),
+	}
+}
+
+func (repo *CachedArticleRepository) revisionToDomain(rev article.ArticleRevision) domain.ArticleRevision {
+	return domain.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    domain.ArticleStatus(rev.Status),
+		AuthorId:  rev.AuthorId,
+		Utime:     time.UnixMilli(rev.Utime),
 	}
 }
@@ -0,0 +1,105 @@
+package article
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao/article"
+	"webookpro/pkg/logger"
+)
+
+var ErrCollaboratorNotFound = article.ErrCollaboratorNotFound
+
+//go:generate mockgen -source=collaborator.go -package=artrepomocks -destination=mocks/collaborator.mock.go CollaboratorRepository
+type CollaboratorRepository interface {
+	// Save 添加协作者，已经是协作者的话修改角色
+	Save(ctx context.Context, c domain.ArticleCollaborator) error
+	Remove(ctx context.Context, artId int64, uid int64) error
+	// GetRole 不是协作者的时候返回 ErrCollaboratorNotFound
+	GetRole(ctx context.Context, artId int64, uid int64) (domain.ArticleRole, error)
+	ListByArticle(ctx context.Context, artId int64) ([]domain.ArticleCollaborator, error)
+	// DelListCache 帖子变了之后，协作者的帖子列表第一页缓存也要删掉
+	DelListCache(ctx context.Context, artId int64) error
+}
+
+type CachedCollaboratorRepository struct {
+	dao article.CollaboratorDAO
+	// 协作者的帖子列表里面有协作的帖子，所以要用到帖子列表的缓存
+	artCache cache.ArticleCache
+	l        logger.Logger
+}
+
+func NewCachedCollaboratorRepository(dao article.CollaboratorDAO,
+	artCache cache.ArticleCache, l logger.Logger) CollaboratorRepository {
+	return &CachedCollaboratorRepository{
+		dao:      dao,
+		artCache: artCache,
+		l:        l,
+	}
+}
+
+func (r *CachedCollaboratorRepository) Save(ctx context.Context, c domain.ArticleCollaborator) error {
+	err := r.dao.Upsert(ctx, article.ArticleCollaborator{
+		ArticleId: c.ArticleId,
+		Uid:       c.Uid,
+		Role:      c.Role.ToUint8(),
+	})
+	if err == nil {
+		r.delFirstPage(ctx, c.Uid)
+	}
+	return err
+}
+
+func (r *CachedCollaboratorRepository) Remove(ctx context.Context, artId int64, uid int64) error {
+	err := r.dao.Delete(ctx, artId, uid)
+	if err == nil {
+		r.delFirstPage(ctx, uid)
+	}
+	return err
+}
+
+func (r *CachedCollaboratorRepository) GetRole(ctx context.Context, artId int64, uid int64) (domain.ArticleRole, error) {
+	c, err := r.dao.Find(ctx, artId, uid)
+	if err != nil {
+		return domain.ArticleRoleNone, err
+	}
+	return domain.ArticleRole(c.Role), nil
+}
+
+func (r *CachedCollaboratorRepository) ListByArticle(ctx context.Context, artId int64) ([]domain.ArticleCollaborator, error) {
+	list, err := r.dao.FindByArticle(ctx, artId)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(list, func(idx int, src article.ArticleCollaborator) domain.ArticleCollaborator {
+		return domain.ArticleCollaborator{
+			ArticleId: src.ArticleId,
+			Uid:       src.Uid,
+			Role:      domain.ArticleRole(src.Role),
+			Ctime:     time.UnixMilli(src.Ctime),
+			Utime:     time.UnixMilli(src.Utime),
+		}
+	}), nil
+}
+
+func (r *CachedCollaboratorRepository) DelListCache(ctx context.Context, artId int64) error {
+	list, err := r.dao.FindByArticle(ctx, artId)
+	if err != nil {
+		return err
+	}
+	for _, c := range list {
+		r.delFirstPage(ctx, c.Uid)
+	}
+	return nil
+}
+
+func (r *CachedCollaboratorRepository) delFirstPage(ctx context.Context, uid int64) {
+	err := r.artCache.DelFirstPage(ctx, uid)
+	if err != nil {
+		r.l.Error("清空协作者第一页帖子缓存失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+}
@@ -0,0 +1,105 @@
+package article
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao/article"
+)
+
+var (
+	ErrExportNotFound = article.ErrExportNotFound
+	ErrExportTaken    = article.ErrExportTaken
+)
+
+//go:generate mockgen -source=export.go -package=artrepomocks -destination=mocks/export.mock.go ExportRepository
+type ExportRepository interface {
+	Create(ctx context.Context, e domain.ArticleExport) (int64, error)
+	GetById(ctx context.Context, id int64) (domain.ArticleExport, error)
+	// ListByUid 最新的在前面
+	ListByUid(ctx context.Context, uid int64, limit int) ([]domain.ArticleExport, error)
+	// ListRunnable 还没开始的，以及 staleBefore 之后就没有动静的
+	ListRunnable(ctx context.Context, staleBefore time.Time, limit int) ([]domain.ArticleExport, error)
+	// Start 抢占导出任务，已经被别人拿走了返回 ErrExportTaken
+	Start(ctx context.Context, id int64, staleBefore time.Time) error
+	// Finish 记下打包的结果，成功或者失败
+	Finish(ctx context.Context, e domain.ArticleExport) error
+}
+
+// GORMExportRepository 导出是低频操作，不走缓存
+type GORMExportRepository struct {
+	dao article.ExportDAO
+}
+
+func NewGORMExportRepository(dao article.ExportDAO) ExportRepository {
+	return &GORMExportRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMExportRepository) Create(ctx context.Context, e domain.ArticleExport) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(e))
+}
+
+func (r *GORMExportRepository) GetById(ctx context.Context, id int64) (domain.ArticleExport, error) {
+	e, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.ArticleExport{}, err
+	}
+	return r.toDomain(e), nil
+}
+
+func (r *GORMExportRepository) ListByUid(ctx context.Context, uid int64, limit int) ([]domain.ArticleExport, error) {
+	list, err := r.dao.FindByUid(ctx, uid, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(list), nil
+}
+
+func (r *GORMExportRepository) ListRunnable(ctx context.Context, staleBefore time.Time, limit int) ([]domain.ArticleExport, error) {
+	list, err := r.dao.FindRunnable(ctx, staleBefore.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(list), nil
+}
+
+func (r *GORMExportRepository) Start(ctx context.Context, id int64, staleBefore time.Time) error {
+	return r.dao.Start(ctx, id, staleBefore.UnixMilli())
+}
+
+func (r *GORMExportRepository) Finish(ctx context.Context, e domain.ArticleExport) error {
+	return r.dao.Finish(ctx, r.toEntity(e))
+}
+
+func (r *GORMExportRepository) toDomains(list []article.ArticleExport) []domain.ArticleExport {
+	return slice.Map(list, func(idx int, src article.ArticleExport) domain.ArticleExport {
+		return r.toDomain(src)
+	})
+}
+
+func (r *GORMExportRepository) toEntity(e domain.ArticleExport) article.ArticleExport {
+	return article.ArticleExport{
+		Id:        e.Id,
+		Uid:       e.Uid,
+		Status:    e.Status.ToUint8(),
+		ObjectKey: e.Key,
+		Cnt:       e.Cnt,
+		Size:      e.Size,
+	}
+}
+
+func (r *GORMExportRepository) toDomain(e article.ArticleExport) domain.ArticleExport {
+	return domain.ArticleExport{
+		Id:     e.Id,
+		Uid:    e.Uid,
+		Status: domain.ArticleExportStatus(e.Status),
+		Key:    e.ObjectKey,
+		Cnt:    e.Cnt,
+		Size:   e.Size,
+		Ctime:  time.UnixMilli(e.Ctime),
+		Utime:  time.UnixMilli(e.Utime),
+	}
+}
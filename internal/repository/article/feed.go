@@ -0,0 +1,33 @@
+package article
+
+import (
+	"context"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+)
+
+//go:generate mockgen -source=feed.go -package=artrepomocks -destination=mocks/feed.mock.go FeedRepository
+type FeedRepository interface {
+	// Get author 为 0 的是全站的，没有缓存的时候返回 error
+	Get(ctx context.Context, kind domain.FeedKind, author int64) ([]byte, error)
+	Set(ctx context.Context, kind domain.FeedKind, author int64, val []byte) error
+}
+
+// CachedFeedRepository 订阅源只存在缓存里面，失效由 CachedArticleRepository 在线上库变化的时候处理
+type CachedFeedRepository struct {
+	cache cache.FeedCache
+}
+
+func NewCachedFeedRepository(cache cache.FeedCache) FeedRepository {
+	return &CachedFeedRepository{
+		cache: cache,
+	}
+}
+
+func (r *CachedFeedRepository) Get(ctx context.Context, kind domain.FeedKind, author int64) ([]byte, error) {
+	return r.cache.Get(ctx, kind, author)
+}
+
+func (r *CachedFeedRepository) Set(ctx context.Context, kind domain.FeedKind, author int64, val []byte) error {
+	return r.cache.Set(ctx, kind, author, val)
+}
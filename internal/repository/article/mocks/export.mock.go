@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export.go
+
+// Package artrepomocks is a generated GoMock package.
+package artrepomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportRepository is a mock of ExportRepository interface.
+type MockExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepositoryMockRecorder
+}
+
+// MockExportRepositoryMockRecorder is the mock recorder for MockExportRepository.
+type MockExportRepositoryMockRecorder struct {
+	mock *MockExportRepository
+}
+
+// NewMockExportRepository creates a new mock instance.
+func NewMockExportRepository(ctrl *gomock.Controller) *MockExportRepository {
+	mock := &MockExportRepository{ctrl: ctrl}
+	mock.recorder = &MockExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepository) EXPECT() *MockExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExportRepository) Create(ctx context.Context, e domain.ArticleExport) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, e)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExportRepositoryMockRecorder) Create(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExportRepository)(nil).Create), ctx, e)
+}
+
+// Finish mocks base method.
+func (m *MockExportRepository) Finish(ctx context.Context, e domain.ArticleExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockExportRepositoryMockRecorder) Finish(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockExportRepository)(nil).Finish), ctx, e)
+}
+
+// GetById mocks base method.
+func (m *MockExportRepository) GetById(ctx context.Context, id int64) (domain.ArticleExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.ArticleExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockExportRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockExportRepository)(nil).GetById), ctx, id)
+}
+
+// ListByUid mocks base method.
+func (m *MockExportRepository) ListByUid(ctx context.Context, uid int64, limit int) ([]domain.ArticleExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUid", ctx, uid, limit)
+	ret0, _ := ret[0].([]domain.ArticleExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUid indicates an expected call of ListByUid.
+func (mr *MockExportRepositoryMockRecorder) ListByUid(ctx, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUid", reflect.TypeOf((*MockExportRepository)(nil).ListByUid), ctx, uid, limit)
+}
+
+// ListRunnable mocks base method.
+func (m *MockExportRepository) ListRunnable(ctx context.Context, staleBefore time.Time, limit int) ([]domain.ArticleExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRunnable", ctx, staleBefore, limit)
+	ret0, _ := ret[0].([]domain.ArticleExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRunnable indicates an expected call of ListRunnable.
+func (mr *MockExportRepositoryMockRecorder) ListRunnable(ctx, staleBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRunnable", reflect.TypeOf((*MockExportRepository)(nil).ListRunnable), ctx, staleBefore, limit)
+}
+
+// Start mocks base method.
+func (m *MockExportRepository) Start(ctx context.Context, id int64, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, id, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockExportRepositoryMockRecorder) Start(ctx, id, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockExportRepository)(nil).Start), ctx, id, staleBefore)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share.go
+
+// Package artrepomocks is a generated GoMock package.
+package artrepomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockShareRepository is a mock of ShareRepository interface.
+type MockShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareRepositoryMockRecorder
+}
+
+// MockShareRepositoryMockRecorder is the mock recorder for MockShareRepository.
+type MockShareRepositoryMockRecorder struct {
+	mock *MockShareRepository
+}
+
+// NewMockShareRepository creates a new mock instance.
+func NewMockShareRepository(ctrl *gomock.Controller) *MockShareRepository {
+	mock := &MockShareRepository{ctrl: ctrl}
+	mock.recorder = &MockShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareRepository) EXPECT() *MockShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareRepository) Create(ctx context.Context, s domain.ArticleShare) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareRepositoryMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareRepository)(nil).Create), ctx, s)
+}
+
+// GetById mocks base method.
+func (m *MockShareRepository) GetById(ctx context.Context, id int64) (domain.ArticleShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.ArticleShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockShareRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockShareRepository)(nil).GetById), ctx, id)
+}
+
+// IncrViews mocks base method.
+func (m *MockShareRepository) IncrViews(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrViews", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrViews indicates an expected call of IncrViews.
+func (mr *MockShareRepositoryMockRecorder) IncrViews(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrViews", reflect.TypeOf((*MockShareRepository)(nil).IncrViews), ctx, id)
+}
+
+// ListByArticle mocks base method.
+func (m *MockShareRepository) ListByArticle(ctx context.Context, artId, uid int64) ([]domain.ArticleShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArticle", ctx, artId, uid)
+	ret0, _ := ret[0].([]domain.ArticleShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArticle indicates an expected call of ListByArticle.
+func (mr *MockShareRepositoryMockRecorder) ListByArticle(ctx, artId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArticle", reflect.TypeOf((*MockShareRepository)(nil).ListByArticle), ctx, artId, uid)
+}
+
+// Revoke mocks base method.
+func (m *MockShareRepository) Revoke(ctx context.Context, id, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockShareRepositoryMockRecorder) Revoke(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareRepository)(nil).Revoke), ctx, id, uid)
+}
@@ -0,0 +1,106 @@
+package article
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao/article"
+)
+
+var (
+	ErrReviewNotFound   = article.ErrReviewNotFound
+	ErrReviewNotPending = article.ErrReviewNotPending
+)
+
+//go:generate mockgen -source=review.go -package=artrepomocks -destination=mocks/review.mock.go ReviewRepository
+type ReviewRepository interface {
+	// Create 同一篇帖子之前还在等审核的记录都会变成过期
+	Create(ctx context.Context, r domain.ArticleReview) (int64, error)
+	GetById(ctx context.Context, id int64) (domain.ArticleReview, error)
+	// ListPending 最早提交的在前面
+	ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error)
+	// ListByArticle 最新的在前面
+	ListByArticle(ctx context.Context, artId, authorId int64, limit int) ([]domain.ArticleReview, error)
+	// Finish 记下审核的结果，已经不是待审核的返回 ErrReviewNotPending
+	Finish(ctx context.Context, r domain.ArticleReview) error
+}
+
+// GORMReviewRepository 审核是低频操作，不走缓存
+type GORMReviewRepository struct {
+	dao article.ReviewDAO
+}
+
+func NewGORMReviewRepository(dao article.ReviewDAO) ReviewRepository {
+	return &GORMReviewRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMReviewRepository) Create(ctx context.Context, rev domain.ArticleReview) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(rev))
+}
+
+func (r *GORMReviewRepository) GetById(ctx context.Context, id int64) (domain.ArticleReview, error) {
+	rev, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.ArticleReview{}, err
+	}
+	return r.toDomain(rev), nil
+}
+
+func (r *GORMReviewRepository) ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error) {
+	list, err := r.dao.FindPending(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(list), nil
+}
+
+func (r *GORMReviewRepository) ListByArticle(ctx context.Context, artId, authorId int64, limit int) ([]domain.ArticleReview, error) {
+	list, err := r.dao.FindByArticle(ctx, artId, authorId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(list), nil
+}
+
+func (r *GORMReviewRepository) Finish(ctx context.Context, rev domain.ArticleReview) error {
+	return r.dao.Finish(ctx, r.toEntity(rev))
+}
+
+func (r *GORMReviewRepository) toDomains(list []article.ArticleReview) []domain.ArticleReview {
+	return slice.Map(list, func(idx int, src article.ArticleReview) domain.ArticleReview {
+		return r.toDomain(src)
+	})
+}
+
+func (r *GORMReviewRepository) toEntity(rev domain.ArticleReview) article.ArticleReview {
+	return article.ArticleReview{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		AuthorId:  rev.Author.Id,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    rev.Status.ToUint8(),
+		Reasons:   rev.Reasons,
+		Reviewer:  rev.Reviewer,
+		Reason:    rev.Reason,
+	}
+}
+
+func (r *GORMReviewRepository) toDomain(rev article.ArticleReview) domain.ArticleReview {
+	return domain.ArticleReview{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Author:    domain.Author{Id: rev.AuthorId},
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    domain.ArticleReviewStatus(rev.Status),
+		Reasons:   rev.Reasons,
+		Reviewer:  rev.Reviewer,
+		Reason:    rev.Reason,
+		Ctime:     time.UnixMilli(rev.Ctime),
+		Utime:     time.UnixMilli(rev.Utime),
+	}
+}
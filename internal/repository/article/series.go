@@ -0,0 +1,211 @@
+package article
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao/article"
+	"webookpro/pkg/logger"
+)
+
+var (
+	ErrSeriesNotFound      = article.ErrSeriesNotFound
+	ErrArticleInSeries     = article.ErrArticleInSeries
+	ErrSeriesOrderMismatch = article.ErrSeriesOrderMismatch
+)
+
+// seriesFirstPageSize 第一页查库的时候多查一些，缓存起来不管 limit 是多少都够用
+const seriesFirstPageSize = 100
+
+//go:generate mockgen -source=series.go -package=artrepomocks -destination=mocks/series.mock.go SeriesRepository
+type SeriesRepository interface {
+	Create(ctx context.Context, s domain.Series) (int64, error)
+	Update(ctx context.Context, s domain.Series) error
+	Delete(ctx context.Context, id int64, uid int64) error
+	// GetById 带上排好序的帖子，包括已经撤回了的
+	GetById(ctx context.Context, id int64) (domain.Series, error)
+	// GetByArticle 帖子所在的系列
+	GetByArticle(ctx context.Context, artId int64) (domain.Series, error)
+	// ListByAuthor 不带系列里面的帖子
+	ListByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error)
+	AddArticle(ctx context.Context, id int64, uid int64, artId int64) error
+	RemoveArticle(ctx context.Context, id int64, uid int64, artId int64) error
+	Reorder(ctx context.Context, id int64, uid int64, artIds []int64) error
+}
+
+type CachedSeriesRepository struct {
+	dao   article.SeriesDAO
+	cache cache.SeriesCache
+	l     logger.Logger
+}
+
+func NewCachedSeriesRepository(dao article.SeriesDAO,
+	cache cache.SeriesCache, l logger.Logger) SeriesRepository {
+	return &CachedSeriesRepository{
+		dao:   dao,
+		cache: cache,
+		l:     l,
+	}
+}
+
+func (r *CachedSeriesRepository) Create(ctx context.Context, s domain.Series) (int64, error) {
+	id, err := r.dao.Insert(ctx, r.toEntity(s))
+	if err == nil {
+		r.delFirstPage(ctx, s.Author.Id)
+	}
+	return id, err
+}
+
+func (r *CachedSeriesRepository) Update(ctx context.Context, s domain.Series) error {
+	err := r.dao.Update(ctx, r.toEntity(s))
+	if err == nil {
+		r.invalidate(ctx, s.Id, s.Author.Id)
+	}
+	return err
+}
+
+func (r *CachedSeriesRepository) Delete(ctx context.Context, id int64, uid int64) error {
+	err := r.dao.Delete(ctx, id, uid)
+	if err == nil {
+		r.invalidate(ctx, id, uid)
+	}
+	return err
+}
+
+func (r *CachedSeriesRepository) GetById(ctx context.Context, id int64) (domain.Series, error) {
+	res, err := r.cache.Get(ctx, id)
+	if err == nil {
+		return res, nil
+	}
+	s, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	items, err := r.dao.FindItems(ctx, id)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	res = r.toDomain(s)
+	res.Articles = slice.Map(items, func(idx int, src article.SeriesItem) domain.SeriesArticle {
+		return domain.SeriesArticle{
+			Id:     src.ArticleId,
+			Title:  src.Title,
+			Status: domain.ArticleStatus(src.Status),
+		}
+	})
+	er := r.cache.Set(ctx, res)
+	if er != nil {
+		r.l.Error("回写系列缓存失败",
+			logger.Int64("id", id),
+			logger.Error(er))
+	}
+	return res, nil
+}
+
+func (r *CachedSeriesRepository) GetByArticle(ctx context.Context, artId int64) (domain.Series, error) {
+	id, err := r.dao.FindIdByArticle(ctx, artId)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return r.GetById(ctx, id)
+}
+
+func (r *CachedSeriesRepository) ListByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error) {
+	firstPage := offset == 0 && limit <= seriesFirstPageSize
+	if firstPage {
+		res, err := r.cache.GetFirstPage(ctx, uid)
+		if err == nil {
+			return r.prefix(res, limit), nil
+		}
+		limit, offset = seriesFirstPageSize, 0
+	}
+	list, err := r.dao.FindByAuthor(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := slice.Map(list, func(idx int, src article.Series) domain.Series {
+		return r.toDomain(src)
+	})
+	if firstPage {
+		er := r.cache.SetFirstPage(ctx, uid, res)
+		if er != nil {
+			r.l.Error("回写系列第一页缓存失败",
+				logger.Int64("uid", uid),
+				logger.Error(er))
+		}
+	}
+	return res, nil
+}
+
+func (r *CachedSeriesRepository) AddArticle(ctx context.Context, id int64, uid int64, artId int64) error {
+	err := r.dao.AddArticle(ctx, id, uid, artId)
+	if err == nil {
+		r.invalidate(ctx, id, uid)
+	}
+	return err
+}
+
+func (r *CachedSeriesRepository) RemoveArticle(ctx context.Context, id int64, uid int64, artId int64) error {
+	err := r.dao.RemoveArticle(ctx, id, uid, artId)
+	if err == nil {
+		r.invalidate(ctx, id, uid)
+	}
+	return err
+}
+
+func (r *CachedSeriesRepository) Reorder(ctx context.Context, id int64, uid int64, artIds []int64) error {
+	err := r.dao.Reorder(ctx, id, uid, artIds)
+	if err == nil {
+		r.invalidate(ctx, id, uid)
+	}
+	return err
+}
+
+// invalidate 系列变了，详情和创作者的第一页都要删掉，第一页是按照更新时间排序的
+func (r *CachedSeriesRepository) invalidate(ctx context.Context, id int64, uid int64) {
+	err := r.cache.Del(ctx, id)
+	if err != nil {
+		r.l.Error("删除系列缓存失败",
+			logger.Int64("id", id),
+			logger.Error(err))
+	}
+	r.delFirstPage(ctx, uid)
+}
+
+func (r *CachedSeriesRepository) delFirstPage(ctx context.Context, uid int64) {
+	err := r.cache.DelFirstPage(ctx, uid)
+	if err != nil {
+		r.l.Error("删除系列第一页缓存失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+}
+
+func (r *CachedSeriesRepository) prefix(res []domain.Series, limit int) []domain.Series {
+	if len(res) > limit {
+		return res[:limit]
+	}
+	return res
+}
+
+func (r *CachedSeriesRepository) toEntity(s domain.Series) article.Series {
+	return article.Series{
+		Id:          s.Id,
+		AuthorId:    s.Author.Id,
+		Title:       s.Title,
+		Description: s.Description,
+	}
+}
+
+func (r *CachedSeriesRepository) toDomain(s article.Series) domain.Series {
+	return domain.Series{
+		Id:          s.Id,
+		Author:      domain.Author{Id: s.AuthorId},
+		Title:       s.Title,
+		Description: s.Description,
+		Ctime:       time.UnixMilli(s.Ctime),
+		Utime:       time.UnixMilli(s.Utime),
+	}
+}
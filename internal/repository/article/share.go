@@ -0,0 +1,91 @@
+package article
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao/article"
+)
+
+var (
+	ErrShareNotFound    = article.ErrShareNotFound
+	ErrShareUnavailable = article.ErrShareUnavailable
+)
+
+//go:generate mockgen -source=share.go -package=artrepomocks -destination=mocks/share.mock.go ShareRepository
+type ShareRepository interface {
+	Create(ctx context.Context, s domain.ArticleShare) (int64, error)
+	GetById(ctx context.Context, id int64) (domain.ArticleShare, error)
+	ListByArticle(ctx context.Context, artId int64, uid int64) ([]domain.ArticleShare, error)
+	Revoke(ctx context.Context, id int64, uid int64) error
+	// IncrViews 链接还有效的话访问次数 +1，否则返回 ErrShareUnavailable
+	IncrViews(ctx context.Context, id int64) error
+}
+
+// GORMShareRepository 分享链接每次访问都要计数，所以不走缓存
+type GORMShareRepository struct {
+	dao article.ShareDAO
+}
+
+func NewGORMShareRepository(dao article.ShareDAO) ShareRepository {
+	return &GORMShareRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMShareRepository) Create(ctx context.Context, s domain.ArticleShare) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(s))
+}
+
+func (r *GORMShareRepository) GetById(ctx context.Context, id int64) (domain.ArticleShare, error) {
+	s, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.ArticleShare{}, err
+	}
+	return r.toDomain(s), nil
+}
+
+func (r *GORMShareRepository) ListByArticle(ctx context.Context, artId int64, uid int64) ([]domain.ArticleShare, error) {
+	list, err := r.dao.FindByArticle(ctx, artId, uid)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(list, func(idx int, src article.ArticleShare) domain.ArticleShare {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *GORMShareRepository) Revoke(ctx context.Context, id int64, uid int64) error {
+	return r.dao.Revoke(ctx, id, uid)
+}
+
+func (r *GORMShareRepository) IncrViews(ctx context.Context, id int64) error {
+	return r.dao.IncrViews(ctx, id, time.Now().UnixMilli())
+}
+
+func (r *GORMShareRepository) toEntity(s domain.ArticleShare) article.ArticleShare {
+	return article.ArticleShare{
+		Id:        s.Id,
+		ArticleId: s.ArticleId,
+		AuthorId:  s.Author.Id,
+		MaxViews:  s.MaxViews,
+		Views:     s.Views,
+		Expire:    s.Expire.UnixMilli(),
+		Revoked:   s.Revoked,
+	}
+}
+
+func (r *GORMShareRepository) toDomain(s article.ArticleShare) domain.ArticleShare {
+	return domain.ArticleShare{
+		Id:        s.Id,
+		ArticleId: s.ArticleId,
+		Author:    domain.Author{Id: s.AuthorId},
+		MaxViews:  s.MaxViews,
+		Views:     s.Views,
+		Expire:    time.UnixMilli(s.Expire),
+		Revoked:   s.Revoked,
+		Ctime:     time.UnixMilli(s.Ctime),
+		Utime:     time.UnixMilli(s.Utime),
+	}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao"
+)
+
+//go:generate mockgen -source=attachment.go -package=repomocks -destination=mock/attachment.mock.go AttachmentRepository
+type AttachmentRepository interface {
+	Create(ctx context.Context, a domain.Attachment) (int64, error)
+	// BindArticle 帖子引用了这些附件
+	BindArticle(ctx context.Context, uid int64, artId int64, keys []string) error
+	// UnbindArticle 除了 keepKeys 之外，帖子不再引用其它的附件
+	UnbindArticle(ctx context.Context, artId int64, keepKeys []string) error
+	// FindOrphans 在 before 之前就已经没有任何帖子引用的附件
+	FindOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Attachment, error)
+	Delete(ctx context.Context, ids []int64) error
+}
+
+type attachmentRepository struct {
+	dao dao.AttachmentDAO
+}
+
+func NewAttachmentRepository(dao dao.AttachmentDAO) AttachmentRepository {
+	return &attachmentRepository{
+		dao: dao,
+	}
+}
+
+func (a *attachmentRepository) Create(ctx context.Context, att domain.Attachment) (int64, error) {
+	return a.dao.Insert(ctx, dao.Attachment{
+		Uid:      att.Uid,
+		Key:      att.Key,
+		ThumbKey: att.ThumbKey,
+		MimeType: att.MimeType,
+		Size:     att.Size,
+		Width:    att.Width,
+		Height:   att.Height,
+	})
+}
+
+func (a *attachmentRepository) BindArticle(ctx context.Context, uid int64, artId int64, keys []string) error {
+	return a.dao.Bind(ctx, uid, artId, keys)
+}
+
+func (a *attachmentRepository) UnbindArticle(ctx context.Context, artId int64, keepKeys []string) error {
+	return a.dao.Unbind(ctx, artId, keepKeys)
+}
+
+func (a *attachmentRepository) FindOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Attachment, error) {
+	res, err := a.dao.FindOrphans(ctx, before.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Attachment) domain.Attachment {
+		return domain.Attachment{
+			Id:       src.Id,
+			Uid:      src.Uid,
+			Key:      src.Key,
+			ThumbKey: src.ThumbKey,
+			MimeType: src.MimeType,
+			Size:     src.Size,
+			Width:    src.Width,
+			Height:   src.Height,
+			Ctime:    time.UnixMilli(src.Ctime),
+			Utime:    time.UnixMilli(src.Utime),
+		}
+	}), nil
+}
+
+func (a *attachmentRepository) Delete(ctx context.Context, ids []int64) error {
+	return a.dao.DeleteByIds(ctx, ids)
+}
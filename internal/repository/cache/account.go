@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// ErrRestoreTicketNotFound 恢复账号的凭证不存在，过期了或者已经用过了
+var ErrRestoreTicketNotFound = redis.Nil
+
+// AccountCache 停用或者注销中的账号登录的时候发的一次性凭证，拿着它才能恢复账号
+type AccountCache interface {
+	Set(ctx context.Context, ticket string, uid int64) error
+	// GetDel 取出来的同时就删掉，保证只能用一次
+	GetDel(ctx context.Context, ticket string) (int64, error)
+}
+
+type RedisAccountCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRedisAccountCache(cmd redis.Cmdable) AccountCache {
+	return &RedisAccountCache{
+		cmd:        cmd,
+		expiration: time.Minute * 10,
+	}
+}
+
+func (c *RedisAccountCache) Set(ctx context.Context, ticket string, uid int64) error {
+	return c.cmd.Set(ctx, c.key(ticket), uid, c.expiration).Err()
+}
+
+func (c *RedisAccountCache) GetDel(ctx context.Context, ticket string) (int64, error) {
+	return c.cmd.GetDel(ctx, c.key(ticket)).Int64()
+}
+
+func (c *RedisAccountCache) key(ticket string) string {
+	return fmt.Sprintf("account:restore:%s", ticket)
+}
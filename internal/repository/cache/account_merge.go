@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// ErrMergeTicketNotFound 合并账号的凭证不存在，过期了或者已经用过了
+var ErrMergeTicketNotFound = redis.Nil
+
+// AccountMergeCache 登录被合并的账号之后拿到的一次性凭证，证明这个账号是自己的
+// 再登录主账号带着凭证发起合并
+type AccountMergeCache interface {
+	Set(ctx context.Context, ticket string, uid int64) error
+	// GetDel 取出来的同时就删掉，保证只能用一次
+	GetDel(ctx context.Context, ticket string) (int64, error)
+}
+
+type RedisAccountMergeCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRedisAccountMergeCache(cmd redis.Cmdable) AccountMergeCache {
+	return &RedisAccountMergeCache{
+		cmd:        cmd,
+		expiration: time.Minute * 10,
+	}
+}
+
+func (c *RedisAccountMergeCache) Set(ctx context.Context, ticket string, uid int64) error {
+	return c.cmd.Set(ctx, c.key(ticket), uid, c.expiration).Err()
+}
+
+func (c *RedisAccountMergeCache) GetDel(ctx context.Context, ticket string) (int64, error) {
+	return c.cmd.GetDel(ctx, c.key(ticket)).Int64()
+}
+
+func (c *RedisAccountMergeCache) key(ticket string) string {
+	return fmt.Sprintf("account_merge:ticket:%s", ticket)
+}
@@ -22,6 +22,9 @@ type ArticleCache interface {
 	// SetPub 正常来说，创作者和读者的 Redis 集群要分开，因为读者是一个核心中的核心
 	SetPub(ctx context.Context, article domain.Article) error
 	GetPub(ctx context.Context, id int64) (domain.Article, error)
+
+	// Del 删除帖子的时候，创作者和读者两边的缓存都要删掉
+	Del(ctx context.Context, id int64) error
 }
 
 type RedisArticleCache struct {
@@ -102,6 +105,10 @@ func (r RedisArticleCache) GetPub(ctx context.Context, id int64) (domain.Article
 	return res, err
 }
 
+func (r RedisArticleCache) Del(ctx context.Context, id int64) error {
+	return r.client.Del(ctx, r.authorArtKey(id), r.readerArtKey(id)).Err()
+}
+
 // 创作端的缓存设置
 func (r *RedisArticleCache) authorArtKey(id int64) string {
 	return fmt.Sprintf("article:author:%d", id)
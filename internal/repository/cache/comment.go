@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"webookpro/internal/domain"
+)
+
+type CommentCache interface {
+	// GetFirstPage 只缓存第一页的根评论，连带着每个根评论的前几条回复
+	GetFirstPage(ctx context.Context, biz string, bizId int64) ([]domain.Comment, error)
+	SetFirstPage(ctx context.Context, biz string, bizId int64, cs []domain.Comment) error
+	DelFirstPage(ctx context.Context, biz string, bizId int64) error
+}
+
+type RedisCommentCache struct {
+	client redis.Cmdable
+}
+
+func NewRedisCommentCache(client redis.Cmdable) CommentCache {
+	return &RedisCommentCache{
+		client: client,
+	}
+}
+
+func (r *RedisCommentCache) GetFirstPage(ctx context.Context, biz string, bizId int64) ([]domain.Comment, error) {
+	bs, err := r.client.Get(ctx, r.firstPageKey(biz, bizId)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var cs []domain.Comment
+	err = json.Unmarshal(bs, &cs)
+	return cs, err
+}
+
+func (r *RedisCommentCache) SetFirstPage(ctx context.Context, biz string, bizId int64, cs []domain.Comment) error {
+	bs, err := json.Marshal(cs)
+	if err != nil {
+		return err
+	}
+	// 新增和删除评论都会删掉缓存，所以过期时间可以长一点
+	return r.client.Set(ctx, r.firstPageKey(biz, bizId), bs, time.Minute*10).Err()
+}
+
+func (r *RedisCommentCache) DelFirstPage(ctx context.Context, biz string, bizId int64) error {
+	return r.client.Del(ctx, r.firstPageKey(biz, bizId)).Err()
+}
+
+func (r *RedisCommentCache) firstPageKey(biz string, bizId int64) string {
+	return fmt.Sprintf("comment:first_page:%s:%d", biz, bizId)
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"webookpro/internal/domain"
+)
+
+// FeedCache 缓存生成好的 RSS、Atom 和 sitemap
+// author 为 0 的是全站的，sitemap 只有全站的
+type FeedCache interface {
+	Get(ctx context.Context, kind domain.FeedKind, author int64) ([]byte, error)
+	Set(ctx context.Context, kind domain.FeedKind, author int64, val []byte) error
+	// Del 作者发表或者撤回了帖子，全站的和这个作者的都要删掉
+	Del(ctx context.Context, author int64) error
+}
+
+type RedisFeedCache struct {
+	client redis.Cmdable
+}
+
+func NewRedisFeedCache(client redis.Cmdable) FeedCache {
+	return &RedisFeedCache{
+		client: client,
+	}
+}
+
+func (r *RedisFeedCache) Get(ctx context.Context, kind domain.FeedKind, author int64) ([]byte, error) {
+	return r.client.Get(ctx, r.key(kind, author)).Bytes()
+}
+
+// Set 发表和撤回都会删掉缓存，过期时间主要是兜底删除失败的情况
+func (r *RedisFeedCache) Set(ctx context.Context, kind domain.FeedKind, author int64, val []byte) error {
+	return r.client.Set(ctx, r.key(kind, author), val, time.Hour).Err()
+}
+
+func (r *RedisFeedCache) Del(ctx context.Context, author int64) error {
+	return r.client.Del(ctx,
+		r.key(domain.FeedKindRSS, 0),
+		r.key(domain.FeedKindAtom, 0),
+		r.key(domain.FeedKindSitemap, 0),
+		r.key(domain.FeedKindRSS, author),
+		r.key(domain.FeedKindAtom, author),
+	).Err()
+}
+
+func (r *RedisFeedCache) key(kind domain.FeedKind, author int64) string {
+	return fmt.Sprintf("feed:%s:%d", kind, author)
+}
@@ -0,0 +1,12 @@
+local key = KEYS[1]
+local cntKey = key..":cnt"
+-- 已经过期了就什么都不做，免得 decr 把 key 重新创建出来，而且没有过期时间
+if redis.call("exists", cntKey) == 0 then
+    return 0
+end
+local cnt = redis.call("decr", cntKey)
+if cnt <= 0 then
+    -- 输错太多次，这个挑战作废，只能重新输密码
+    redis.call("del", key, cntKey)
+end
+return cnt
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=comment.go -package=cachemocks -destination=mock/comment.mock.go
+//
+
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentCache is a mock of CommentCache interface.
+type MockCommentCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentCacheMockRecorder
+}
+
+// MockCommentCacheMockRecorder is the mock recorder for MockCommentCache.
+type MockCommentCacheMockRecorder struct {
+	mock *MockCommentCache
+}
+
+// NewMockCommentCache creates a new mock instance.
+func NewMockCommentCache(ctrl *gomock.Controller) *MockCommentCache {
+	mock := &MockCommentCache{ctrl: ctrl}
+	mock.recorder = &MockCommentCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentCache) EXPECT() *MockCommentCacheMockRecorder {
+	return m.recorder
+}
+
+// DelFirstPage mocks base method.
+func (m *MockCommentCache) DelFirstPage(ctx context.Context, biz string, bizId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFirstPage", ctx, biz, bizId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelFirstPage indicates an expected call of DelFirstPage.
+func (mr *MockCommentCacheMockRecorder) DelFirstPage(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFirstPage", reflect.TypeOf((*MockCommentCache)(nil).DelFirstPage), ctx, biz, bizId)
+}
+
+// GetFirstPage mocks base method.
+func (m *MockCommentCache) GetFirstPage(ctx context.Context, biz string, bizId int64) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstPage", ctx, biz, bizId)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstPage indicates an expected call of GetFirstPage.
+func (mr *MockCommentCacheMockRecorder) GetFirstPage(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstPage", reflect.TypeOf((*MockCommentCache)(nil).GetFirstPage), ctx, biz, bizId)
+}
+
+// SetFirstPage mocks base method.
+func (m *MockCommentCache) SetFirstPage(ctx context.Context, biz string, bizId int64, cs []domain.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFirstPage", ctx, biz, bizId, cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFirstPage indicates an expected call of SetFirstPage.
+func (mr *MockCommentCacheMockRecorder) SetFirstPage(ctx, biz, bizId, cs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFirstPage", reflect.TypeOf((*MockCommentCache)(nil).SetFirstPage), ctx, biz, bizId, cs)
+}
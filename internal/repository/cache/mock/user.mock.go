@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: F:\GeekGoProjects\src\webookpro\internal\repository\cache\user.go
+// Source: user.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user.go -package=cachemocks -destination=mock/user.mock.go
+//
 
 // Package cachemocks is a generated GoMock package.
 package cachemocks
@@ -35,6 +40,20 @@ func (m *MockUserCache) EXPECT() *MockUserCacheMockRecorder {
 	return m.recorder
 }
 
+// Del mocks base method.
+func (m *MockUserCache) Del(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockUserCacheMockRecorder) Del(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockUserCache)(nil).Del), ctx, id)
+}
+
 // Get mocks base method.
 func (m *MockUserCache) Get(ctx context.Context, id int64) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -45,7 +64,7 @@ func (m *MockUserCache) Get(ctx context.Context, id int64) (domain.User, error)
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockUserCacheMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+func (mr *MockUserCacheMockRecorder) Get(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserCache)(nil).Get), ctx, id)
 }
@@ -59,7 +78,7 @@ func (m *MockUserCache) Key(ctx context.Context, id int64) string {
 }
 
 // Key indicates an expected call of Key.
-func (mr *MockUserCacheMockRecorder) Key(ctx, id interface{}) *gomock.Call {
+func (mr *MockUserCacheMockRecorder) Key(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockUserCache)(nil).Key), ctx, id)
 }
@@ -73,7 +92,7 @@ func (m *MockUserCache) Set(ctx context.Context, user domain.User) error {
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockUserCacheMockRecorder) Set(ctx, user interface{}) *gomock.Call {
+func (mr *MockUserCacheMockRecorder) Set(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUserCache)(nil).Set), ctx, user)
 }
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// ErrResetTokenNotFound 重置密码的凭证不存在，过期了或者已经用过了
+var ErrResetTokenNotFound = redis.Nil
+
+// PasswordResetCache 验证码校验通过之后发的一次性凭证，拿着它才能设置新密码
+type PasswordResetCache interface {
+	Set(ctx context.Context, token string, uid int64) error
+	// GetDel 取出来的同时就删掉，保证只能用一次
+	GetDel(ctx context.Context, token string) (int64, error)
+}
+
+type RedisPasswordResetCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRedisPasswordResetCache(cmd redis.Cmdable) PasswordResetCache {
+	return &RedisPasswordResetCache{
+		cmd:        cmd,
+		expiration: time.Minute * 10,
+	}
+}
+
+func (c *RedisPasswordResetCache) Set(ctx context.Context, token string, uid int64) error {
+	return c.cmd.Set(ctx, c.key(token), uid, c.expiration).Err()
+}
+
+func (c *RedisPasswordResetCache) GetDel(ctx context.Context, token string) (int64, error) {
+	return c.cmd.GetDel(ctx, c.key(token)).Int64()
+}
+
+func (c *RedisPasswordResetCache) key(token string) string {
+	return fmt.Sprintf("password_reset:token:%s", token)
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"webookpro/internal/domain"
+)
+
+type SeriesCache interface {
+	// GetFirstPage 创作者的系列列表，只缓存第一页，不带系列里面的帖子
+	GetFirstPage(ctx context.Context, author int64) ([]domain.Series, error)
+	SetFirstPage(ctx context.Context, author int64, series []domain.Series) error
+	DelFirstPage(ctx context.Context, author int64) error
+
+	// Get 系列详情，连同排好序的帖子一起缓存
+	Get(ctx context.Context, id int64) (domain.Series, error)
+	Set(ctx context.Context, s domain.Series) error
+	Del(ctx context.Context, id int64) error
+}
+
+type RedisSeriesCache struct {
+	client redis.Cmdable
+}
+
+func NewRedisSeriesCache(client redis.Cmdable) SeriesCache {
+	return &RedisSeriesCache{
+		client: client,
+	}
+}
+
+func (r *RedisSeriesCache) GetFirstPage(ctx context.Context, author int64) ([]domain.Series, error) {
+	bs, err := r.client.Get(ctx, r.firstPageKey(author)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var res []domain.Series
+	err = json.Unmarshal(bs, &res)
+	return res, err
+}
+
+func (r *RedisSeriesCache) SetFirstPage(ctx context.Context, author int64, series []domain.Series) error {
+	bs, err := json.Marshal(series)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, r.firstPageKey(author), bs, time.Minute*10).Err()
+}
+
+func (r *RedisSeriesCache) DelFirstPage(ctx context.Context, author int64) error {
+	return r.client.Del(ctx, r.firstPageKey(author)).Err()
+}
+
+func (r *RedisSeriesCache) Get(ctx context.Context, id int64) (domain.Series, error) {
+	bs, err := r.client.Get(ctx, r.key(id)).Bytes()
+	if err != nil {
+		return domain.Series{}, err
+	}
+	var res domain.Series
+	err = json.Unmarshal(bs, &res)
+	return res, err
+}
+
+// Set 帖子改标题或者撤回不会删掉这个缓存，所以过期时间不能太长
+func (r *RedisSeriesCache) Set(ctx context.Context, s domain.Series) error {
+	bs, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, r.key(s.Id), bs, time.Minute*10).Err()
+}
+
+func (r *RedisSeriesCache) Del(ctx context.Context, id int64) error {
+	return r.client.Del(ctx, r.key(id)).Err()
+}
+
+func (r *RedisSeriesCache) key(id int64) string {
+	return fmt.Sprintf("series:%d", id)
+}
+
+func (r *RedisSeriesCache) firstPageKey(author int64) string {
+	return fmt.Sprintf("series:first_page:%d", author)
+}
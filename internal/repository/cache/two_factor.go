@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// ErrChallengeNotFound 两步验证的挑战不存在，过期了、用过了或者输错太多次了
+var ErrChallengeNotFound = redis.Nil
+
+//go:embed lua/fail_challenge.lua
+var failChallengeLua string
+
+// TwoFactorCache 密码验证通过之后发的挑战，拿着它和验证码才能换到登录态
+type TwoFactorCache interface {
+	Set(ctx context.Context, challenge string, uid int64) error
+	Get(ctx context.Context, challenge string) (int64, error)
+	// Fail 输错一次，次数用完了挑战就作废
+	Fail(ctx context.Context, challenge string) error
+	Del(ctx context.Context, challenge string) error
+	// FailUser 按照用户记录输错的次数，重新输密码换一个挑战也会接着累加
+	FailUser(ctx context.Context, uid int64) error
+	// UserLocked 输错的次数到了上限，要等一段时间才能再试
+	UserLocked(ctx context.Context, uid int64) (bool, error)
+	// ResetUser 验证通过之后清零
+	ResetUser(ctx context.Context, uid int64) error
+}
+
+type RedisTwoFactorCache struct {
+	cmd        redis.Cmdable
+	expiration time.Duration
+	// maxAttempts 一个挑战最多能输几次验证码
+	maxAttempts int
+	// maxUserFailures 一个用户在 lockDuration 里面最多能输错几次，每输错一次重新计时
+	maxUserFailures int
+	lockDuration    time.Duration
+}
+
+func NewRedisTwoFactorCache(cmd redis.Cmdable) TwoFactorCache {
+	return &RedisTwoFactorCache{
+		cmd:             cmd,
+		expiration:      time.Minute * 5,
+		maxAttempts:     5,
+		maxUserFailures: 10,
+		lockDuration:    time.Minute * 15,
+	}
+}
+
+func (c *RedisTwoFactorCache) Set(ctx context.Context, challenge string, uid int64) error {
+	key := c.key(challenge)
+	_, err := c.cmd.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, uid, c.expiration)
+		pipe.Set(ctx, key+":cnt", c.maxAttempts, c.expiration)
+		return nil
+	})
+	return err
+}
+
+func (c *RedisTwoFactorCache) Get(ctx context.Context, challenge string) (int64, error) {
+	return c.cmd.Get(ctx, c.key(challenge)).Int64()
+}
+
+func (c *RedisTwoFactorCache) Fail(ctx context.Context, challenge string) error {
+	return c.cmd.Eval(ctx, failChallengeLua, []string{c.key(challenge)}).Err()
+}
+
+func (c *RedisTwoFactorCache) Del(ctx context.Context, challenge string) error {
+	key := c.key(challenge)
+	return c.cmd.Del(ctx, key, key+":cnt").Err()
+}
+
+func (c *RedisTwoFactorCache) FailUser(ctx context.Context, uid int64) error {
+	key := c.failureKey(uid)
+	_, err := c.cmd.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, c.lockDuration)
+		return nil
+	})
+	return err
+}
+
+func (c *RedisTwoFactorCache) UserLocked(ctx context.Context, uid int64) (bool, error) {
+	cnt, err := c.cmd.Get(ctx, c.failureKey(uid)).Int()
+	if err == redis.Nil {
+		return false, nil
+	}
+	return cnt >= c.maxUserFailures, err
+}
+
+func (c *RedisTwoFactorCache) ResetUser(ctx context.Context, uid int64) error {
+	return c.cmd.Del(ctx, c.failureKey(uid)).Err()
+}
+
+func (c *RedisTwoFactorCache) failureKey(uid int64) string {
+	return fmt.Sprintf("two_factor:failure:%d", uid)
+}
+
+func (c *RedisTwoFactorCache) key(challenge string) string {
+	return fmt.Sprintf("two_factor:challenge:%s", challenge)
+}
@@ -16,6 +16,8 @@ var (
 type UserCache interface {
 	Set(ctx context.Context, user domain.User) error
 	Get(ctx context.Context, id int64) (domain.User, error)
+	// Del 用户信息修改之后删掉，下次查询的时候再从数据库加载
+	Del(ctx context.Context, id int64) error
 	Key(ctx context.Context, id int64) string
 }
 
@@ -49,6 +51,10 @@ func (uc *RedisUserCache) Get(ctx context.Context, id int64) (domain.User, error
 	return user, err
 }
 
+func (uc *RedisUserCache) Del(ctx context.Context, id int64) error {
+	return uc.cmd.Del(ctx, uc.Key(ctx, id)).Err()
+}
+
 func (uc *RedisUserCache) Key(ctx context.Context, id int64) string {
 	return fmt.Sprintf("user:info:%d", id)
 }
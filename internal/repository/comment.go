@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"golang.org/x/sync/errgroup"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao"
+	"webookpro/pkg/logger"
+)
+
+const (
+	// 列表页每个根评论带上这么多条回复，剩下的要用户点开再查
+	previewReplyCnt = 3
+	// 第一页缓存这么多条根评论
+	firstPageCommentCnt = 50
+)
+
+var ErrCommentNotFound = dao.ErrCommentNotFound
+
+//go:generate mockgen -source=comment.go -package=repomocks -destination=mock/comment.mock.go CommentRepository
+type CommentRepository interface {
+	AddComment(ctx context.Context, c domain.Comment) (int64, error)
+	FindById(ctx context.Context, id int64) (domain.Comment, error)
+	// FindRoots 根评论，每个根评论都带上前几条回复
+	FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]domain.Comment, error)
+	FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]domain.Comment, error)
+	Delete(ctx context.Context, c domain.Comment) error
+}
+
+type CachedCommentRepository struct {
+	dao   dao.CommentDAO
+	cache cache.CommentCache
+	l     logger.Logger
+}
+
+func NewCachedCommentRepository(dao dao.CommentDAO, cache cache.CommentCache, l logger.Logger) CommentRepository {
+	return &CachedCommentRepository{
+		dao:   dao,
+		cache: cache,
+		l:     l,
+	}
+}
+
+func (r *CachedCommentRepository) AddComment(ctx context.Context, c domain.Comment) (int64, error) {
+	id, err := r.dao.Insert(ctx, r.toEntity(c))
+	if err != nil {
+		return 0, err
+	}
+	r.delFirstPage(ctx, c.Biz, c.BizId)
+	return id, nil
+}
+
+func (r *CachedCommentRepository) FindById(ctx context.Context, id int64) (domain.Comment, error) {
+	c, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	return r.toDomain(c), nil
+}
+
+func (r *CachedCommentRepository) FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]domain.Comment, error) {
+	firstPage := maxId == 0 && limit <= firstPageCommentCnt
+	queryLimit := limit
+	if firstPage {
+		res, err := r.cache.GetFirstPage(ctx, biz, bizId)
+		if err == nil {
+			return r.cut(res, limit), nil
+		}
+		// 第一页直接查够缓存的数量，后面的请求就都能命中了
+		queryLimit = firstPageCommentCnt
+	}
+	cs, err := r.dao.FindRoots(ctx, biz, bizId, maxId, queryLimit)
+	if err != nil {
+		return nil, err
+	}
+	res := slice.Map[dao.Comment, domain.Comment](cs, func(idx int, src dao.Comment) domain.Comment {
+		return r.toDomain(src)
+	})
+	var eg errgroup.Group
+	for i := range res {
+		if res[i].ReplyCnt == 0 {
+			continue
+		}
+		root := &res[i]
+		eg.Go(func() error {
+			var er error
+			root.Replies, er = r.FindReplies(ctx, root.Id, 0, previewReplyCnt)
+			return er
+		})
+	}
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	if firstPage {
+		err = r.cache.SetFirstPage(ctx, biz, bizId, res)
+		if err != nil {
+			r.l.Error("回写第一页评论缓存失败",
+				logger.String("biz", biz),
+				logger.Int64("bizId", bizId),
+				logger.Error(err))
+		}
+	}
+	// 第一页多查的那些只是用来回写缓存的
+	return r.cut(res, limit), nil
+}
+
+func (r *CachedCommentRepository) cut(res []domain.Comment, limit int) []domain.Comment {
+	if len(res) > limit {
+		return res[:limit]
+	}
+	return res
+}
+
+func (r *CachedCommentRepository) FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]domain.Comment, error) {
+	cs, err := r.dao.FindReplies(ctx, rootId, minId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Comment, domain.Comment](cs, func(idx int, src dao.Comment) domain.Comment {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *CachedCommentRepository) Delete(ctx context.Context, c domain.Comment) error {
+	err := r.dao.Delete(ctx, c.Id, c.Commentator.Id)
+	if err != nil {
+		return err
+	}
+	r.delFirstPage(ctx, c.Biz, c.BizId)
+	return nil
+}
+
+func (r *CachedCommentRepository) delFirstPage(ctx context.Context, biz string, bizId int64) {
+	err := r.cache.DelFirstPage(ctx, biz, bizId)
+	if err != nil {
+		// 缓存有过期时间，删不掉问题也不大
+		r.l.Error("删除第一页评论缓存失败",
+			logger.String("biz", biz),
+			logger.Int64("bizId", bizId),
+			logger.Error(err))
+	}
+}
+
+func (r *CachedCommentRepository) toDomain(c dao.Comment) domain.Comment {
+	return domain.Comment{
+		Id:    c.Id,
+		Biz:   c.Biz,
+		BizId: c.BizId,
+		Commentator: domain.Commentator{
+			Id: c.Uid,
+		},
+		Content:  c.Content,
+		RootId:   c.RootId,
+		ParentId: c.ParentId,
+		ReplyCnt: c.ReplyCnt,
+		Deleted:  c.Status != 0,
+		Ctime:    time.UnixMilli(c.Ctime),
+		Utime:    time.UnixMilli(c.Utime),
+	}
+}
+
+func (r *CachedCommentRepository) toEntity(c domain.Comment) dao.Comment {
+	return dao.Comment{
+		Id:       c.Id,
+		Uid:      c.Commentator.Id,
+		Biz:      c.Biz,
+		BizId:    c.BizId,
+		Content:  c.Content,
+		RootId:   c.RootId,
+		ParentId: c.ParentId,
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/cache"
+	cachemocks "webookpro/internal/repository/cache/mock"
+	"webookpro/internal/repository/dao"
+	daomocks "webookpro/internal/repository/dao/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestCachedCommentRepository_FindRoots(t *testing.T) {
+	roots := func(n int) []dao.Comment {
+		res := make([]dao.Comment, 0, n)
+		for i := n; i > 0; i-- {
+			res = append(res, dao.Comment{Id: int64(i), Biz: "article", BizId: 1})
+		}
+		return res
+	}
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (dao.CommentDAO, cache.CommentCache)
+		maxId   int64
+		limit   int
+		wantIds []int64
+	}{
+		{
+			name: "第一页缓存命中",
+			mock: func(ctrl *gomock.Controller) (dao.CommentDAO, cache.CommentCache) {
+				c := cachemocks.NewMockCommentCache(ctrl)
+				c.EXPECT().GetFirstPage(gomock.Any(), "article", int64(1)).
+					Return([]domain.Comment{{Id: 3}, {Id: 2}, {Id: 1}}, nil)
+				return daomocks.NewMockCommentDAO(ctrl), c
+			},
+			limit:   2,
+			wantIds: []int64{3, 2},
+		},
+		{
+			name: "第一页缓存未命中，查够缓存的数量，只返回要的条数",
+			mock: func(ctrl *gomock.Controller) (dao.CommentDAO, cache.CommentCache) {
+				c := cachemocks.NewMockCommentCache(ctrl)
+				c.EXPECT().GetFirstPage(gomock.Any(), "article", int64(1)).
+					Return(nil, redis.Nil)
+				d := daomocks.NewMockCommentDAO(ctrl)
+				d.EXPECT().FindRoots(gomock.Any(), "article", int64(1), int64(0), firstPageCommentCnt).
+					Return(roots(3), nil)
+				c.EXPECT().SetFirstPage(gomock.Any(), "article", int64(1), gomock.Len(3)).Return(nil)
+				return d, c
+			},
+			limit:   2,
+			wantIds: []int64{3, 2},
+		},
+		{
+			name: "后面的页不走缓存",
+			mock: func(ctrl *gomock.Controller) (dao.CommentDAO, cache.CommentCache) {
+				d := daomocks.NewMockCommentDAO(ctrl)
+				d.EXPECT().FindRoots(gomock.Any(), "article", int64(1), int64(3), 2).
+					Return(roots(2), nil)
+				return d, cachemocks.NewMockCommentCache(ctrl)
+			},
+			maxId:   3,
+			limit:   2,
+			wantIds: []int64{2, 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c := tc.mock(ctrl)
+			repo := NewCachedCommentRepository(d, c, &logger.NopLogger{})
+			cs, err := repo.FindRoots(context.Background(), "article", 1, tc.maxId, tc.limit)
+			assert.NoError(t, err)
+			ids := make([]int64, 0, len(cs))
+			for _, cm := range cs {
+				ids = append(ids, cm.Id)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
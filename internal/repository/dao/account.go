@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+	"webookpro/internal/domain"
+)
+
+// ErrUserStatusConflict 账号当前的状态不能这么改，比如恢复一个正常的账号
+var ErrUserStatusConflict = errors.New("账号状态不对")
+
+type AccountDAO interface {
+	// UpdateStatus 当前状态在 from 里面才会更新，不然返回 ErrUserStatusConflict
+	UpdateStatus(ctx context.Context, id int64, from []uint8, to uint8, deleteAt int64) error
+	// FindDeleting 冷静期已经结束的注销中账号，最早到期的在前面
+	FindDeleting(ctx context.Context, now int64, limit int) ([]int64, error)
+	// Anonymise 抹掉个人信息和两步验证的数据，只留下 id，冷静期内被恢复了的话返回 ErrUserStatusConflict
+	Anonymise(ctx context.Context, id int64, now int64) error
+}
+
+type GORMAccountDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMAccountDAO(db *gorm.DB) AccountDAO {
+	return &GORMAccountDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMAccountDAO) UpdateStatus(ctx context.Context, id int64, from []uint8, to uint8, deleteAt int64) error {
+	res := dao.db.WithContext(ctx).Model(&User{}).
+		Where("id = ? AND status IN ?", id, from).
+		Updates(map[string]any{
+			"status":    to,
+			"delete_at": deleteAt,
+			"utime":     time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrUserStatusConflict
+	}
+	return nil
+}
+
+func (dao *GORMAccountDAO) FindDeleting(ctx context.Context, now int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := dao.db.WithContext(ctx).Model(&User{}).
+		Where("status = ? AND delete_at <= ?", domain.UserStatusDeleting.ToUint8(), now).
+		Order("delete_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Anonymise 两步验证的密钥和恢复码也是跟着账号的，一起删掉
+func (dao *GORMAccountDAO) Anonymise(ctx context.Context, id int64, now int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&User{}).
+			Where("id = ? AND status = ? AND delete_at <= ?", id, domain.UserStatusDeleting.ToUint8(), now).
+			Updates(map[string]any{
+				"email":           nil,
+				"phone":           nil,
+				"wechat_open_id":  nil,
+				"wechat_union_id": nil,
+				"password":        "",
+				"nickname":        nil,
+				"bio":             "",
+				"birthday":        nil,
+				"avatar":          "",
+				"status":          domain.UserStatusDeleted.ToUint8(),
+				"utime":           now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrUserStatusConflict
+		}
+		err := tx.Where("uid = ?", id).Delete(&UserTOTP{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("uid = ?", id).Delete(&RecoveryCode{}).Error
+	})
+}
@@ -0,0 +1,212 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao/article"
+)
+
+var (
+	// ErrUserMerged 账号已经被合并到别的账号里面了
+	ErrUserMerged = errors.New("账号已经合并过了")
+	// ErrMergeTwoFactor 要被合并的账号开启了两步验证
+	ErrMergeTwoFactor = errors.New("要被合并的账号开启了两步验证")
+)
+
+type AccountMergeDAO interface {
+	Insert(ctx context.Context, m AccountMerge) (int64, error)
+	UpdateStatus(ctx context.Context, id int64, status uint8, reason string) error
+	// FindByStatus utime 早于 before 的记录，最早的在前面
+	FindByStatus(ctx context.Context, status uint8, before int64, limit int) ([]AccountMerge, error)
+	// Move 在一个事务里面把 secondary 的登录方式、帖子、评论、阅读记录这些转给 primary，
+	// 再把 secondary 标记成已合并，合并记录的状态改成 moved
+	// 任何一个账号已经被合并过了返回 ErrUserMerged，secondary 开启了两步验证返回 ErrMergeTwoFactor
+	Move(ctx context.Context, id int64, primary, secondary int64) error
+}
+
+type GORMAccountMergeDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMAccountMergeDAO(db *gorm.DB) AccountMergeDAO {
+	return &GORMAccountMergeDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMAccountMergeDAO) Insert(ctx context.Context, m AccountMerge) (int64, error) {
+	now := time.Now().UnixMilli()
+	m.Ctime = now
+	m.Utime = now
+	err := dao.db.WithContext(ctx).Create(&m).Error
+	return m.Id, err
+}
+
+func (dao *GORMAccountMergeDAO) UpdateStatus(ctx context.Context, id int64, status uint8, reason string) error {
+	return dao.db.WithContext(ctx).Model(&AccountMerge{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"status": status,
+			"reason": reason,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+func (dao *GORMAccountMergeDAO) FindByStatus(ctx context.Context, status uint8, before int64, limit int) ([]AccountMerge, error) {
+	var res []AccountMerge
+	err := dao.db.WithContext(ctx).
+		Where("status = ? AND utime < ?", status, before).
+		Order("utime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMAccountMergeDAO) Move(ctx context.Context, id int64, primary, secondary int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		// 两个账号都锁住，合并的过程中不能再绑定、解绑
+		var users []User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []int64{primary, secondary}).
+			Order("id ASC").
+			Find(&users).Error
+		if err != nil {
+			return err
+		}
+		if len(users) != 2 {
+			return gorm.ErrRecordNotFound
+		}
+		var dst, src User
+		for _, u := range users {
+			if u.MergedInto != 0 {
+				return ErrUserMerged
+			}
+			if u.Id == primary {
+				dst = u
+			} else {
+				src = u
+			}
+		}
+
+		// 两步验证是跟着 secondary 的 uid 的，登录方式转过去之后就没有第二步了
+		// 要先在 secondary 上关掉，锁住是为了合并的时候不能再开启
+		var totps []UserTOTP
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ?", secondary).
+			Find(&totps).Error
+		if err != nil {
+			return err
+		}
+		if len(totps) > 0 && totps[0].Enabled {
+			return ErrMergeTwoFactor
+		}
+
+		// primary 没有的登录方式从 secondary 那里拿过来
+		// 唯一索引的关系，要先清掉 secondary 的再更新 primary
+		fields := map[string]any{}
+		if !dst.Email.Valid && src.Email.Valid {
+			fields["email"] = src.Email
+			if dst.Password == "" {
+				fields["password"] = src.Password
+			}
+		}
+		if !dst.Phone.Valid && src.Phone.Valid {
+			fields["phone"] = src.Phone
+		}
+		if !dst.WechatOpenID.Valid && src.WechatOpenID.Valid {
+			fields["wechat_open_id"] = src.WechatOpenID
+			fields["wechat_union_id"] = src.WechatUnionID
+		}
+		err = tx.Model(&User{}).Where("id = ?", secondary).
+			Updates(map[string]any{
+				"email":           nil,
+				"phone":           nil,
+				"wechat_open_id":  nil,
+				"wechat_union_id": nil,
+				"merged_into":     primary,
+				"utime":           now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		if len(fields) > 0 {
+			fields["utime"] = now
+			err = tx.Model(&User{}).Where("id = ?", primary).Updates(fields).Error
+			if err != nil {
+				return uniqueErr(err)
+			}
+		}
+
+		for _, m := range []any{&article.Article{}, &article.PublishedArticle{},
+			&article.ArticleRevision{}, &article.Series{},
+			&article.ArticleShare{}, &article.ArticleReview{}} {
+			err = tx.Model(m).Where("author_id = ?", secondary).
+				UpdateColumn("author_id", primary).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, m := range []any{&Comment{}, &Attachment{}, &article.ArticleExport{}} {
+			err = tx.Model(m).Where("uid = ?", secondary).
+				UpdateColumn("uid", primary).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// 两个账号都是同一篇帖子的协作者，只保留 primary 的
+		err = tx.Exec("DELETE c1 FROM article_collaborators c1 JOIN article_collaborators c2 "+
+			"ON c1.article_id = c2.article_id WHERE c1.uid = ? AND c2.uid = ?",
+			secondary, primary).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&article.ArticleCollaborator{}).Where("uid = ?", secondary).
+			UpdateColumn("uid", primary).Error
+		if err != nil {
+			return err
+		}
+		// 帖子转过来之后 primary 自己就是作者了，不用再当协作者
+		err = tx.Exec("DELETE c FROM article_collaborators c JOIN articles a "+
+			"ON c.article_id = a.id WHERE c.uid = ? AND a.author_id = ?",
+			primary, primary).Error
+		if err != nil {
+			return err
+		}
+
+		// 两个账号都看过的，保留 primary 的阅读记录
+		err = tx.Exec("DELETE h1 FROM history_records h1 JOIN history_records h2 "+
+			"ON h1.biz = h2.biz AND h1.biz_id = h2.biz_id WHERE h1.uid = ? AND h2.uid = ?",
+			secondary, primary).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&HistoryRecord{}).Where("uid = ?", secondary).
+			UpdateColumn("uid", primary).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&AccountMerge{}).
+			Where("id = ? AND status = ?", id, domain.AccountMergeStatusPending.ToUint8()).
+			Updates(map[string]any{
+				"status": domain.AccountMergeStatusMoved.ToUint8(),
+				"utime":  now,
+			}).Error
+	})
+}
+
+// AccountMerge 合并账号的审计记录，只会改状态，不会删除
+type AccountMerge struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	PrimaryId   int64  `gorm:"index"`
+	SecondaryId int64  `gorm:"index"`
+	Status      uint8  `gorm:"index:idx_status_utime"`
+	Reason      string `gorm:"type:varchar(1024)"`
+	Ctime       int64
+	Utime       int64 `gorm:"index:idx_status_utime"`
+}
@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestGORMAccountMergeDAO_Move(t *testing.T) {
+	userCols := []string{"id", "email", "password", "phone", "wechat_open_id", "merged_into"}
+	testcases := []struct {
+		name    string
+		wantErr error
+		sqlmock func(t *testing.T) *sql.DB
+	}{
+		{
+			name: "合并成功",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `users` WHERE id IN .* FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows(userCols).
+						AddRow(1, nil, "", "13800000000", nil, 0).
+						AddRow(2, "123@qq.com", "hash", nil, nil, 0))
+				mock.ExpectQuery("SELECT \\* FROM `user_totps` WHERE uid = .* FOR UPDATE").
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"uid", "enabled"}))
+				// 先清掉被合并账号的登录方式，再把邮箱和密码给主账号
+				mock.ExpectExec("UPDATE `users` SET .*`merged_into`=.* WHERE id = .*").
+					WithArgs(nil, int64(1), nil, sqlmock.AnyArg(), nil, nil, int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE `users` SET `email`=.*,`password`=.*,`utime`=.* WHERE id = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				for _, table := range []string{"articles", "published_articles", "article_revisions",
+					"series", "article_shares", "article_reviews"} {
+					mock.ExpectExec("UPDATE `"+table+"` SET `author_id`=.* WHERE author_id = .*").
+						WithArgs(int64(1), int64(2)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+				for _, table := range []string{"comments", "attachments", "article_exports"} {
+					mock.ExpectExec("UPDATE `"+table+"` SET `uid`=.* WHERE uid = .*").
+						WithArgs(int64(1), int64(2)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+				mock.ExpectExec("DELETE c1 FROM article_collaborators c1 .*").
+					WithArgs(int64(2), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE `article_collaborators` SET `uid`=.* WHERE uid = .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE c FROM article_collaborators c JOIN articles a .*").
+					WithArgs(int64(1), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("DELETE h1 FROM history_records h1 .*").
+					WithArgs(int64(2), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE `history_records` SET `uid`=.* WHERE uid = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE `account_merges` SET .* WHERE id = .* AND status = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return mockDB
+			},
+		},
+		{
+			name:    "已经合并过了",
+			wantErr: ErrUserMerged,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `users` .*").
+					WillReturnRows(sqlmock.NewRows(userCols).
+						AddRow(1, nil, "", "13800000000", nil, 0).
+						AddRow(2, nil, "", nil, nil, 3))
+				mock.ExpectRollback()
+				return mockDB
+			},
+		},
+		{
+			name:    "要被合并的账号开启了两步验证",
+			wantErr: ErrMergeTwoFactor,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `users` .*").
+					WillReturnRows(sqlmock.NewRows(userCols).
+						AddRow(1, nil, "", "13800000000", nil, 0).
+						AddRow(2, "123@qq.com", "hash", nil, nil, 0))
+				mock.ExpectQuery("SELECT \\* FROM `user_totps` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"uid", "enabled"}).AddRow(2, true))
+				mock.ExpectRollback()
+				return mockDB
+			},
+		},
+		{
+			name:    "账号不存在",
+			wantErr: gorm.ErrRecordNotFound,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `users` .*").
+					WillReturnRows(sqlmock.NewRows(userCols).
+						AddRow(1, nil, "", "13800000000", nil, 0))
+				mock.ExpectRollback()
+				return mockDB
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+				Conn:                      tc.sqlmock(t),
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			err = NewGORMAccountMergeDAO(db).Move(context.Background(), 10, 1, 2)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+	"webookpro/internal/domain"
+)
+
+func TestGORMAccountDAO_UpdateStatus(t *testing.T) {
+	testcases := []struct {
+		name    string
+		to      domain.UserStatus
+		wantErr error
+		sqlmock func(t *testing.T) *sql.DB
+	}{
+		{
+			name: "停用",
+			to:   domain.UserStatusDeactivated,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .* WHERE id = .* AND status IN .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				return mockDB
+			},
+		},
+		{
+			name:    "状态不对",
+			to:      domain.UserStatusDeactivated,
+			wantErr: ErrUserStatusConflict,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				return mockDB
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+				Conn:                      tc.sqlmock(t),
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			err = NewGORMAccountDAO(db).UpdateStatus(context.Background(), 1,
+				[]uint8{domain.UserStatusActive.ToUint8()}, tc.to.ToUint8(), 0)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestGORMAccountDAO_Anonymise(t *testing.T) {
+	testcases := []struct {
+		name    string
+		wantErr error
+		sqlmock func(t *testing.T) (*sql.DB, sqlmock.Sqlmock)
+	}{
+		{
+			name: "两步验证的数据一起删掉",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `users` SET .* WHERE id = .* AND status = .* AND delete_at <= .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM `user_totps` WHERE uid = \\?").
+					WithArgs(int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM `recovery_codes` WHERE uid = \\?").
+					WithArgs(int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+		},
+		{
+			name:    "冷静期内被恢复了",
+			wantErr: ErrUserStatusConflict,
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `users` SET .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+				return mockDB, mock
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock := tc.sqlmock(t)
+			err := NewGORMAccountDAO(newMockGormDB(t, mockDB)).Anonymise(context.Background(), 1, 100)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// ErrCollaboratorNotFound 用户不是这篇帖子的协作者
+var ErrCollaboratorNotFound = errors.New("协作者不存在")
+
+// ArticleCollaborator 帖子的协作者，创作者本身不在这里，还是看 Article.AuthorId
+type ArticleCollaborator struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:uk_art_uid"`
+	// 创作者的帖子列表要按照 uid 查协作的帖子
+	Uid   int64 `gorm:"uniqueIndex:uk_art_uid;index"`
+	Role  uint8
+	Ctime int64
+	Utime int64
+}
+
+type CollaboratorDAO interface {
+	// Upsert 已经是协作者的话修改角色
+	Upsert(ctx context.Context, c ArticleCollaborator) error
+	Delete(ctx context.Context, artId int64, uid int64) error
+	Find(ctx context.Context, artId int64, uid int64) (ArticleCollaborator, error)
+	// FindByArticle 按照加入的时间排序
+	FindByArticle(ctx context.Context, artId int64) ([]ArticleCollaborator, error)
+}
+
+type GORMCollaboratorDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollaboratorDAO(db *gorm.DB) CollaboratorDAO {
+	return &GORMCollaboratorDAO{
+		db: db,
+	}
+}
+
+func (d *GORMCollaboratorDAO) Upsert(ctx context.Context, c ArticleCollaborator) error {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"role":  c.Role,
+			"utime": now,
+		}),
+	}).Create(&c).Error
+}
+
+func (d *GORMCollaboratorDAO) Delete(ctx context.Context, artId int64, uid int64) error {
+	res := d.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", artId, uid).
+		Delete(&ArticleCollaborator{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+func (d *GORMCollaboratorDAO) Find(ctx context.Context, artId int64, uid int64) (ArticleCollaborator, error) {
+	var c ArticleCollaborator
+	err := d.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", artId, uid).
+		First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ArticleCollaborator{}, ErrCollaboratorNotFound
+	}
+	return c, err
+}
+
+func (d *GORMCollaboratorDAO) FindByArticle(ctx context.Context, artId int64) ([]ArticleCollaborator, error) {
+	var res []ArticleCollaborator
+	err := d.db.WithContext(ctx).
+		Where("article_id = ?", artId).
+		Order("id ASC").
+		Find(&res).Error
+	return res, err
+}
@@ -1,5 +1,11 @@
 package article
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
 type Article struct {
 	Id int64 `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
 	// 标题的长度
@@ -9,12 +15,111 @@ type Article struct {
 	// 作者
 	AuthorId int64 `gorm:"index" bson:"author_id,omitempty"`
 	Status   uint8 `bson:"status,omitempty"`
-	Ctime    int64 `bson:"ctime,omitempty"`
-	Utime    int64 `bson:"utime,omitempty"`
+	// PublishAt 定时发表的时间，毫秒数
+	PublishAt int64  `gorm:"index" bson:"publish_at,omitempty"`
+	Category  string `gorm:"type:varchar(64);index" bson:"category,omitempty"`
+	Tags      Tags   `gorm:"type:varchar(1024)" bson:"tags,omitempty"`
+	// Dtime 放进回收站的时间，毫秒数，清理回收站按照这个字段来找
+	Dtime int64 `gorm:"index" bson:"dtime,omitempty"`
+	// Version 每次更新加一，更新的时候带上客户端编辑的版本，对不上就是被别人改过了
+	// 加字段之前的帖子默认是 1
+	Version int64 `gorm:"not null;default:1" bson:"version,omitempty"`
+	Ctime   int64 `bson:"ctime,omitempty"`
+	// 按照 utime, id 游标翻页要用到这个索引
+	Utime int64 `gorm:"index" bson:"utime,omitempty"`
+}
+
+// Tags 帖子的标签，MySQL 里面存成 JSON 字符串，MongoDB 里面直接就是数组
+// 按照标签查询走的是 PublishedArticleTag，这个字段只是用来展示的
+type Tags []string
+
+func (t Tags) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return "[]", nil
+	}
+	val, err := json.Marshal(t)
+	return string(val), err
+}
+
+func (t *Tags) Scan(src any) error {
+	var bs []byte
+	switch val := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		bs = val
+	case string:
+		bs = []byte(val)
+	default:
+		return fmt.Errorf("Tags 不支持的类型 %T", src)
+	}
+	if len(bs) == 0 {
+		*t = nil
+		return nil
+	}
+	return json.Unmarshal(bs, t)
+}
+
+// PublishedArticleTag 线上库的标签索引，只有已发表的帖子才会有
+// 一篇帖子一个标签一行，发表的时候整体替换，撤回的时候删掉
+type PublishedArticleTag struct {
+	Id        int64  `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64  `gorm:"uniqueIndex:uk_art_tag"`
+	Tag       string `gorm:"type:varchar(64);uniqueIndex:uk_art_tag;index:idx_tag_utime"`
+	Utime     int64  `gorm:"index:idx_tag_utime"`
+}
+
+// TagCount 每个标签下已发表的帖子数量，不是表
+type TagCount struct {
+	Tag string `bson:"_id"`
+	Cnt int64  `bson:"cnt"`
 }
 
 type PublishedArticle struct {
-	Article
+	// MongoDB 里面不写 inline 的话会变成一个叫 article 的子文档
+	Article `bson:",inline"`
+	// Html 发表的时候由 Content 渲染出来的，已经过滤掉了不安全的标签和链接
+	Html string `gorm:"type:BLOB" bson:"html,omitempty"`
+	Toc  Toc    `gorm:"type:text" bson:"toc,omitempty"`
+}
+
+// TocItem 目录里面的一个标题
+type TocItem struct {
+	Level int    `json:"level" bson:"level"`
+	Id    string `json:"id" bson:"id"`
+	Title string `json:"title" bson:"title"`
+}
+
+// Toc 帖子的目录，和 Tags 一样，MySQL 里面存成 JSON 字符串
+type Toc []TocItem
+
+func (t Toc) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return "[]", nil
+	}
+	val, err := json.Marshal(t)
+	return string(val), err
+}
+
+func (t *Toc) Scan(src any) error {
+	var bs []byte
+	switch val := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		bs = val
+	case string:
+		bs = []byte(val)
+	default:
+		return fmt.Errorf("Toc 不支持的类型 %T", src)
+	}
+	if len(bs) == 0 {
+		*t = nil
+		return nil
+	}
+	return json.Unmarshal(bs, t)
 }
 
 type PublishedArticleV1 struct {
@@ -25,3 +130,14 @@ type PublishedArticleV1 struct {
 	Ctime    int64  `bson:"ctime,omitempty"`
 	Utime    int64  `bson:"utime,omitempty"`
 }
+
+// ArticleRevision 制作库的历史版本，只插入，不更新
+type ArticleRevision struct {
+	Id        int64  `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
+	ArticleId int64  `gorm:"index:idx_art_id_utime" bson:"article_id,omitempty"`
+	Title     string `gorm:"type:varchar(4096)" bson:"title,omitempty"`
+	Content   string `gorm:"type:BLOB" bson:"content,omitempty"`
+	AuthorId  int64  `bson:"author_id,omitempty"`
+	Status    uint8  `bson:"status,omitempty"`
+	Utime     int64  `gorm:"index:idx_art_id_utime" bson:"utime,omitempty"`
+}
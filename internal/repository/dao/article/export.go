@@ -0,0 +1,121 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+	"webookpro/internal/domain"
+)
+
+var (
+	// ErrExportNotFound 导出任务不存在，或者不是这个用户的
+	ErrExportNotFound = errors.New("导出任务不存在")
+	// ErrExportTaken 导出任务已经被别的实例拿走了
+	ErrExportTaken = errors.New("导出任务已经在打包了")
+)
+
+// ArticleExport 导出任务，打包好的 zip 在对象存储里面，这里只记 key
+type ArticleExport struct {
+	Id     int64 `gorm:"primaryKey,autoIncrement"`
+	Uid    int64 `gorm:"index"`
+	Status uint8 `gorm:"index:idx_status_utime"`
+	// ObjectKey 对象存储里面的 key
+	ObjectKey string
+	Cnt       int
+	Size      int64
+	Ctime     int64
+	Utime     int64 `gorm:"index:idx_status_utime"`
+}
+
+type ExportDAO interface {
+	Insert(ctx context.Context, e ArticleExport) (int64, error)
+	FindById(ctx context.Context, id int64) (ArticleExport, error)
+	// FindByUid 最新的在前面
+	FindByUid(ctx context.Context, uid int64, limit int) ([]ArticleExport, error)
+	// FindRunnable 还没开始的，以及开始了但是 staleBefore 之后就没动静的
+	FindRunnable(ctx context.Context, staleBefore int64, limit int) ([]ArticleExport, error)
+	// Start 抢占导出任务，已经被别人拿走了返回 ErrExportTaken
+	Start(ctx context.Context, id int64, staleBefore int64) error
+	Finish(ctx context.Context, e ArticleExport) error
+}
+
+type GORMExportDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMExportDAO(db *gorm.DB) ExportDAO {
+	return &GORMExportDAO{
+		db: db,
+	}
+}
+
+func (d *GORMExportDAO) Insert(ctx context.Context, e ArticleExport) (int64, error) {
+	now := time.Now().UnixMilli()
+	e.Ctime = now
+	e.Utime = now
+	err := d.db.WithContext(ctx).Create(&e).Error
+	return e.Id, err
+}
+
+func (d *GORMExportDAO) FindById(ctx context.Context, id int64) (ArticleExport, error) {
+	var e ArticleExport
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ArticleExport{}, ErrExportNotFound
+	}
+	return e, err
+}
+
+func (d *GORMExportDAO) FindByUid(ctx context.Context, uid int64, limit int) ([]ArticleExport, error) {
+	var res []ArticleExport
+	err := d.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Order("id DESC").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMExportDAO) FindRunnable(ctx context.Context, staleBefore int64, limit int) ([]ArticleExport, error) {
+	var res []ArticleExport
+	err := d.runnable(d.db.WithContext(ctx), staleBefore).
+		Order("id ASC").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMExportDAO) Start(ctx context.Context, id int64, staleBefore int64) error {
+	// 判断状态和更新放在同一个 UPDATE 里面，多个实例同时跑也只有一个能抢到
+	res := d.runnable(d.db.WithContext(ctx).Model(&ArticleExport{}), staleBefore).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status": domain.ArticleExportStatusRunning.ToUint8(),
+			"utime":  time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrExportTaken
+	}
+	return nil
+}
+
+func (d *GORMExportDAO) Finish(ctx context.Context, e ArticleExport) error {
+	return d.db.WithContext(ctx).Model(&ArticleExport{}).
+		Where("id = ?", e.Id).
+		Updates(map[string]any{
+			"status":     e.Status,
+			"object_key": e.ObjectKey,
+			"cnt":        e.Cnt,
+			"size":       e.Size,
+			"utime":      time.Now().UnixMilli(),
+		}).Error
+}
+
+// runnable 进程在打包的过程中挂了，任务会一直是打包中，超过 staleBefore 就重新来过
+func (d *GORMExportDAO) runnable(db *gorm.DB, staleBefore int64) *gorm.DB {
+	return db.Where("status = ? OR (status = ? AND utime < ?)",
+		domain.ArticleExportStatusPending.ToUint8(),
+		domain.ArticleExportStatusRunning.ToUint8(), staleBefore)
+}
@@ -21,12 +21,20 @@ func NewGORMArticleDAO(db *gorm.DB) ArticleDAO {
 
 func (d *GORMArticleDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error) {
 	var res []PublishedArticle
+	// 作者停用或者注销了账号的帖子不出现在列表里面
 	err := d.db.WithContext(ctx).
-		Where("utime < ?", start.UnixMilli()).
+		Where("utime < ? AND status <> ?", start.UnixMilli(), domain.ArticleStatusHidden.ToUint8()).
 		Order("utime DESC").Offset(offset).Limit(limit).Find(&res).Error
 	return res, err
 }
 
+func (d *GORMArticleDAO) ListPubCursor(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := withCursor(d.db.WithContext(ctx).Where("status <> ?", domain.ArticleStatusHidden.ToUint8()), cursor).
+		Order("utime DESC, id DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
 func (d *GORMArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
 	var art PublishedArticle
 	err := d.db.WithContext(ctx).Model(&PublishedArticle{}).Where("id = ?", id).First(&art).Error
@@ -45,10 +53,11 @@ func (d *GORMArticleDAO) GetById(ctx context.Context, artId int64) (Article, err
 	return art, err
 }
 
-// GetByAuthor 通过authorid 获取 创作者文章列表
+// GetByAuthor 通过authorid 获取 创作者文章列表，包括协作的帖子
 func (d *GORMArticleDAO) GetByAuthor(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error) {
 	var res []Article
-	err := d.db.WithContext(ctx).Model(&Article{}).Where("author_id = ?", authorId).
+	err := d.withAuthor(d.db.WithContext(ctx).Model(&Article{}), authorId).
+		Where("status <> ?", domain.ArticleStatusDeleted).
 		Offset(offset).
 		Limit(limit).
 		Order("utime DESC").
@@ -56,11 +65,40 @@ func (d *GORMArticleDAO) GetByAuthor(ctx context.Context, authorId int64, offset
 	return res, err
 }
 
+func (d *GORMArticleDAO) GetByAuthorCursor(ctx context.Context, authorId int64, cursor Cursor, limit int) ([]Article, error) {
+	var res []Article
+	err := withCursor(d.withAuthor(d.db.WithContext(ctx), authorId).
+		Where("status <> ?", domain.ArticleStatusDeleted), cursor).
+		Order("utime DESC, id DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+// withAuthor 自己创作的帖子，加上作为协作者参与的帖子
+func (d *GORMArticleDAO) withAuthor(db *gorm.DB, authorId int64) *gorm.DB {
+	collaborating := d.db.Model(&ArticleCollaborator{}).
+		Select("article_id").Where("uid = ?", authorId)
+	return db.Where("(author_id = ? OR id IN (?))", authorId, collaborating)
+}
+
+// withCursor 游标条件 (utime, id) < (?, ?)，没有直接用行比较是因为有些 MySQL 版本用不上索引
+func withCursor(db *gorm.DB, cursor Cursor) *gorm.DB {
+	if cursor.Utime == 0 {
+		return db
+	}
+	if cursor.Id == 0 {
+		return db.Where("utime < ?", cursor.Utime)
+	}
+	return db.Where("utime < ? OR (utime = ? AND id < ?)",
+		cursor.Utime, cursor.Utime, cursor.Id)
+}
+
 // SyncStatus 同步线上库制作库帖子状态
 func (d *GORMArticleDAO) SyncStatus(ctx context.Context, article Article, status domain.ArticleStatus) error {
 	now := time.Now().UnixMilli()
 	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		res := d.db.Model(&Article{}).Where("id = ? AND author_id = ?", article.Id, article.AuthorId).
+		// 回收站里面的帖子不能直接撤回，只能恢复
+		res := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status <> ?", article.Id, article.AuthorId, domain.ArticleStatusDeleted).
 			Updates(map[string]any{
 				"status": domain.ArticleStatusUnpublished,
 				"utime":  now,
@@ -70,17 +108,44 @@ func (d *GORMArticleDAO) SyncStatus(ctx context.Context, article Article, status
 		}
 		if res.RowsAffected != 1 {
 			// 要么 用户不对 ，要么文章 id不对
-			return fmt.Errorf("非法操作 Uid:%s, artId:%s", article.Id, article.AuthorId)
+			return fmt.Errorf("非法操作 artId: %d, authorId: %d", article.Id, article.AuthorId)
 		}
-		return d.db.Model(&PublishedArticle{}).Where("id = ? AND author_id = ?", article.Id, article.AuthorId).
+		err := tx.Model(&PublishedArticle{}).Where("id = ? AND author_id = ?", article.Id, article.AuthorId).
 			Updates(map[string]any{
 				"status": domain.ArticleStatusUnpublished,
 				"utime":  now,
 			}).Error
+		if err != nil {
+			return err
+		}
+		// 撤回之后，按照标签就查不到了
+		return tx.Where("article_id = ?", article.Id).Delete(&PublishedArticleTag{}).Error
 	})
 	return err
 }
 
+func (d *GORMArticleDAO) SetAuthorPubStatus(ctx context.Context, authorId int64, ids []int64,
+	from, to domain.ArticleStatus) ([]int64, error) {
+	var res []int64
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&PublishedArticle{}).Where("author_id = ? AND status = ?", authorId, from)
+		if len(ids) > 0 {
+			query = query.Where("id IN ?", ids)
+		}
+		err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &res).Error
+		if err != nil || len(res) == 0 {
+			return err
+		}
+		// 不动 utime，恢复之后帖子还在原来的位置
+		return tx.Model(&PublishedArticle{}).Where("id IN ?", res).
+			UpdateColumn("status", to).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // Upsert 线上库upsert
 func (d *GORMArticleDAO) Upsert(ctx context.Context, art PublishedArticle) error {
 	now := time.Now().UnixMilli()
@@ -101,10 +166,14 @@ func (d *GORMArticleDAO) Upsert(ctx context.Context, art PublishedArticle) error
 
 		// MySQL 只需要关心这里
 		DoUpdates: clause.Assignments(map[string]interface{}{
-			"title":   art.Title,
-			"content": art.Content,
-			"utime":   now,
-			"status":  art.Status,
+			"title":    art.Title,
+			"content":  art.Content,
+			"utime":    now,
+			"status":   art.Status,
+			"category": art.Category,
+			"tags":     art.Tags,
+			"html":     art.Html,
+			"toc":      art.Toc,
 		}),
 	}).Create(&art).Error
 	// MySQL 最终的语句 INSERT xxx ON DUPLICATE KEY UPDATE xxx
@@ -116,9 +185,10 @@ func (d *GORMArticleDAO) Upsert(ctx context.Context, art PublishedArticle) error
 }
 
 // Sync 帖子发表接口同步线上库和制作库数据
-func (d *GORMArticleDAO) Sync(ctx context.Context, art Article) (int64, error) {
+func (d *GORMArticleDAO) Sync(ctx context.Context, publishArt PublishedArticle) (int64, error) {
 	now := time.Now().UnixMilli()
 	var (
+		art   = publishArt.Article
 		artId = art.Id
 		err   error
 	)
@@ -135,23 +205,33 @@ func (d *GORMArticleDAO) Sync(ctx context.Context, art Article) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	// 保证线上库和制作库的 id 是一样的
+	art.Id = artId
 	// upsert 线上库
-	publishArt := PublishedArticle{Article: art}
+	publishArt.Article = art
 	publishArt.Utime = now
 	publishArt.Ctime = now
 	err = tx.Clauses(clause.OnConflict{
 		// ID 冲突的时候。实际上，在 MYSQL 里面你写不写都可以
 		Columns: []clause.Column{{Name: "id"}},
 		DoUpdates: clause.Assignments(map[string]interface{}{
-			"title":   art.Title,
-			"content": art.Content,
-			"status":  art.Status,
-			"utime":   now,
+			"title":    art.Title,
+			"content":  art.Content,
+			"status":   art.Status,
+			"category": art.Category,
+			"tags":     art.Tags,
+			"html":     publishArt.Html,
+			"toc":      publishArt.Toc,
+			"utime":    now,
 		}),
 	}).Create(&publishArt).Error
 	if err != nil {
 		return 0, err
 	}
+	err = syncTags(tx, artId, art.Tags, now)
+	if err != nil {
+		return 0, err
+	}
 	tx.Commit()
 	return artId, err
 }
@@ -160,28 +240,327 @@ func (d *GORMArticleDAO) Insert(ctx context.Context, article Article) (int64, er
 	now := time.Now().UnixMilli()
 	article.Ctime = now
 	article.Utime = now
-	err := d.db.WithContext(ctx).Create(&article).Error
+	article.Version = 1
+	// 新建的同时记录第一个历史版本
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&article).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(newRevision(article)).Error
+	})
 	return article.Id, err
 }
 
 func (d *GORMArticleDAO) UpdateById(ctx context.Context, article Article) error {
 	now := time.Now().UnixMilli()
 	article.Utime = now
-	res := d.db.WithContext(ctx).Model(&article).Where("id = ? AND author_id = ?", article.Id, article.AuthorId).
+	// 更新和记录历史版本要在一个事务里面，不然就可能出现改了内容但是没有历史版本的情况
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 回收站里面的帖子要先恢复才能编辑
+		query := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status <> ?", article.Id, article.AuthorId, domain.ArticleStatusDeleted).
+			Session(&gorm.Session{})
+		update := query
+		if article.Version > 0 {
+			update = update.Where("version = ?", article.Version)
+		}
+		res := update.Updates(map[string]any{
+			"title":      article.Title,
+			"content":    article.Content,
+			"utime":      now,
+			"status":     article.Status,
+			"publish_at": article.PublishAt,
+			"category":   article.Category,
+			"tags":       article.Tags,
+			"version":    gorm.Expr("version + 1"),
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 帖子还在的话就是版本对不上
+			var cnt int64
+			err := query.Count(&cnt).Error
+			if err != nil {
+				return err
+			}
+			if cnt > 0 {
+				return ErrVersionConflict
+			}
+			// 此时可能是id 不会或 Authorid 不对
+			return fmt.Errorf("更新失败，可能是创作者非法 id %d, author_id %d",
+				article.Id, article.AuthorId)
+		}
+		return tx.Create(newRevision(article)).Error
+	})
+}
+
+// ListRevisions 历史版本列表，带上 author_id 是为了防止看到别人的历史版本
+func (d *GORMArticleDAO) ListRevisions(ctx context.Context, artId int64, authorId int64, offset int, limit int) ([]ArticleRevision, error) {
+	var res []ArticleRevision
+	err := d.db.WithContext(ctx).
+		Where("article_id = ? AND author_id = ?", artId, authorId).
+		Order("utime DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) GetRevision(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) ListScheduled(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error) {
+	var res []Article
+	err := d.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", authorId, domain.ArticleStatusScheduled).
+		Order("publish_at ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) ListDueScheduled(ctx context.Context, now int64, limit int) ([]Article, error) {
+	var res []Article
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", domain.ArticleStatusScheduled, now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// Reschedule 修改定时发表的时间，只有还处于定时发表状态的帖子才能改
+func (d *GORMArticleDAO) Reschedule(ctx context.Context, id int64, authorId int64, publishAt int64) error {
+	res := d.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ?", id, authorId, domain.ArticleStatusScheduled).
+		Updates(map[string]any{
+			"publish_at": publishAt,
+			"utime":      time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+// CancelSchedule 取消定时发表，帖子回到未发表的状态
+func (d *GORMArticleDAO) CancelSchedule(ctx context.Context, id int64, authorId int64) error {
+	res := d.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ?", id, authorId, domain.ArticleStatusScheduled).
 		Updates(map[string]any{
-			"title":   article.Title,
-			"content": article.Content,
-			"utime":   now,
-			"status":  article.Status,
+			"status":     domain.ArticleStatusUnpublished,
+			"publish_at": 0,
+			"utime":      time.Now().UnixMilli(),
 		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+// Delete 放进回收站，线上库和标签直接删掉，恢复之后要重新发表
+func (d *GORMArticleDAO) Delete(ctx context.Context, id int64, authorId int64) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status <> ?", id, authorId, domain.ArticleStatusDeleted).
+			Updates(map[string]any{
+				"status":     domain.ArticleStatusDeleted,
+				"publish_at": 0,
+				"dtime":      now,
+				"utime":      now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrArticleNotFound
+		}
+		err := tx.Where("id = ?", id).Delete(&PublishedArticle{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("article_id = ?", id).Delete(&PublishedArticleTag{}).Error
+	})
+}
+
+func (d *GORMArticleDAO) ListDeleted(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error) {
+	var res []Article
+	err := d.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", authorId, domain.ArticleStatusDeleted).
+		Order("dtime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
 
+func (d *GORMArticleDAO) Restore(ctx context.Context, id int64, authorId int64, after int64) error {
+	res := d.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ? AND dtime >= ?",
+			id, authorId, domain.ArticleStatusDeleted, after).
+		Updates(map[string]any{
+			"status": domain.ArticleStatusUnpublished,
+			"dtime":  0,
+			"utime":  time.Now().UnixMilli(),
+		})
 	if res.Error != nil {
 		return res.Error
 	}
 	if res.RowsAffected == 0 {
-		// 此时可能是id 不会或 Authorid 不对
-		return fmt.Errorf("更新失败，可能是创作者非法 id %d, author_id %d",
-			article.Id, article.AuthorId)
+		return ErrArticleNotFound
 	}
 	return nil
 }
+
+func (d *GORMArticleDAO) ListPurgeable(ctx context.Context, before int64, limit int) ([]Article, error) {
+	var res []Article
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND dtime < ?", domain.ArticleStatusDeleted, before).
+		Order("dtime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) Purge(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 查询和删除之间帖子可能被恢复了，只删还在回收站里面的
+		var deleted []int64
+		err := tx.Model(&Article{}).
+			Where("id IN ? AND status = ?", ids, domain.ArticleStatusDeleted).
+			Pluck("id", &deleted).Error
+		if err != nil || len(deleted) == 0 {
+			return err
+		}
+		err = tx.Where("id IN ?", deleted).Delete(&Article{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&ArticleRevision{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("id IN ?", deleted).Delete(&PublishedArticle{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&PublishedArticleTag{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&ArticleReview{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&SeriesArticle{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&ArticleShare{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", deleted).Delete(&ArticleCollaborator{}).Error
+		if err != nil {
+			return err
+		}
+		return unbindAttachments(tx, deleted)
+	})
+}
+
+// unbindAttachments 去掉帖子对附件的引用，没有别的帖子引用的附件会被定时任务清理
+// 附件的表定义在 dao 包里面，这边引用不了，只能直接写表名
+func unbindAttachments(tx *gorm.DB, artIds []int64) error {
+	var attIds []int64
+	err := tx.Table("attachment_refs").
+		Where("article_id IN ?", artIds).
+		Distinct().
+		Pluck("attachment_id", &attIds).Error
+	if err != nil || len(attIds) == 0 {
+		return err
+	}
+	err = tx.Exec("DELETE FROM attachment_refs WHERE article_id IN ?", artIds).Error
+	if err != nil {
+		return err
+	}
+	return tx.Table("attachments").Where("id IN ?", attIds).
+		UpdateColumn("utime", time.Now().UnixMilli()).Error
+}
+
+func (d *GORMArticleDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := d.db.WithContext(ctx).Model(&PublishedArticle{}).
+		Select("published_articles.*").
+		Joins("JOIN published_article_tags t ON t.article_id = published_articles.id").
+		Where("t.tag = ? AND published_articles.status = ?", tag, domain.ArticleStatusPublished).
+		Order("t.utime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := d.db.WithContext(ctx).
+		Where("category = ? AND status = ?", category, domain.ArticleStatusPublished).
+		Order("utime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleDAO) CountPubTags(ctx context.Context, limit int) ([]TagCount, error) {
+	var res []TagCount
+	// 作者停用了账号的帖子标签还在，要按线上库的状态过滤
+	err := d.db.WithContext(ctx).Model(&PublishedArticleTag{}).
+		Select("published_article_tags.tag, COUNT(*) AS cnt").
+		Joins("JOIN published_articles a ON a.id = published_article_tags.article_id").
+		Where("a.status = ?", domain.ArticleStatusPublished).
+		Group("published_article_tags.tag").
+		Order("cnt DESC, tag ASC").
+		Limit(limit).
+		Scan(&res).Error
+	return res, err
+}
+
+// syncTags 用帖子最新的标签整体替换掉线上库的标签索引，要在发表的事务里面调用
+func syncTags(tx *gorm.DB, artId int64, tags Tags, now int64) error {
+	err := tx.Where("article_id = ?", artId).Delete(&PublishedArticleTag{}).Error
+	if err != nil || len(tags) == 0 {
+		return err
+	}
+	rows := make([]PublishedArticleTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, PublishedArticleTag{
+			ArticleId: artId,
+			Tag:       tag,
+			Utime:     now,
+		})
+	}
+	return tx.Create(&rows).Error
+}
+
+// newRevision 根据制作库的数据生成一个历史版本
+func newRevision(art Article) *ArticleRevision {
+	return &ArticleRevision{
+		ArticleId: art.Id,
+		Title:     art.Title,
+		Content:   art.Content,
+		AuthorId:  art.AuthorId,
+		Status:    art.Status,
+		Utime:     art.Utime,
+	}
+}
@@ -0,0 +1,170 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/internal/domain"
+)
+
+func TestGORMArticleDAO_UpdateById(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		art     Article
+		wantErr error
+	}{
+		{
+			name: "版本号一致",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `articles` SET .*`version`=version \\+ 1.* AND version = \\?").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO `article_revisions` .*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			art: Article{Id: 1, AuthorId: 123, Title: "标题", Version: 3},
+		},
+		{
+			name: "版本号过期了",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `articles` .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM `articles` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			art:     Article{Id: 1, AuthorId: 123, Title: "标题", Version: 2},
+			wantErr: ErrVersionConflict,
+		},
+		{
+			name: "不是自己的帖子",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `articles` .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM `articles` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			art:     Article{Id: 1, AuthorId: 234, Title: "标题", Version: 3},
+			wantErr: errors.New("更新失败，可能是创作者非法 id 1, author_id 234"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tc.mock(mock)
+			d := NewGORMArticleDAO(newMockGormDB(t, mockDB))
+			err = d.UpdateById(context.Background(), tc.art)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMArticleDAO_Purge(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT `id` FROM `articles` WHERE id IN .* AND status = .*").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	for _, table := range []string{"articles", "article_revisions", "published_articles",
+		"published_article_tags", "article_reviews", "series_articles",
+		"article_shares", "article_collaborators"} {
+		mock.ExpectExec("DELETE FROM `" + table + "` .*").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	// 帖子引用的附件要解除引用，不然永远不会被清理
+	mock.ExpectQuery("SELECT DISTINCT `attachment_id` FROM `attachment_refs` WHERE article_id IN \\(\\?\\)").
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"attachment_id"}).AddRow(5))
+	mock.ExpectExec("DELETE FROM attachment_refs WHERE article_id IN \\(\\?\\)").
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `attachments` SET `utime`=\\? WHERE id IN \\(\\?\\)").
+		WithArgs(sqlmock.AnyArg(), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	d := NewGORMArticleDAO(newMockGormDB(t, mockDB))
+	err = d.Purge(context.Background(), []int64{1, 2})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGORMArticleDAO_CountPubTags(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery("SELECT published_article_tags.tag, COUNT\\(\\*\\) AS cnt FROM `published_article_tags` " +
+		"JOIN published_articles a ON a.id = published_article_tags.article_id WHERE a.status = \\? " +
+		"GROUP BY `published_article_tags`.`tag` ORDER BY cnt DESC, tag ASC LIMIT 10").
+		WithArgs(domain.ArticleStatusPublished).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "cnt"}).AddRow("Go", 2))
+
+	d := NewGORMArticleDAO(newMockGormDB(t, mockDB))
+	res, err := d.CountPubTags(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, []TagCount{{Tag: "Go", Cnt: 2}}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGORMArticleDAO_SetAuthorPubStatus(t *testing.T) {
+	testcases := []struct {
+		name    string
+		sqlmock func(t *testing.T) (*sql.DB, sqlmock.Sqlmock)
+		ids     []int64
+		wantIds []int64
+	}{
+		{
+			name: "藏起作者已发表的帖子",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `id` FROM `published_articles` WHERE author_id = \\? AND status = \\? FOR UPDATE").
+					WithArgs(int64(1), domain.ArticleStatusPublished).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(3))
+				mock.ExpectExec("UPDATE `published_articles` SET `status`=\\? WHERE id IN \\(\\?,\\?\\)").
+					WithArgs(domain.ArticleStatusHidden, int64(2), int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+			wantIds: []int64{2, 3},
+		},
+		{
+			name: "只改指定的帖子",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `id` FROM `published_articles` WHERE \\(author_id = \\? AND status = \\?\\) "+
+					"AND id IN \\(\\?\\) FOR UPDATE").
+					WithArgs(int64(1), domain.ArticleStatusPublished, int64(3)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+			ids: []int64{3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock := tc.sqlmock(t)
+			d := NewGORMArticleDAO(newMockGormDB(t, mockDB))
+			ids, err := d.SetAuthorPubStatus(context.Background(), 1, tc.ids,
+				domain.ArticleStatusPublished, domain.ArticleStatusHidden)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.wantIds, ids)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -16,6 +16,7 @@ type MongoDBArticleDAO struct {
 	client  *mongo.Client
 	col     *mongo.Collection // 代表制作库
 	liveCol *mongo.Collection // 代表线上库
+	revCol  *mongo.Collection // 制作库的历史版本
 	node    *snowflake.Node
 }
 
@@ -48,6 +49,41 @@ func InitCollections(db *mongo.Database) error {
 	}
 	_, err = db.Collection("published_articles").Indexes().
 		CreateMany(ctx, index)
+	if err != nil {
+		return err
+	}
+	// 线上库按照标签和分类查询
+	_, err = db.Collection("published_articles").Indexes().
+		CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{Key: "tags", Value: 1},
+					bson.E{Key: "utime", Value: -1},
+				},
+				Options: options.Index(),
+			},
+			{
+				Keys: bson.D{bson.E{Key: "category", Value: 1},
+					bson.E{Key: "utime", Value: -1},
+				},
+				Options: options.Index(),
+			},
+		})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("article_revisions").Indexes().
+		CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{bson.E{Key: "id", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{bson.E{Key: "article_id", Value: 1},
+					bson.E{Key: "utime", Value: -1},
+				},
+				Options: options.Index(),
+			},
+		})
 	return err
 }
 
@@ -56,6 +92,7 @@ func NewMongoDBArticleDAO(client *mongo.Client, db *mongo.Database, node *snowfl
 		client:  client,
 		col:     db.Collection("articles"),
 		liveCol: db.Collection("published_articles"),
+		revCol:  db.Collection("article_revisions"),
 		node:    node,
 	}
 }
@@ -65,6 +102,20 @@ func (m MongoDBArticleDAO) ListPub(ctx context.Context, start time.Time, offset
 	panic("implement me")
 }
 
+func (m MongoDBArticleDAO) ListPubCursor(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error) {
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}, bson.E{Key: "id", Value: -1}}).
+		SetLimit(int64(limit))
+	filter := bson.M{"status": bson.M{"$ne": domain.ArticleStatusHidden.ToUint8()}}
+	res, err := m.liveCol.Find(ctx, cursorFilter(filter, cursor), opts)
+	if err != nil {
+		return nil, err
+	}
+	var arts []PublishedArticle
+	err = res.All(ctx, &arts)
+	return arts, err
+}
+
 func (m MongoDBArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
 	//TODO implement me
 	panic("implement me")
@@ -80,41 +131,290 @@ func (m MongoDBArticleDAO) GetByAuthor(ctx context.Context, authorId int64, offs
 	panic("implement me")
 }
 
+// GetByAuthorCursor 协作者只记录在 MySQL 里面，这里只有自己创作的帖子
+func (m MongoDBArticleDAO) GetByAuthorCursor(ctx context.Context, authorId int64, cursor Cursor, limit int) ([]Article, error) {
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}, bson.E{Key: "id", Value: -1}}).
+		SetLimit(int64(limit))
+	filter := bson.M{"author_id": authorId,
+		"status": bson.M{"$ne": domain.ArticleStatusDeleted.ToUint8()}}
+	return m.findArticles(ctx, cursorFilter(filter, cursor), opts)
+}
+
+// cursorFilter 在 filter 上加上 (utime, id) < (?, ?) 的条件
+func cursorFilter(filter bson.M, cursor Cursor) bson.M {
+	if cursor.Utime == 0 {
+		return filter
+	}
+	if cursor.Id == 0 {
+		filter["utime"] = bson.M{"$lt": cursor.Utime}
+		return filter
+	}
+	filter["$or"] = bson.A{
+		bson.M{"utime": bson.M{"$lt": cursor.Utime}},
+		bson.M{"utime": cursor.Utime, "id": bson.M{"$lt": cursor.Id}},
+	}
+	return filter
+}
+
 func (m MongoDBArticleDAO) Insert(ctx context.Context, art Article) (int64, error) {
 	now := time.Now().UnixMilli()
 	art.Ctime = now
 	art.Utime = now
+	art.Version = 1
 	// 通过雪花算法解决mongo中article的id问题
 	id := m.node.Generate().Int64()
 	art.Id = id
 	_, err := m.col.InsertOne(ctx, art)
-	return id, err
+	if err != nil {
+		return 0, err
+	}
+	// MongoDB 这里没有开事务，历史版本插入失败的话，帖子本身还是保存成功了
+	return id, m.insertRevision(ctx, art)
 }
 
 func (m MongoDBArticleDAO) UpdateById(ctx context.Context, art Article) error {
 	// 操作的是制作库
-	filter := bson.M{"id": art.Id, "author_id": art.AuthorId}
-	update := bson.D{bson.E{"$set", bson.M{
-		"title":   art.Title,
-		"content": art.Content,
-		"utime":   time.Now().UnixMilli(),
-		"status":  art.Status,
-	}}}
+	art.Utime = time.Now().UnixMilli()
+	// 回收站里面的帖子要先恢复才能编辑
+	filter := bson.M{"id": art.Id, "author_id": art.AuthorId,
+		"status": bson.M{"$ne": domain.ArticleStatusDeleted.ToUint8()}}
+	set := bson.M{
+		"title":      art.Title,
+		"content":    art.Content,
+		"utime":      art.Utime,
+		"status":     art.Status,
+		"publish_at": art.PublishAt,
+		"category":   art.Category,
+		"tags":       art.Tags,
+	}
+	update := bson.D{bson.E{Key: "$set", Value: set}, bson.E{Key: "$inc", Value: bson.M{"version": 1}}}
+	if art.Version > 0 {
+		filter["version"] = art.Version
+		if art.Version == 1 {
+			// 没有 version 的老文档当成 1，null 也能匹配到字段不存在的文档
+			filter["version"] = bson.M{"$in": bson.A{art.Version, nil}}
+		}
+		// 老文档上面 $inc 会从 0 开始加，版本号已经确定了，直接设置
+		set["version"] = art.Version + 1
+		update = bson.D{bson.E{Key: "$set", Value: set}}
+	}
 	res, err := m.col.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return err
 	}
+	if res.MatchedCount == 0 && art.Version > 0 {
+		// 去掉版本号再查一次，帖子还在的话就是版本对不上
+		delete(filter, "version")
+		cnt, err := m.col.CountDocuments(ctx, filter)
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return ErrVersionConflict
+		}
+	}
 	if res.ModifiedCount == 0 {
 		return errors.New("更新数据失败")
 	}
+	return m.insertRevision(ctx, art)
+}
+
+func (m MongoDBArticleDAO) insertRevision(ctx context.Context, art Article) error {
+	rev := newRevision(art)
+	rev.Id = m.node.Generate().Int64()
+	_, err := m.revCol.InsertOne(ctx, rev)
+	return err
+}
+
+func (m MongoDBArticleDAO) ListRevisions(ctx context.Context, artId int64, authorId int64, offset int, limit int) ([]ArticleRevision, error) {
+	filter := bson.M{"article_id": artId, "author_id": authorId}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}, bson.E{Key: "id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.revCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []ArticleRevision
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m MongoDBArticleDAO) ListScheduled(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error) {
+	filter := bson.M{"author_id": authorId, "status": domain.ArticleStatusScheduled.ToUint8()}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "publish_at", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	return m.findArticles(ctx, filter, opts)
+}
+
+func (m MongoDBArticleDAO) ListDueScheduled(ctx context.Context, now int64, limit int) ([]Article, error) {
+	filter := bson.M{
+		"status":     domain.ArticleStatusScheduled.ToUint8(),
+		"publish_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "publish_at", Value: 1}}).
+		SetLimit(int64(limit))
+	return m.findArticles(ctx, filter, opts)
+}
+
+func (m MongoDBArticleDAO) Reschedule(ctx context.Context, id int64, authorId int64, publishAt int64) error {
+	filter := bson.M{"id": id, "author_id": authorId, "status": domain.ArticleStatusScheduled.ToUint8()}
+	update := bson.M{"$set": bson.M{
+		"publish_at": publishAt,
+		"utime":      time.Now().UnixMilli(),
+	}}
+	res, err := m.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+func (m MongoDBArticleDAO) CancelSchedule(ctx context.Context, id int64, authorId int64) error {
+	filter := bson.M{"id": id, "author_id": authorId, "status": domain.ArticleStatusScheduled.ToUint8()}
+	update := bson.M{"$set": bson.M{
+		"status":     domain.ArticleStatusUnpublished.ToUint8(),
+		"publish_at": int64(0),
+		"utime":      time.Now().UnixMilli(),
+	}}
+	res, err := m.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+func (m MongoDBArticleDAO) Delete(ctx context.Context, id int64, authorId int64) error {
+	now := time.Now().UnixMilli()
+	filter := bson.M{"id": id, "author_id": authorId,
+		"status": bson.M{"$ne": domain.ArticleStatusDeleted.ToUint8()}}
+	update := bson.M{"$set": bson.M{
+		"status":     domain.ArticleStatusDeleted.ToUint8(),
+		"publish_at": int64(0),
+		"dtime":      now,
+		"utime":      now,
+	}}
+	res, err := m.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrArticleNotFound
+	}
+	// 没有开事务，线上库删除失败的话，重新删一次就可以
+	_, err = m.liveCol.DeleteOne(ctx, bson.M{"id": id})
+	return err
+}
+
+func (m MongoDBArticleDAO) ListDeleted(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error) {
+	filter := bson.M{"author_id": authorId, "status": domain.ArticleStatusDeleted.ToUint8()}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "dtime", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	return m.findArticles(ctx, filter, opts)
+}
+
+func (m MongoDBArticleDAO) Restore(ctx context.Context, id int64, authorId int64, after int64) error {
+	filter := bson.M{"id": id, "author_id": authorId,
+		"status": domain.ArticleStatusDeleted.ToUint8(),
+		"dtime":  bson.M{"$gte": after}}
+	update := bson.M{
+		"$set": bson.M{
+			"status": domain.ArticleStatusUnpublished.ToUint8(),
+			"utime":  time.Now().UnixMilli(),
+		},
+		"$unset": bson.M{"dtime": ""},
+	}
+	res, err := m.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrArticleNotFound
+	}
 	return nil
 }
 
-func (m MongoDBArticleDAO) Sync(ctx context.Context, art Article) (int64, error) {
+func (m MongoDBArticleDAO) ListPurgeable(ctx context.Context, before int64, limit int) ([]Article, error) {
+	filter := bson.M{
+		"status": domain.ArticleStatusDeleted.ToUint8(),
+		"dtime":  bson.M{"$lt": before},
+	}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "dtime", Value: 1}}).
+		SetLimit(int64(limit))
+	return m.findArticles(ctx, filter, opts)
+}
+
+func (m MongoDBArticleDAO) Purge(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	// 只删还在回收站里面的
+	arts, err := m.findArticles(ctx, bson.M{
+		"id":     bson.M{"$in": ids},
+		"status": domain.ArticleStatusDeleted.ToUint8(),
+	}, options.Find().SetProjection(bson.M{"id": 1}))
+	if err != nil || len(arts) == 0 {
+		return err
+	}
+	deleted := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		deleted = append(deleted, art.Id)
+	}
+	// 先删历史版本和线上库，中间失败了帖子还在回收站里面，下次还会再删
+	_, err = m.revCol.DeleteMany(ctx, bson.M{"article_id": bson.M{"$in": deleted}})
+	if err != nil {
+		return err
+	}
+	_, err = m.liveCol.DeleteMany(ctx, bson.M{"id": bson.M{"$in": deleted}})
+	if err != nil {
+		return err
+	}
+	_, err = m.col.DeleteMany(ctx, bson.M{"id": bson.M{"$in": deleted}})
+	return err
+}
+
+func (m MongoDBArticleDAO) findArticles(ctx context.Context, filter any, opts *options.FindOptions) ([]Article, error) {
+	cursor, err := m.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	for i := range res {
+		// 版本号上线之前写进去的文档没有 version，当成 1
+		if res[i].Version == 0 {
+			res[i].Version = 1
+		}
+	}
+	return res, err
+}
+
+func (m MongoDBArticleDAO) GetRevision(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := m.revCol.FindOne(ctx, bson.M{"id": id}).Decode(&res)
+	return res, err
+}
+
+func (m MongoDBArticleDAO) Sync(ctx context.Context, publishArt PublishedArticle) (int64, error) {
 	// 在这里同步制作库和线上库没有办法做到类似事务的概念
 	// 制作库新建或更新
 	now := time.Now().UnixMilli()
 	var (
+		art   = publishArt.Article
 		artId = art.Id
 		err   error
 	)
@@ -125,14 +425,36 @@ func (m MongoDBArticleDAO) Sync(ctx context.Context, art Article) (int64, error)
 		// 新建到制作库
 		artId, err = m.Insert(ctx, art)
 	}
+	if err != nil {
+		return 0, err
+	}
 	// upsert到线上库
+	art.Id = artId
 	art.Utime = now
+	publishArt.Article = art
 	update := bson.M{
 		// 更新，如果不存在，就是插入，
-		"$set": PublishedArticle{Article: art},
+		"$set": publishArt,
 		// 在插入的时候，要插入 ctime
 		"$setOnInsert": bson.M{"ctime": now},
 	}
+	// omitempty 的字段不会出现在 $set 里面，标签和分类被清空的时候要显式删掉
+	unset := bson.M{}
+	if len(art.Tags) == 0 {
+		unset["tags"] = ""
+	}
+	if art.Category == "" {
+		unset["category"] = ""
+	}
+	if publishArt.Html == "" {
+		unset["html"] = ""
+	}
+	if len(publishArt.Toc) == 0 {
+		unset["toc"] = ""
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
 	filter := bson.M{"id": art.Id}
 	_, err = m.liveCol.UpdateOne(ctx, filter, update,
 		options.Update().SetUpsert(true))
@@ -140,6 +462,48 @@ func (m MongoDBArticleDAO) Sync(ctx context.Context, art Article) (int64, error)
 	return artId, err
 }
 
+func (m MongoDBArticleDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	filter := bson.M{"tags": tag, "status": domain.ArticleStatusPublished.ToUint8()}
+	return m.findPub(ctx, filter, offset, limit)
+}
+
+func (m MongoDBArticleDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	filter := bson.M{"category": category, "status": domain.ArticleStatusPublished.ToUint8()}
+	return m.findPub(ctx, filter, offset, limit)
+}
+
+func (m MongoDBArticleDAO) findPub(ctx context.Context, filter any, offset int, limit int) ([]PublishedArticle, error) {
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.liveCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []PublishedArticle
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+// CountPubTags 把 tags 数组展开之后按照标签分组计数
+func (m MongoDBArticleDAO) CountPubTags(ctx context.Context, limit int) ([]TagCount, error) {
+	pipeline := mongo.Pipeline{
+		{bson.E{Key: "$match", Value: bson.M{"status": domain.ArticleStatusPublished.ToUint8()}}},
+		{bson.E{Key: "$unwind", Value: "$tags"}},
+		{bson.E{Key: "$group", Value: bson.M{"_id": "$tags", "cnt": bson.M{"$sum": 1}}}},
+		{bson.E{Key: "$sort", Value: bson.D{bson.E{Key: "cnt", Value: -1}, bson.E{Key: "_id", Value: 1}}}},
+		{bson.E{Key: "$limit", Value: limit}},
+	}
+	cursor, err := m.liveCol.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var res []TagCount
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
 func (m MongoDBArticleDAO) Upsert(ctx context.Context, article PublishedArticle) error {
 	//TODO implement me
 	panic("implement me")
@@ -187,4 +551,33 @@ func (m MongoDBArticleDAO) SyncStatus(ctx context.Context, art Article, status d
 	return err
 }
 
+// SetAuthorPubStatus 只改线上库，制作库里面本来就是已发表
+func (m MongoDBArticleDAO) SetAuthorPubStatus(ctx context.Context, authorId int64, ids []int64,
+	from, to domain.ArticleStatus) ([]int64, error) {
+	filter := bson.M{"author_id": authorId, "status": from.ToUint8()}
+	if len(ids) > 0 {
+		filter["id"] = bson.M{"$in": ids}
+	}
+	cursor, err := m.liveCol.Find(ctx, filter, options.Find().SetProjection(bson.M{"id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var arts []PublishedArticle
+	err = cursor.All(ctx, &arts)
+	if err != nil || len(arts) == 0 {
+		return nil, err
+	}
+	res := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		res = append(res, art.Id)
+	}
+	_, err = m.liveCol.UpdateMany(ctx,
+		bson.M{"id": bson.M{"$in": res}, "status": from.ToUint8()},
+		bson.M{"$set": bson.M{"status": to.ToUint8()}})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 type IDGenerator func() int64
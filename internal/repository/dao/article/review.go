@@ -0,0 +1,118 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+	"webookpro/internal/domain"
+)
+
+var (
+	ErrReviewNotFound = errors.New("审核记录不存在")
+	// ErrReviewNotPending 已经被别的管理员审核过了，或者已经过期了
+	ErrReviewNotPending = errors.New("审核记录不是待审核的状态")
+)
+
+// ArticleReview 审核记录，标题和内容是提交审核时候的快照
+type ArticleReview struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index"`
+	AuthorId  int64
+	Title     string `gorm:"type=varchar(4096)"`
+	Content   string `gorm:"type=BLOB"`
+	Status    uint8  `gorm:"index"`
+	// Reasons 自动审核给出的原因，和 Tags 一样存成 JSON 字符串
+	Reasons  Tags `gorm:"type:varchar(1024)"`
+	Reviewer int64
+	Reason   string
+	Ctime    int64
+	Utime    int64
+}
+
+type ReviewDAO interface {
+	// Insert 同一篇帖子之前还在等审核的记录都会变成过期
+	Insert(ctx context.Context, r ArticleReview) (int64, error)
+	FindById(ctx context.Context, id int64) (ArticleReview, error)
+	// FindPending 最早提交的在前面
+	FindPending(ctx context.Context, offset, limit int) ([]ArticleReview, error)
+	// FindByArticle 作者查看自己帖子的审核记录，最新的在前面
+	FindByArticle(ctx context.Context, artId, authorId int64, limit int) ([]ArticleReview, error)
+	// Finish 只有待审核的记录才能更新，不然返回 ErrReviewNotPending
+	Finish(ctx context.Context, r ArticleReview) error
+}
+
+type GORMReviewDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMReviewDAO(db *gorm.DB) ReviewDAO {
+	return &GORMReviewDAO{
+		db: db,
+	}
+}
+
+func (d *GORMReviewDAO) Insert(ctx context.Context, r ArticleReview) (int64, error) {
+	now := time.Now().UnixMilli()
+	r.Ctime = now
+	r.Utime = now
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ArticleReview{}).
+			Where("article_id = ? AND status = ?", r.ArticleId, domain.ArticleReviewStatusPending).
+			Updates(map[string]any{
+				"status": domain.ArticleReviewStatusOutdated,
+				"utime":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&r).Error
+	})
+	return r.Id, err
+}
+
+func (d *GORMReviewDAO) FindById(ctx context.Context, id int64) (ArticleReview, error) {
+	var r ArticleReview
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&r).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ArticleReview{}, ErrReviewNotFound
+	}
+	return r, err
+}
+
+func (d *GORMReviewDAO) FindPending(ctx context.Context, offset, limit int) ([]ArticleReview, error) {
+	var res []ArticleReview
+	err := d.db.WithContext(ctx).
+		Where("status = ?", domain.ArticleReviewStatusPending).
+		Order("id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMReviewDAO) FindByArticle(ctx context.Context, artId, authorId int64, limit int) ([]ArticleReview, error) {
+	var res []ArticleReview
+	err := d.db.WithContext(ctx).
+		Where("article_id = ? AND author_id = ?", artId, authorId).
+		Order("id DESC").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMReviewDAO) Finish(ctx context.Context, r ArticleReview) error {
+	res := d.db.WithContext(ctx).Model(&ArticleReview{}).
+		Where("id = ? AND status = ?", r.Id, domain.ArticleReviewStatusPending).
+		Updates(map[string]any{
+			"status":   r.Status,
+			"reviewer": r.Reviewer,
+			"reason":   r.Reason,
+			"utime":    time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrReviewNotPending
+	}
+	return nil
+}
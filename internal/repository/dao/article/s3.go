@@ -1,53 +1,61 @@
 package article
 
 import (
-	"bytes"
 	"context"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/ecodeclub/ekit"
+	"errors"
+	"fmt"
+	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"strconv"
 	"time"
 	"webookpro/internal/domain"
+	"webookpro/pkg/ossx"
 )
 
+const (
+	contentTypeText = "text/plain;charset=utf-8"
+	contentTypeHTML = "text/html;charset=utf-8"
+)
+
+var _ ArticleDAO = (*S3ArticleDA0)(nil)
+
+// S3ArticleDA0 线上库的内容放在对象存储里面，数据库里面只保存元数据
+// 制作库流量不大，并发不高，还是保存到数据库
 type S3ArticleDA0 struct {
-	oss *s3.S3
+	oss ossx.Store
 	GORMArticleDAO
-	bucket *string
 }
 
-func NewS3ArticleDAO(oss *s3.S3, db *gorm.DB) *S3ArticleDA0 {
+func NewS3ArticleDAO(oss ossx.Store, db *gorm.DB) *S3ArticleDA0 {
 	return &S3ArticleDA0{
-		oss:    oss,
-		bucket: ekit.ToPtr[string]("webook-1314583317"),
+		oss: oss,
 		GORMArticleDAO: GORMArticleDAO{
 			db: db,
 		},
 	}
 }
 
-func (s S3ArticleDA0) Insert(ctx context.Context, article Article) (int64, error) {
-	//TODO implement me
-	panic("implement me")
+// Insert 制作库，直接保存到数据库
+func (s *S3ArticleDA0) Insert(ctx context.Context, article Article) (int64, error) {
+	return s.GORMArticleDAO.Insert(ctx, article)
 }
 
-func (s S3ArticleDA0) UpdateById(ctx context.Context, article Article) error {
-	//TODO implement me
-	panic("implement me")
+// UpdateById 制作库，直接保存到数据库
+func (s *S3ArticleDA0) UpdateById(ctx context.Context, article Article) error {
+	return s.GORMArticleDAO.UpdateById(ctx, article)
 }
 
-func (s S3ArticleDA0) Sync(ctx context.Context, art Article) (int64, error) {
+func (s *S3ArticleDA0) Sync(ctx context.Context, publishArt PublishedArticle) (int64, error) {
 	// 保存制作库
-	// 保存线上库，并且把 content 上传到 OSS
-	//
+	// 保存线上库，并且把 content 和 html 上传到 OSS
 	var (
-		id = art.Id
+		art = publishArt.Article
+		id  = art.Id
 	)
 	// 制作库流量不大，并发不高，你就保存到数据库就可以
 	// 当然，有钱或者体量大，就还是考虑 OSS
-	err := s.db.Transaction(func(tx *gorm.DB) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var err error
 		now := time.Now().UnixMilli()
 		// 制作库
@@ -60,28 +68,12 @@ func (s S3ArticleDA0) Sync(ctx context.Context, art Article) (int64, error) {
 		if err != nil {
 			return err
 		}
-		art.Id = id
-		publishArt := PublishedArticle{
-			Article{
-				Id:       art.Id,
-				Title:    art.Title,
-				AuthorId: art.AuthorId,
-				Status:   art.Status,
-				Ctime:    now,
-				Utime:    now,
-			},
+		publishArt.Id = id
+		err = s.upsertPub(tx, publishArt, now)
+		if err != nil {
+			return err
 		}
-		// 线上库不保存 Content,要准备上传到 OSS 里面
-		return tx.Clauses(clause.OnConflict{
-			// ID 冲突的时候。实际上，在 MYSQL 里面你写不写都可以
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"title":  art.Title,
-				"utime":  now,
-				"status": art.Status,
-				// 要参与 SQL 运算的
-			}),
-		}).Create(&publishArt).Error
+		return syncTags(tx, id, art.Tags, now)
 	})
 	// 说明保存到数据库的时候失败了
 	if err != nil {
@@ -89,22 +81,187 @@ func (s S3ArticleDA0) Sync(ctx context.Context, art Article) (int64, error) {
 	}
 	// 接下来就是保存到 OSS 里面
 	// 你要有监控，你要有重试，你要有补偿机制
-	_, err = s.oss.PutObjectWithContext(ctx, &s3.PutObjectInput{
-		Bucket:      s.bucket,
-		Key:         ekit.ToPtr[string](strconv.FormatInt(art.Id, 10)),
-		Body:        bytes.NewReader([]byte(art.Content)),
-		ContentType: ekit.ToPtr[string]("text/plain;charset=utf-8"),
+	publishArt.Id = id
+	return id, s.putContent(ctx, publishArt)
+}
+
+// Upsert 线上库，内容上传到 OSS
+func (s *S3ArticleDA0) Upsert(ctx context.Context, art PublishedArticle) error {
+	err := s.upsertPub(s.db.WithContext(ctx), art, time.Now().UnixMilli())
+	if err != nil {
+		return err
+	}
+	return s.putContent(ctx, art)
+}
+
+// putContent 原文和渲染之后的 HTML 分开存，列表只需要原文
+func (s *S3ArticleDA0) putContent(ctx context.Context, art PublishedArticle) error {
+	err := s.oss.Put(ctx, s.key(art.Id), []byte(art.Content), contentTypeText)
+	if err != nil {
+		return err
+	}
+	return s.oss.Put(ctx, s.htmlKey(art.Id), []byte(art.Html), contentTypeHTML)
+}
+
+// SyncStatus 不再是发表状态的话，OSS 上的内容也要删掉，不然知道 key 的人还是能看到
+func (s *S3ArticleDA0) SyncStatus(ctx context.Context, art Article, status domain.ArticleStatus) error {
+	now := time.Now().UnixMilli()
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status <> ?", art.Id, art.AuthorId, domain.ArticleStatusDeleted).
+			Updates(map[string]any{
+				"status": status,
+				"utime":  now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != 1 {
+			// 要么用户不对，要么帖子 ID 不对
+			return fmt.Errorf("非法操作 artId: %d, authorId: %d", art.Id, art.AuthorId)
+		}
+		err := tx.Model(&PublishedArticle{}).
+			Where("id = ? AND author_id = ?", art.Id, art.AuthorId).
+			Updates(map[string]any{
+				"status": status,
+				"utime":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		if status == domain.ArticleStatusPublished {
+			return nil
+		}
+		return tx.Where("article_id = ?", art.Id).Delete(&PublishedArticleTag{}).Error
 	})
-	return id, err
+	if err != nil || status == domain.ArticleStatusPublished {
+		return err
+	}
+	err = s.oss.Delete(ctx, s.key(art.Id))
+	if err != nil {
+		return err
+	}
+	return s.oss.Delete(ctx, s.htmlKey(art.Id))
+}
 
+// Delete 线上库的内容在 OSS 上，也要一起删掉
+func (s *S3ArticleDA0) Delete(ctx context.Context, id int64, authorId int64) error {
+	err := s.GORMArticleDAO.Delete(ctx, id, authorId)
+	if err != nil {
+		return err
+	}
+	err = s.oss.Delete(ctx, s.key(id))
+	if err != nil {
+		return err
+	}
+	return s.oss.Delete(ctx, s.htmlKey(id))
+}
+
+// GetPubById 元数据从数据库里面查，内容和 HTML 从 OSS 里面查
+func (s *S3ArticleDA0) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
+	art, err := s.GORMArticleDAO.GetPubById(ctx, id)
+	if err != nil {
+		return PublishedArticle{}, err
+	}
+	var eg errgroup.Group
+	eg.Go(func() error {
+		data, er := s.getObject(ctx, s.key(id))
+		art.Content = string(data)
+		return er
+	})
+	var html []byte
+	eg.Go(func() error {
+		var er error
+		html, er = s.getObject(ctx, s.htmlKey(id))
+		return er
+	})
+	err = eg.Wait()
+	if err != nil {
+		return PublishedArticle{}, err
+	}
+	art.Html = string(html)
+	return art, nil
+}
+
+// getObject 已经撤回了的帖子，内容已经删掉了，不算错误
+func (s *S3ArticleDA0) getObject(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.oss.Get(ctx, key)
+	if errors.Is(err, ossx.ErrObjectNotFound) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// ListPub 搜索重建索引之类的场景要用到内容，所以已发表的帖子要把内容查回来
+func (s *S3ArticleDA0) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error) {
+	arts, err := s.GORMArticleDAO.ListPub(ctx, start, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return arts, s.fillContent(ctx, arts)
+}
+
+func (s *S3ArticleDA0) ListPubCursor(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error) {
+	arts, err := s.GORMArticleDAO.ListPubCursor(ctx, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return arts, s.fillContent(ctx, arts)
+}
+
+// fillContent 只有已发表的帖子在 OSS 上有内容
+func (s *S3ArticleDA0) fillContent(ctx context.Context, arts []PublishedArticle) error {
+	var eg errgroup.Group
+	for i := range arts {
+		art := &arts[i]
+		if art.Status != domain.ArticleStatusPublished.ToUint8() {
+			continue
+		}
+		eg.Go(func() error {
+			data, er := s.oss.Get(ctx, s.key(art.Id))
+			if errors.Is(er, ossx.ErrObjectNotFound) {
+				return nil
+			}
+			art.Content = string(data)
+			return er
+		})
+	}
+	return eg.Wait()
+}
+
+// upsertPub 线上库不保存 Content 和 Html，目录不大，还是放在数据库
+func (s *S3ArticleDA0) upsertPub(tx *gorm.DB, art PublishedArticle, now int64) error {
+	publishArt := PublishedArticle{
+		Article: Article{
+			Id:       art.Id,
+			Title:    art.Title,
+			AuthorId: art.AuthorId,
+			Status:   art.Status,
+			Category: art.Category,
+			Tags:     art.Tags,
+			Ctime:    now,
+			Utime:    now,
+		},
+		Toc: art.Toc,
+	}
+	return tx.Clauses(clause.OnConflict{
+		// ID 冲突的时候。实际上，在 MYSQL 里面你写不写都可以
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"title":    art.Title,
+			"utime":    now,
+			"status":   art.Status,
+			"category": art.Category,
+			"tags":     art.Tags,
+			"toc":      art.Toc,
+		}),
+	}).Create(&publishArt).Error
 }
 
-func (s S3ArticleDA0) Upsert(ctx context.Context, article PublishedArticle) error {
-	//TODO implement me
-	panic("implement me")
+func (s *S3ArticleDA0) key(id int64) string {
+	return strconv.FormatInt(id, 10)
 }
 
-func (s S3ArticleDA0) SyncStatus(ctx context.Context, article Article, status domain.ArticleStatus) error {
-	//TODO implement me
-	panic("implement me")
+func (s *S3ArticleDA0) htmlKey(id int64) string {
+	return s.key(id) + ".html"
 }
@@ -0,0 +1,174 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/pkg/ossx"
+	"webookpro/pkg/ossx/memory"
+)
+
+func TestS3ArticleDA0_GetPubById(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sqlmock func(t *testing.T) *sql.DB
+		oss     func(t *testing.T) ossx.Store
+		want    PublishedArticle
+		wantErr error
+	}{
+		{
+			name: "内容和 HTML 从 OSS 里面查",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				rows := sqlmock.NewRows([]string{"id", "title", "author_id", "status"}).
+					AddRow(1, "标题", 123, domain.ArticleStatusPublished.ToUint8())
+				mock.ExpectQuery("SELECT .* FROM `published_articles`.*").WillReturnRows(rows)
+				return mockDB
+			},
+			oss: func(t *testing.T) ossx.Store {
+				store := memory.NewStore()
+				err := store.Put(context.Background(), "1", []byte("内容"), contentTypeText)
+				require.NoError(t, err)
+				err = store.Put(context.Background(), "1.html", []byte("<p>内容</p>"), contentTypeHTML)
+				require.NoError(t, err)
+				return store
+			},
+			want: PublishedArticle{
+				Article: Article{
+					Id:       1,
+					Title:    "标题",
+					Content:  "内容",
+					AuthorId: 123,
+					Status:   domain.ArticleStatusPublished.ToUint8(),
+				},
+				Html: "<p>内容</p>",
+			},
+		},
+		{
+			name: "OSS 上没有内容",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				rows := sqlmock.NewRows([]string{"id", "title", "author_id", "status"}).
+					AddRow(1, "标题", 123, domain.ArticleStatusUnpublished.ToUint8())
+				mock.ExpectQuery("SELECT .* FROM `published_articles`.*").WillReturnRows(rows)
+				return mockDB
+			},
+			oss: func(t *testing.T) ossx.Store {
+				return memory.NewStore()
+			},
+			want: PublishedArticle{Article: Article{
+				Id:       1,
+				Title:    "标题",
+				AuthorId: 123,
+				Status:   domain.ArticleStatusUnpublished.ToUint8(),
+			}},
+		},
+		{
+			name: "数据库错误",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectQuery("SELECT .* FROM `published_articles`.*").
+					WillReturnError(errors.New("数据库错误"))
+				return mockDB
+			},
+			oss: func(t *testing.T) ossx.Store {
+				return memory.NewStore()
+			},
+			wantErr: errors.New("数据库错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewS3ArticleDAO(tc.oss(t), newMockGormDB(t, tc.sqlmock(t)))
+			art, err := d.GetPubById(context.Background(), 1)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, art)
+		})
+	}
+}
+
+func TestS3ArticleDA0_SyncStatus(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `articles` .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `published_articles` .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `published_article_tags` .*").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	store := memory.NewStore()
+	ctx := context.Background()
+	err = store.Put(ctx, "1", []byte("内容"), contentTypeText)
+	require.NoError(t, err)
+	err = store.Put(ctx, "1.html", []byte("<p>内容</p>"), contentTypeHTML)
+	require.NoError(t, err)
+
+	d := NewS3ArticleDAO(store, newMockGormDB(t, mockDB))
+	err = d.SyncStatus(ctx, Article{Id: 1, AuthorId: 123}, domain.ArticleStatusPrivate)
+	require.NoError(t, err)
+	// 撤回之后，OSS 上的内容也要删掉
+	_, err = store.Get(ctx, "1")
+	assert.Equal(t, ossx.ErrObjectNotFound, err)
+	_, err = store.Get(ctx, "1.html")
+	assert.Equal(t, ossx.ErrObjectNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestS3ArticleDA0_Sync(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	// 新建的帖子，制作库插入之后才有 id
+	mock.ExpectExec("SAVEPOINT .*").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO `articles` .*").WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.ExpectExec("INSERT INTO `article_revisions` .*").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `published_articles` .*").WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.ExpectExec("DELETE FROM `published_article_tags` WHERE article_id = ?").
+		WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO `published_article_tags` .*").
+		WithArgs(int64(10), "go", sqlmock.AnyArg(), int64(10), "gin", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	store := memory.NewStore()
+	ctx := context.Background()
+	d := NewS3ArticleDAO(store, newMockGormDB(t, mockDB))
+	id, err := d.Sync(ctx, PublishedArticle{
+		Article: Article{
+			Title:    "标题",
+			Content:  "内容",
+			AuthorId: 123,
+			Status:   domain.ArticleStatusPublished.ToUint8(),
+			Tags:     Tags{"go", "gin"},
+		},
+		Html: "<p>内容</p>",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), id)
+	content, err := store.Get(ctx, "10")
+	require.NoError(t, err)
+	assert.Equal(t, "内容", string(content))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newMockGormDB(t *testing.T, mockDB *sql.DB) *gorm.DB {
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+		Conn:                      mockDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	return db
+}
@@ -0,0 +1,270 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var (
+	// ErrSeriesNotFound 系列不存在，或者不是这个创作者的，或者帖子不在这个系列里面
+	ErrSeriesNotFound = errors.New("系列不存在")
+	// ErrArticleInSeries 一篇帖子只能属于一个系列
+	ErrArticleInSeries = errors.New("帖子已经在系列里面了")
+	// ErrSeriesOrderMismatch 调整顺序的时候传过来的帖子和系列里面的帖子对不上
+	ErrSeriesOrderMismatch = errors.New("系列里面的帖子对不上")
+)
+
+// Series 系列本身，里面的帖子放在 SeriesArticle
+type Series struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	AuthorId    int64  `gorm:"index:idx_author_utime"`
+	Title       string `gorm:"type:varchar(256)"`
+	Description string `gorm:"type:varchar(1024)"`
+	Ctime       int64
+	Utime       int64 `gorm:"index:idx_author_utime"`
+}
+
+// SeriesArticle 系列里面的帖子，Position 越小越靠前
+type SeriesArticle struct {
+	Id       int64 `gorm:"primaryKey,autoIncrement"`
+	SeriesId int64 `gorm:"index:idx_series_pos"`
+	// 一篇帖子只能属于一个系列，也靠这个索引来查帖子属于哪个系列
+	ArticleId int64 `gorm:"uniqueIndex"`
+	Position  int   `gorm:"index:idx_series_pos"`
+	Ctime     int64
+}
+
+// SeriesItem 系列里面的帖子，带上线上库的标题和状态，不是表
+type SeriesItem struct {
+	ArticleId int64
+	Title     string
+	Status    uint8
+}
+
+type SeriesDAO interface {
+	Insert(ctx context.Context, s Series) (int64, error)
+	// Update 只能修改标题和简介
+	Update(ctx context.Context, s Series) error
+	// Delete 删除系列，帖子本身不受影响
+	Delete(ctx context.Context, id int64, authorId int64) error
+	FindById(ctx context.Context, id int64) (Series, error)
+	// FindByAuthor 按照更新时间倒序
+	FindByAuthor(ctx context.Context, authorId int64, offset int, limit int) ([]Series, error)
+	// FindItems 系列里面的帖子，按照顺序排好
+	FindItems(ctx context.Context, id int64) ([]SeriesItem, error)
+	// FindIdByArticle 帖子属于哪个系列，不属于任何系列的话返回 ErrSeriesNotFound
+	FindIdByArticle(ctx context.Context, artId int64) (int64, error)
+	// AddArticle 把帖子加到系列的最后面
+	AddArticle(ctx context.Context, id int64, authorId int64, artId int64) error
+	RemoveArticle(ctx context.Context, id int64, authorId int64, artId int64) error
+	// Reorder artIds 是系列里面全部的帖子，按照新的顺序排好
+	Reorder(ctx context.Context, id int64, authorId int64, artIds []int64) error
+}
+
+type GORMSeriesDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMSeriesDAO(db *gorm.DB) SeriesDAO {
+	return &GORMSeriesDAO{
+		db: db,
+	}
+}
+
+func (d *GORMSeriesDAO) Insert(ctx context.Context, s Series) (int64, error) {
+	now := time.Now().UnixMilli()
+	s.Ctime = now
+	s.Utime = now
+	err := d.db.WithContext(ctx).Create(&s).Error
+	return s.Id, err
+}
+
+func (d *GORMSeriesDAO) Update(ctx context.Context, s Series) error {
+	res := d.db.WithContext(ctx).Model(&Series{}).
+		Where("id = ? AND author_id = ?", s.Id, s.AuthorId).
+		Updates(map[string]any{
+			"title":       s.Title,
+			"description": s.Description,
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrSeriesNotFound
+	}
+	return nil
+}
+
+func (d *GORMSeriesDAO) Delete(ctx context.Context, id int64, authorId int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND author_id = ?", id, authorId).Delete(&Series{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrSeriesNotFound
+		}
+		return tx.Where("series_id = ?", id).Delete(&SeriesArticle{}).Error
+	})
+}
+
+func (d *GORMSeriesDAO) FindById(ctx context.Context, id int64) (Series, error) {
+	var s Series
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Series{}, ErrSeriesNotFound
+	}
+	return s, err
+}
+
+func (d *GORMSeriesDAO) FindByAuthor(ctx context.Context, authorId int64, offset int, limit int) ([]Series, error) {
+	var res []Series
+	err := d.db.WithContext(ctx).
+		Where("author_id = ?", authorId).
+		Order("utime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMSeriesDAO) FindItems(ctx context.Context, id int64) ([]SeriesItem, error) {
+	var res []SeriesItem
+	// 撤回之后线上库可能没有这篇帖子了，所以用 LEFT JOIN，状态是 0
+	err := d.db.WithContext(ctx).Model(&SeriesArticle{}).
+		Select("series_articles.article_id, p.title, p.status").
+		Joins("LEFT JOIN published_articles p ON p.id = series_articles.article_id").
+		Where("series_articles.series_id = ?", id).
+		Order("series_articles.position ASC, series_articles.id ASC").
+		Scan(&res).Error
+	return res, err
+}
+
+func (d *GORMSeriesDAO) FindIdByArticle(ctx context.Context, artId int64) (int64, error) {
+	var sa SeriesArticle
+	err := d.db.WithContext(ctx).Where("article_id = ?", artId).First(&sa).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, ErrSeriesNotFound
+	}
+	return sa.SeriesId, err
+}
+
+func (d *GORMSeriesDAO) AddArticle(ctx context.Context, id int64, authorId int64, artId int64) error {
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住系列，并发加帖子的时候 Position 不会重复
+		err := d.lockSeries(tx, id, authorId)
+		if err != nil {
+			return err
+		}
+		var maxPos int
+		err = tx.Model(&SeriesArticle{}).
+			Select("COALESCE(MAX(position), 0)").
+			Where("series_id = ?", id).
+			Scan(&maxPos).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		err = tx.Create(&SeriesArticle{
+			SeriesId:  id,
+			ArticleId: artId,
+			Position:  maxPos + 1,
+			Ctime:     now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return d.touch(tx, id, now)
+	})
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	if ok {
+		const uniqueIndexErrNo uint16 = 1062
+		if mysqlErr.Number == uniqueIndexErrNo {
+			return ErrArticleInSeries
+		}
+	}
+	return err
+}
+
+func (d *GORMSeriesDAO) RemoveArticle(ctx context.Context, id int64, authorId int64, artId int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := d.lockSeries(tx, id, authorId)
+		if err != nil {
+			return err
+		}
+		res := tx.Where("series_id = ? AND article_id = ?", id, artId).Delete(&SeriesArticle{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrSeriesNotFound
+		}
+		return d.touch(tx, id, time.Now().UnixMilli())
+	})
+}
+
+func (d *GORMSeriesDAO) Reorder(ctx context.Context, id int64, authorId int64, artIds []int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := d.lockSeries(tx, id, authorId)
+		if err != nil {
+			return err
+		}
+		var current []int64
+		err = tx.Model(&SeriesArticle{}).
+			Where("series_id = ?", id).
+			Pluck("article_id", &current).Error
+		if err != nil {
+			return err
+		}
+		if !sameIds(current, artIds) {
+			return ErrSeriesOrderMismatch
+		}
+		for i, artId := range artIds {
+			err = tx.Model(&SeriesArticle{}).
+				Where("series_id = ? AND article_id = ?", id, artId).
+				Update("position", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return d.touch(tx, id, time.Now().UnixMilli())
+	})
+}
+
+// lockSeries 确认系列是这个创作者的，同时锁住这一行
+func (d *GORMSeriesDAO) lockSeries(tx *gorm.DB, id int64, authorId int64) error {
+	var s Series
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND author_id = ?", id, authorId).
+		First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrSeriesNotFound
+	}
+	return err
+}
+
+func (d *GORMSeriesDAO) touch(tx *gorm.DB, id int64, now int64) error {
+	return tx.Model(&Series{}).Where("id = ?", id).Update("utime", now).Error
+}
+
+// sameIds 两组 id 是不是完全一样，不管顺序，也不能有重复的
+func sameIds(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[int64]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+		delete(set, id)
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	// ErrShareNotFound 分享链接不存在，或者不是这个创作者的
+	ErrShareNotFound = errors.New("分享链接不存在")
+	// ErrShareUnavailable 分享链接已经撤销、过期或者次数用完了
+	ErrShareUnavailable = errors.New("分享链接已经失效")
+)
+
+// ArticleShare 分享链接，链接里面只带 Id，能不能看以这里为准
+type ArticleShare struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index:idx_art_author"`
+	AuthorId  int64 `gorm:"index:idx_art_author"`
+	// MaxViews 0 代表不限制次数
+	MaxViews int64
+	Views    int64
+	Expire   int64
+	Revoked  bool
+	Ctime    int64
+	Utime    int64
+}
+
+type ShareDAO interface {
+	Insert(ctx context.Context, s ArticleShare) (int64, error)
+	FindById(ctx context.Context, id int64) (ArticleShare, error)
+	// FindByArticle 帖子的全部分享链接，最新的在前面
+	FindByArticle(ctx context.Context, artId int64, authorId int64) ([]ArticleShare, error)
+	Revoke(ctx context.Context, id int64, authorId int64) error
+	// IncrViews 链接还有效的话访问次数 +1，否则返回 ErrShareUnavailable
+	IncrViews(ctx context.Context, id int64, now int64) error
+}
+
+type GORMShareDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMShareDAO(db *gorm.DB) ShareDAO {
+	return &GORMShareDAO{
+		db: db,
+	}
+}
+
+func (d *GORMShareDAO) Insert(ctx context.Context, s ArticleShare) (int64, error) {
+	now := time.Now().UnixMilli()
+	s.Ctime = now
+	s.Utime = now
+	err := d.db.WithContext(ctx).Create(&s).Error
+	return s.Id, err
+}
+
+func (d *GORMShareDAO) FindById(ctx context.Context, id int64) (ArticleShare, error) {
+	var s ArticleShare
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ArticleShare{}, ErrShareNotFound
+	}
+	return s, err
+}
+
+func (d *GORMShareDAO) FindByArticle(ctx context.Context, artId int64, authorId int64) ([]ArticleShare, error) {
+	var res []ArticleShare
+	err := d.db.WithContext(ctx).
+		Where("article_id = ? AND author_id = ?", artId, authorId).
+		Order("id DESC").
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMShareDAO) Revoke(ctx context.Context, id int64, authorId int64) error {
+	res := d.db.WithContext(ctx).Model(&ArticleShare{}).
+		Where("id = ? AND author_id = ?", id, authorId).
+		Updates(map[string]any{
+			"revoked": true,
+			"utime":   time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+func (d *GORMShareDAO) IncrViews(ctx context.Context, id int64, now int64) error {
+	// 判断和 +1 放在同一个 UPDATE 里面，并发访问也不会超过次数限制
+	res := d.db.WithContext(ctx).Model(&ArticleShare{}).
+		Where("id = ? AND revoked = ? AND expire > ? AND (max_views = 0 OR views < max_views)",
+			id, false, now).
+		Updates(map[string]any{
+			"views": gorm.Expr("views + 1"),
+			"utime": now,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrShareUnavailable
+	}
+	return nil
+}
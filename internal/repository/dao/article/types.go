@@ -2,18 +2,72 @@ package article
 
 import (
 	"context"
+	"errors"
+	"gorm.io/gorm"
 	"time"
 	"webookpro/internal/domain"
 )
 
+// ErrScheduleNotFound 帖子不存在，不是这个创作者的，或者已经不是定时发表的状态了
+var ErrScheduleNotFound = errors.New("定时发表的帖子不存在")
+
+// ErrArticleNotFound 帖子不存在，不是这个创作者的，或者不在回收站里面
+var ErrArticleNotFound = errors.New("帖子不存在")
+
+// ErrRecordNotFound GetById 和 GetPubById 按 id 查不到帖子
+var ErrRecordNotFound = gorm.ErrRecordNotFound
+
+// ErrVersionConflict 更新的时候带的版本号不是最新的，帖子已经被别人改过了
+var ErrVersionConflict = errors.New("帖子已经被修改过了")
+
+// Cursor 按照 utime, id 倒序翻页的游标，也就是上一页最后一条数据的 utime 和 id
+// Utime 为 0 代表第一页
+type Cursor struct {
+	Utime int64
+	Id    int64
+}
+
 type ArticleDAO interface {
 	Insert(ctx context.Context, article Article) (int64, error)
+	// UpdateById article.Version 大于 0 的时候要和库里面的版本一致，不然返回 ErrVersionConflict
 	UpdateById(ctx context.Context, article Article) error
-	Sync(ctx context.Context, article Article) (int64, error)
+	// Sync 保存制作库，同时把 article 同步到线上库，制作库只用到里面的 Article
+	Sync(ctx context.Context, article PublishedArticle) (int64, error)
 	Upsert(ctx context.Context, article PublishedArticle) error
 	SyncStatus(ctx context.Context, article Article, status domain.ArticleStatus) error
+	// SetAuthorPubStatus 把作者线上库里面 from 状态的帖子改成 to，ids 不为空的时候只改这几篇
+	// 返回真正改了的帖子，停用、注销和恢复账号的时候用
+	SetAuthorPubStatus(ctx context.Context, authorId int64, ids []int64, from, to domain.ArticleStatus) ([]int64, error)
 	GetByAuthor(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error)
+	// GetByAuthorCursor 游标翻页，翻页的过程中有帖子更新也不会重复或者漏掉
+	GetByAuthorCursor(ctx context.Context, authorId int64, cursor Cursor, limit int) ([]Article, error)
 	GetById(ctx context.Context, id int64) (Article, error)
 	GetPubById(ctx context.Context, id int64) (PublishedArticle, error)
 	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error)
+	// ListPubCursor 线上库游标翻页，第一页可以用 Cursor{Utime: start} 来表达 utime < start
+	ListPubCursor(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error)
+	// ListRevisions 按照时间倒序列出某篇帖子的历史版本
+	ListRevisions(ctx context.Context, artId int64, authorId int64, offset int, limit int) ([]ArticleRevision, error)
+	GetRevision(ctx context.Context, id int64) (ArticleRevision, error)
+	// ListScheduled 创作者的定时发表列表，按照发表时间排序
+	ListScheduled(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error)
+	// ListDueScheduled 已经到了发表时间的定时发表帖子
+	ListDueScheduled(ctx context.Context, now int64, limit int) ([]Article, error)
+	Reschedule(ctx context.Context, id int64, authorId int64, publishAt int64) error
+	CancelSchedule(ctx context.Context, id int64, authorId int64) error
+	// ListPubByTag 线上库某个标签下的帖子，按照更新时间倒序
+	ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error)
+	ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error)
+	// CountPubTags 每个标签下已发表的帖子数量，按照数量倒序
+	CountPubTags(ctx context.Context, limit int) ([]TagCount, error)
+	// Delete 放进回收站，同时从线上库删掉
+	Delete(ctx context.Context, id int64, authorId int64) error
+	// ListDeleted 回收站，最近删除的在前面
+	ListDeleted(ctx context.Context, authorId int64, offset int, limit int) ([]Article, error)
+	// Restore 从回收站恢复成未发表的状态，after 之前删除的已经不能恢复了
+	Restore(ctx context.Context, id int64, authorId int64, after int64) error
+	// ListPurgeable before 之前放进回收站的帖子
+	ListPurgeable(ctx context.Context, before int64, limit int) ([]Article, error)
+	// Purge 彻底删除，连同历史版本一起，不在回收站里面的帖子不会被删掉
+	Purge(ctx context.Context, ids []int64) error
 }
@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type AttachmentDAO interface {
+	Insert(ctx context.Context, a Attachment) (int64, error)
+	// Bind 记录帖子引用了 uid 自己上传的这些附件，帖子可能引用的是原图，也可能是缩略图
+	Bind(ctx context.Context, uid int64, artId int64, keys []string) error
+	// Unbind 去掉帖子对 keepKeys 之外的附件的引用，原图和缩略图有一个在 keepKeys 里面就算还在引用
+	Unbind(ctx context.Context, artId int64, keepKeys []string) error
+	// FindOrphans 一篇帖子都没有引用，并且在 before 之前就已经是这个状态的附件
+	FindOrphans(ctx context.Context, before int64, limit int) ([]Attachment, error)
+	DeleteByIds(ctx context.Context, ids []int64) error
+}
+
+type GORMAttachmentDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMAttachmentDAO(db *gorm.DB) AttachmentDAO {
+	return &GORMAttachmentDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMAttachmentDAO) Insert(ctx context.Context, a Attachment) (int64, error) {
+	now := time.Now().UnixMilli()
+	a.Ctime = now
+	a.Utime = now
+	err := dao.db.WithContext(ctx).Create(&a).Error
+	return a.Id, err
+}
+
+func (dao *GORMAttachmentDAO) Bind(ctx context.Context, uid int64, artId int64, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []int64
+		err := tx.Model(&Attachment{}).
+			Where("uid = ? AND (`key` IN ? OR thumb_key IN ?)", uid, keys, keys).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		refs := make([]AttachmentRef, 0, len(ids))
+		for _, id := range ids {
+			refs = append(refs, AttachmentRef{
+				AttachmentId: id,
+				ArticleId:    artId,
+				Ctime:        now,
+			})
+		}
+		// 已经引用过的不用管
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&refs).Error
+	})
+}
+
+func (dao *GORMAttachmentDAO) Unbind(ctx context.Context, artId int64, keepKeys []string) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&AttachmentRef{}).Where("article_id = ?", artId)
+		if len(keepKeys) > 0 {
+			query = query.Where("attachment_id NOT IN (?)", tx.Model(&Attachment{}).
+				Select("id").
+				Where("`key` IN ? OR thumb_key IN ?", keepKeys, keepKeys))
+		}
+		var ids []int64
+		err := query.Pluck("attachment_id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		err = tx.Where("article_id = ? AND attachment_id IN ?", artId, ids).
+			Delete(&AttachmentRef{}).Error
+		if err != nil {
+			return err
+		}
+		// 可能刚刚变成没人引用，从现在开始算清理的时间；别的帖子还在引用的话不会被清理
+		return tx.Model(&Attachment{}).Where("id IN ?", ids).
+			UpdateColumn("utime", time.Now().UnixMilli()).Error
+	})
+}
+
+func (dao *GORMAttachmentDAO) FindOrphans(ctx context.Context, before int64, limit int) ([]Attachment, error) {
+	var res []Attachment
+	err := dao.db.WithContext(ctx).
+		Where("utime < ? AND NOT EXISTS (?)", before, dao.db.Model(&AttachmentRef{}).
+			Select("1").
+			Where("attachment_refs.attachment_id = attachments.id")).
+		Order("utime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMAttachmentDAO) DeleteByIds(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return dao.db.WithContext(ctx).Where("id IN ?", ids).
+		Delete(&Attachment{}).Error
+}
+
+// Attachment 上传的附件，文件本身在对象存储里面
+type Attachment struct {
+	Id       int64  `gorm:"primaryKey,autoIncrement"`
+	Uid      int64  `gorm:"index"`
+	Key      string `gorm:"type:varchar(256);uniqueIndex"`
+	ThumbKey string `gorm:"type:varchar(256)"`
+	MimeType string `gorm:"type:varchar(64)"`
+	Size     int64
+	Width    int
+	Height   int
+	Ctime    int64
+	// Utime 最后一次被解除引用的时间，没有引用的附件按照它来判断是不是该清理了
+	Utime int64 `gorm:"index"`
+}
+
+// AttachmentRef 帖子引用了哪些附件，同一张图片可以被好几篇帖子引用
+// 一条引用都没有的附件才会被清理
+type AttachmentRef struct {
+	Id           int64 `gorm:"primaryKey,autoIncrement"`
+	AttachmentId int64 `gorm:"uniqueIndex:uk_att_art"`
+	ArticleId    int64 `gorm:"uniqueIndex:uk_att_art;index"`
+	Ctime        int64
+}
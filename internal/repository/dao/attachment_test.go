@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestGORMAttachmentDAO_Bind(t *testing.T) {
+	testcases := []struct {
+		name    string
+		sqlmock func(t *testing.T) (*sql.DB, sqlmock.Sqlmock)
+		keys    []string
+	}{
+		{
+			name: "同一张图片被另一篇帖子引用，原来的引用还在",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `id` FROM `attachments` WHERE uid = .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec("INSERT INTO `attachment_refs` .* ON DUPLICATE KEY UPDATE `id`=`id`").
+					WithArgs(int64(1), int64(2), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+			keys: []string{"attachments/1/a.jpg"},
+		},
+		{
+			name: "没有自己上传的附件",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `id` FROM `attachments` WHERE uid = .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+			keys: []string{"attachments/3/a.jpg"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock := tc.sqlmock(t)
+			err := NewGORMAttachmentDAO(newMockGormDB(t, mockDB)).
+				Bind(context.Background(), 1, 2, tc.keys)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMAttachmentDAO_Unbind(t *testing.T) {
+	testcases := []struct {
+		name     string
+		sqlmock  func(t *testing.T) (*sql.DB, sqlmock.Sqlmock)
+		keepKeys []string
+	}{
+		{
+			name: "只去掉这篇帖子的引用",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `attachment_id` FROM `attachment_refs` WHERE article_id = \\? "+
+					"AND attachment_id NOT IN \\(SELECT `id` FROM `attachments` WHERE .*\\)").
+					WithArgs(int64(2), "attachments/1/b.jpg", "attachments/1/b.jpg").
+					WillReturnRows(sqlmock.NewRows([]string{"attachment_id"}).AddRow(1))
+				mock.ExpectExec("DELETE FROM `attachment_refs` WHERE article_id = \\? AND attachment_id IN \\(\\?\\)").
+					WithArgs(int64(2), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE `attachments` SET `utime`=\\? WHERE id IN \\(\\?\\)").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+			keepKeys: []string{"attachments/1/b.jpg"},
+		},
+		{
+			name: "没有要去掉的引用",
+			sqlmock: func(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT `attachment_id` FROM `attachment_refs` WHERE article_id = \\?$").
+					WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"attachment_id"}))
+				mock.ExpectCommit()
+				return mockDB, mock
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock := tc.sqlmock(t)
+			err := NewGORMAttachmentDAO(newMockGormDB(t, mockDB)).
+				Unbind(context.Background(), 2, tc.keepKeys)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMAttachmentDAO_FindOrphans(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery("SELECT \\* FROM `attachments` WHERE utime < \\? AND NOT EXISTS " +
+		"\\(SELECT 1 FROM `attachment_refs` WHERE attachment_refs.attachment_id = attachments.id\\) " +
+		"ORDER BY utime ASC LIMIT 10").
+		WithArgs(int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key"}).AddRow(1, "attachments/1/a.jpg"))
+	res, err := NewGORMAttachmentDAO(newMockGormDB(t, mockDB)).
+		FindOrphans(context.Background(), 100, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []Attachment{{Id: 1, Key: "attachments/1/a.jpg"}}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newMockGormDB(t *testing.T, mockDB *sql.DB) *gorm.DB {
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+		Conn:                      mockDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	return db
+}
@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// ErrCommentNotFound 评论不存在，或者已经被删除了，或者不是你的评论
+var ErrCommentNotFound = errors.New("评论不存在")
+
+const (
+	commentStatusNormal uint8 = iota
+	// 软删除，根评论下面还有回复的话，列表里面还要占个位置
+	commentStatusDeleted
+)
+
+type CommentDAO interface {
+	// Insert 插入一条评论，如果是回复，还要顺便把根评论的回复数加一
+	Insert(ctx context.Context, c Comment) (int64, error)
+	FindById(ctx context.Context, id int64) (Comment, error)
+	// FindRoots 按照 ID 倒序查找根评论，maxId 为 0 就是第一页
+	FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]Comment, error)
+	// FindReplies 按照 ID 正序查找回复，也就是先回复的排在前面
+	FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]Comment, error)
+	// Delete 软删除自己的评论
+	Delete(ctx context.Context, id int64, uid int64) error
+}
+
+type GORMCommentDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCommentDAO(db *gorm.DB) CommentDAO {
+	return &GORMCommentDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMCommentDAO) Insert(ctx context.Context, c Comment) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&c).Error
+		if err != nil {
+			return err
+		}
+		if c.RootId == 0 {
+			return nil
+		}
+		return tx.Model(&Comment{}).Where("id = ?", c.RootId).
+			Updates(map[string]any{
+				"reply_cnt": gorm.Expr("reply_cnt + 1"),
+				"utime":     now,
+			}).Error
+	})
+	return c.Id, err
+}
+
+func (dao *GORMCommentDAO) FindById(ctx context.Context, id int64) (Comment, error) {
+	var res Comment
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Comment{}, ErrCommentNotFound
+	}
+	return res, err
+}
+
+func (dao *GORMCommentDAO) FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]Comment, error) {
+	db := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id = ? AND root_id = 0", biz, bizId).
+		// 删掉了的根评论，下面还有回复就要留着
+		Where("status = ? OR reply_cnt > 0", commentStatusNormal)
+	if maxId > 0 {
+		db = db.Where("id < ?", maxId)
+	}
+	var res []Comment
+	err := db.Order("id DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMCommentDAO) FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]Comment, error) {
+	var res []Comment
+	err := dao.db.WithContext(ctx).
+		Where("root_id = ? AND id > ? AND status = ?", rootId, minId, commentStatusNormal).
+		Order("id ASC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMCommentDAO) Delete(ctx context.Context, id int64, uid int64) error {
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var c Comment
+		err := tx.Where("id = ? AND uid = ? AND status = ?", id, uid, commentStatusNormal).
+			First(&c).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCommentNotFound
+		}
+		if err != nil {
+			return err
+		}
+		res := tx.Model(&Comment{}).
+			Where("id = ? AND status = ?", id, commentStatusNormal).
+			Updates(map[string]any{
+				"status": commentStatusDeleted,
+				"utime":  now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 并发删除，别人先删掉了
+			return ErrCommentNotFound
+		}
+		if c.RootId == 0 {
+			return nil
+		}
+		return tx.Model(&Comment{}).Where("id = ?", c.RootId).
+			Updates(map[string]any{
+				"reply_cnt": gorm.Expr("reply_cnt - 1"),
+				"utime":     now,
+			}).Error
+	})
+}
+
+// Comment 评论表，根评论和回复放在同一张表里面
+type Comment struct {
+	Id int64 `gorm:"primaryKey,autoIncrement"`
+	// 发表评论的人
+	Uid   int64  `gorm:"index"`
+	Biz   string `gorm:"type:varchar(128);index:biz_type_id"`
+	BizId int64  `gorm:"index:biz_type_id"`
+	// 根评论是 0
+	RootId int64 `gorm:"index"`
+	// 直接回复的评论，根评论是 0
+	ParentId int64  `gorm:"index"`
+	Content  string `gorm:"type:text"`
+	// 只有根评论才会维护这个字段
+	ReplyCnt int64
+	Status   uint8
+	Ctime    int64
+	Utime    int64
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type HistoryRecordDAO interface {
+	// Upsert 同一个用户同一个资源只有一条记录，阅读时间只会往后挪，所以重复消费也没关系
+	Upsert(ctx context.Context, r HistoryRecord) error
+	// FindByUid 按照阅读时间倒序
+	FindByUid(ctx context.Context, uid int64, offset, limit int) ([]HistoryRecord, error)
+	DeleteByUid(ctx context.Context, uid int64) error
+}
+
+type GORMHistoryRecordDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMHistoryRecordDAO(db *gorm.DB) HistoryRecordDAO {
+	return &GORMHistoryRecordDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMHistoryRecordDAO) Upsert(ctx context.Context, r HistoryRecord) error {
+	now := time.Now().UnixMilli()
+	r.Ctime = now
+	r.Utime = now
+	return dao.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"read_time": gorm.Expr("GREATEST(read_time, ?)", r.ReadTime),
+			"utime":     now,
+		}),
+	}).Create(&r).Error
+}
+
+func (dao *GORMHistoryRecordDAO) FindByUid(ctx context.Context, uid int64, offset, limit int) ([]HistoryRecord, error) {
+	var res []HistoryRecord
+	err := dao.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Order("read_time DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMHistoryRecordDAO) DeleteByUid(ctx context.Context, uid int64) error {
+	return dao.db.WithContext(ctx).Where("uid = ?", uid).
+		Delete(&HistoryRecord{}).Error
+}
+
+// HistoryRecord 阅读记录
+type HistoryRecord struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Uid   int64  `gorm:"uniqueIndex:uid_biz_id;index:uid_read_time"`
+	Biz   string `gorm:"type:varchar(128);uniqueIndex:uid_biz_id"`
+	BizId int64  `gorm:"uniqueIndex:uid_biz_id"`
+	// ReadTime 最后一次阅读的时间，毫秒数
+	ReadTime int64 `gorm:"index:uid_read_time"`
+	Ctime    int64
+	Utime    int64
+}
@@ -6,8 +6,31 @@ import (
 )
 
 func InitTables(db *gorm.DB) error {
+	// 昵称加唯一索引之前，没有填昵称的老数据是空字符串，要先改成 NULL，不然索引建不起来
+	if db.Migrator().HasColumn(&User{}, "nickname") {
+		err := db.Model(&User{}).Where("nickname = ?", "").
+			UpdateColumn("nickname", nil).Error
+		if err != nil {
+			return err
+		}
+	}
 	return db.AutoMigrate(&User{},
 		&article.Article{},
 		&article.PublishedArticle{},
-		&Job{})
+		&article.ArticleRevision{},
+		&article.PublishedArticleTag{},
+		&article.Series{},
+		&article.SeriesArticle{},
+		&article.ArticleShare{},
+		&article.ArticleCollaborator{},
+		&article.ArticleExport{},
+		&article.ArticleReview{},
+		&Comment{},
+		&HistoryRecord{},
+		&Attachment{},
+		&AttachmentRef{},
+		&Job{},
+		&AccountMerge{},
+		&UserTOTP{},
+		&RecoveryCode{})
 }
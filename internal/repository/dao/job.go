@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -12,6 +13,8 @@ type JobDAO interface {
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, next time.Time) error
 	Stop(ctx context.Context, id int64) error
+	// Insert 插入一个任务，同名的任务已经存在就什么也不做
+	Insert(ctx context.Context, j Job) error
 }
 
 type GORMJobDAO struct {
@@ -30,8 +33,9 @@ func (g *GORMJobDAO) Preempt(ctx context.Context, refreshInterval time.Duration)
 		now := time.Now()
 		var j Job
 		err := db.WithContext(ctx).
-			Where("status = ? AND next_time <= ?", jobStatusWaiting, now).
-			Or("utime <= ", time.Now().Add(-refreshInterval*3)). // 3个间隔都没续约，我认为你续约失败，是可以抢占的
+			Where("status = ? AND next_time <= ?", jobStatusWaiting, now.UnixMilli()).
+			// 3个间隔都没续约，我认为你续约失败，是可以抢占的
+			Or("status = ? AND utime <= ?", jobStatusRunning, now.Add(-refreshInterval*3).UnixMilli()).
 			First(&j).Error
 		if err != nil {
 			// 说明没任务执行，直接退出
@@ -45,11 +49,11 @@ func (g *GORMJobDAO) Preempt(ctx context.Context, refreshInterval time.Duration)
 		res := db.Where("id = ? AND version = ?", j.Id, j.Version).
 			Model(&Job{}).Updates(map[string]any{
 			"status":  jobStatusRunning,
-			"utime":   now,
+			"utime":   now.UnixMilli(),
 			"version": j.Version + 1,
 		})
 		if res.Error != nil {
-			return Job{}, err
+			return Job{}, res.Error
 		}
 		if res.RowsAffected == 0 {
 			// 抢占失败，被别人抢了，我要继续下一轮
@@ -87,13 +91,22 @@ func (g *GORMJobDAO) UpdateNextTime(ctx context.Context, id int64, next time.Tim
 }
 
 func (g *GORMJobDAO) Stop(ctx context.Context, id int64) error {
-	return g.db.WithContext(ctx).
+	return g.db.WithContext(ctx).Model(&Job{}).
 		Where("id = ?", id).Updates(map[string]any{
 		"status": jobStatusPaused,
 		"utime":  time.Now().UnixMilli(),
 	}).Error
 }
 
+func (g *GORMJobDAO) Insert(ctx context.Context, j Job) error {
+	now := time.Now().UnixMilli()
+	j.Ctime = now
+	j.Utime = now
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(&j).Error
+}
+
 type Job struct {
 	Id       int64 `gorm:"primaryKey,autoIncrement"`
 	Cfg      string
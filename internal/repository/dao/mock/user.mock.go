@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: F:\GeekGoProjects\src\webookpro\internal\repository\dao\user.go
+// Source: user.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user.go -package=daomocks -destination=mock/user.mock.go
+//
 
 // Package daomocks is a generated GoMock package.
 package daomocks
@@ -35,6 +40,48 @@ func (m *MockUserDAO) EXPECT() *MockUserDAOMockRecorder {
 	return m.recorder
 }
 
+// ClearEmail mocks base method.
+func (m *MockUserDAO) ClearEmail(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearEmail", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearEmail indicates an expected call of ClearEmail.
+func (mr *MockUserDAOMockRecorder) ClearEmail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearEmail", reflect.TypeOf((*MockUserDAO)(nil).ClearEmail), ctx, id)
+}
+
+// ClearPhone mocks base method.
+func (m *MockUserDAO) ClearPhone(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPhone", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPhone indicates an expected call of ClearPhone.
+func (mr *MockUserDAOMockRecorder) ClearPhone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPhone", reflect.TypeOf((*MockUserDAO)(nil).ClearPhone), ctx, id)
+}
+
+// ClearWechat mocks base method.
+func (m *MockUserDAO) ClearWechat(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearWechat", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearWechat indicates an expected call of ClearWechat.
+func (mr *MockUserDAOMockRecorder) ClearWechat(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearWechat", reflect.TypeOf((*MockUserDAO)(nil).ClearWechat), ctx, id)
+}
+
 // FindByEmail mocks base method.
 func (m *MockUserDAO) FindByEmail(ctx context.Context, email string) (dao.User, error) {
 	m.ctrl.T.Helper()
@@ -45,7 +92,7 @@ func (m *MockUserDAO) FindByEmail(ctx context.Context, email string) (dao.User,
 }
 
 // FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockUserDAOMockRecorder) FindByEmail(ctx, email interface{}) *gomock.Call {
+func (mr *MockUserDAOMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserDAO)(nil).FindByEmail), ctx, email)
 }
@@ -60,7 +107,7 @@ func (m *MockUserDAO) FindById(ctx context.Context, id int64) (dao.User, error)
 }
 
 // FindById indicates an expected call of FindById.
-func (mr *MockUserDAOMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+func (mr *MockUserDAOMockRecorder) FindById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserDAO)(nil).FindById), ctx, id)
 }
@@ -75,7 +122,7 @@ func (m *MockUserDAO) FindByPhone(ctx context.Context, phone string) (dao.User,
 }
 
 // FindByPhone indicates an expected call of FindByPhone.
-func (mr *MockUserDAOMockRecorder) FindByPhone(ctx, phone interface{}) *gomock.Call {
+func (mr *MockUserDAOMockRecorder) FindByPhone(ctx, phone any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhone", reflect.TypeOf((*MockUserDAO)(nil).FindByPhone), ctx, phone)
 }
@@ -90,7 +137,7 @@ func (m *MockUserDAO) FindByWechat(ctx context.Context, openID string) (dao.User
 }
 
 // FindByWechat indicates an expected call of FindByWechat.
-func (mr *MockUserDAOMockRecorder) FindByWechat(ctx, openID interface{}) *gomock.Call {
+func (mr *MockUserDAOMockRecorder) FindByWechat(ctx, openID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWechat", reflect.TypeOf((*MockUserDAO)(nil).FindByWechat), ctx, openID)
 }
@@ -104,7 +151,77 @@ func (m *MockUserDAO) Insert(ctx context.Context, u dao.User) error {
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockUserDAOMockRecorder) Insert(ctx, u interface{}) *gomock.Call {
+func (mr *MockUserDAOMockRecorder) Insert(ctx, u any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUserDAO)(nil).Insert), ctx, u)
 }
+
+// UpdateEmail mocks base method.
+func (m *MockUserDAO) UpdateEmail(ctx context.Context, id int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail.
+func (mr *MockUserDAOMockRecorder) UpdateEmail(ctx, id, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserDAO)(nil).UpdateEmail), ctx, id, email)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserDAO) UpdatePassword(ctx context.Context, id int64, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserDAOMockRecorder) UpdatePassword(ctx, id, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserDAO)(nil).UpdatePassword), ctx, id, password)
+}
+
+// UpdatePhone mocks base method.
+func (m *MockUserDAO) UpdatePhone(ctx context.Context, id int64, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhone", ctx, id, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhone indicates an expected call of UpdatePhone.
+func (mr *MockUserDAOMockRecorder) UpdatePhone(ctx, id, phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhone", reflect.TypeOf((*MockUserDAO)(nil).UpdatePhone), ctx, id, phone)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUserDAO) UpdateProfile(ctx context.Context, u dao.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserDAOMockRecorder) UpdateProfile(ctx, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserDAO)(nil).UpdateProfile), ctx, u)
+}
+
+// UpdateWechat mocks base method.
+func (m *MockUserDAO) UpdateWechat(ctx context.Context, id int64, openID, unionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWechat", ctx, id, openID, unionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWechat indicates an expected call of UpdateWechat.
+func (mr *MockUserDAOMockRecorder) UpdateWechat(ctx, id, openID, unionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWechat", reflect.TypeOf((*MockUserDAO)(nil).UpdateWechat), ctx, id, openID, unionID)
+}
@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var (
+	// ErrTwoFactorEnabled 已经开启了两步验证，要先关掉才能重新绑定
+	ErrTwoFactorEnabled = errors.New("已经开启了两步验证")
+	// ErrRecoveryCodeInvalid 恢复码不存在或者已经用过了
+	ErrRecoveryCodeInvalid = errors.New("恢复码无效")
+	// ErrTOTPCounterUsed 这个时间窗口的验证码已经用过了
+	ErrTOTPCounterUsed = errors.New("验证码已经用过了")
+)
+
+type TwoFactorDAO interface {
+	// Upsert 没有开启的时候覆盖掉之前的密钥和恢复码，已经开启了返回 ErrTwoFactorEnabled
+	Upsert(ctx context.Context, t UserTOTP, codes []RecoveryCode) error
+	FindByUid(ctx context.Context, uid int64) (UserTOTP, error)
+	Enable(ctx context.Context, uid int64) error
+	// Delete 关闭两步验证，恢复码一起删掉
+	Delete(ctx context.Context, uid int64) error
+	// UseRecoveryCode 每个恢复码只能用一次
+	UseRecoveryCode(ctx context.Context, uid int64, hash string) error
+	// UseCounter 验证码对应的时间窗口要比上一次用过的新，不然返回 ErrTOTPCounterUsed
+	UseCounter(ctx context.Context, uid int64, counter int64) error
+}
+
+type GORMTwoFactorDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMTwoFactorDAO(db *gorm.DB) TwoFactorDAO {
+	return &GORMTwoFactorDAO{
+		db: db,
+	}
+}
+
+func (dao *GORMTwoFactorDAO) Upsert(ctx context.Context, t UserTOTP, codes []RecoveryCode) error {
+	now := time.Now().UnixMilli()
+	t.Ctime, t.Utime = now, now
+	for i := range codes {
+		codes[i].Uid = t.Uid
+		codes[i].Ctime = now
+	}
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old UserTOTP
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ?", t.Uid).First(&old).Error
+		switch {
+		case err == nil:
+			if old.Enabled {
+				return ErrTwoFactorEnabled
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+		err = tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled", "last_counter", "utime"}),
+		}).Create(&t).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("uid = ?", t.Uid).Delete(&RecoveryCode{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+func (dao *GORMTwoFactorDAO) FindByUid(ctx context.Context, uid int64) (UserTOTP, error) {
+	var t UserTOTP
+	err := dao.db.WithContext(ctx).Where("uid = ?", uid).First(&t).Error
+	return t, err
+}
+
+func (dao *GORMTwoFactorDAO) Enable(ctx context.Context, uid int64) error {
+	return dao.db.WithContext(ctx).Model(&UserTOTP{}).
+		Where("uid = ?", uid).
+		Updates(map[string]any{
+			"enabled": true,
+			"utime":   time.Now().UnixMilli(),
+		}).Error
+}
+
+func (dao *GORMTwoFactorDAO) Delete(ctx context.Context, uid int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("uid = ?", uid).Delete(&UserTOTP{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("uid = ?", uid).Delete(&RecoveryCode{}).Error
+	})
+}
+
+func (dao *GORMTwoFactorDAO) UseRecoveryCode(ctx context.Context, uid int64, hash string) error {
+	res := dao.db.WithContext(ctx).Model(&RecoveryCode{}).
+		Where("uid = ? AND hash = ? AND used_at = ?", uid, hash, 0).
+		Update("used_at", time.Now().UnixMilli())
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrRecoveryCodeInvalid
+	}
+	return nil
+}
+
+// UseCounter 条件放在 WHERE 里面，同一个验证码同时提交两次也只有一次能成功
+func (dao *GORMTwoFactorDAO) UseCounter(ctx context.Context, uid int64, counter int64) error {
+	res := dao.db.WithContext(ctx).Model(&UserTOTP{}).
+		Where("uid = ? AND last_counter < ?", uid, counter).
+		Updates(map[string]any{
+			"last_counter": counter,
+			"utime":        time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrTOTPCounterUsed
+	}
+	return nil
+}
+
+// UserTOTP 一个用户只有一个密钥，扫码之后验证通过了才算开启
+type UserTOTP struct {
+	Uid int64 `gorm:"primaryKey,autoIncrement:false"`
+	// Secret 校验验证码的时候要用原文，没办法哈希
+	Secret  string `gorm:"type:varchar(64)"`
+	Enabled bool
+	// LastCounter 最后一次验证通过的时间窗口，不比它新的验证码都不能再用了
+	LastCounter int64
+	Ctime       int64
+	Utime       int64
+}
+
+// RecoveryCode 手机丢了的时候用来登录，只存哈希
+// 恢复码是 80 位的随机数，穷举不现实，用 SHA256 就行，不需要 bcrypt 那种慢哈希
+type RecoveryCode struct {
+	Id   int64  `gorm:"primaryKey,autoIncrement"`
+	Uid  int64  `gorm:"uniqueIndex:uid_hash"`
+	Hash string `gorm:"type:char(64);uniqueIndex:uid_hash"`
+	// UsedAt 用掉的时间，没用过是 0
+	UsedAt int64
+	Ctime  int64
+}
@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGORMTwoFactorDAO_UseCounter(t *testing.T) {
+	testcases := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{
+			name:     "新的时间窗口",
+			affected: 1,
+		},
+		{
+			name:    "这个时间窗口已经用过了",
+			wantErr: ErrTOTPCounterUsed,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			mock.ExpectExec("UPDATE `user_totps` SET .* WHERE uid = \\? AND last_counter < \\?").
+				WithArgs(int64(100), sqlmock.AnyArg(), int64(1), int64(100)).
+				WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			err = NewGORMTwoFactorDAO(newMockGormDB(t, mockDB)).UseCounter(context.Background(), 1, 100)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -6,10 +6,22 @@ import (
 	"errors"
 	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 )
 
-var ErrUserDuplicateEmail error = errors.New("邮箱冲突")
+var (
+	ErrUserDuplicateEmail error = errors.New("邮箱冲突")
+	ErrUserDuplicatePhone error = errors.New("手机号冲突")
+	// ErrUserDuplicateWechat 这个微信已经绑定了别的账号
+	ErrUserDuplicateWechat error = errors.New("微信冲突")
+	// ErrUserDuplicateNickname 昵称已经被别人用了
+	ErrUserDuplicateNickname error = errors.New("昵称冲突")
+	// ErrUserLastLoginMethod 解绑之后就没有办法登录了
+	ErrUserLastLoginMethod error = errors.New("不能解绑最后一种登录方式")
+)
+
+const uniqueIndexErrNo uint16 = 1062
 
 type UserDAO interface {
 	Insert(ctx context.Context, u User) error
@@ -17,6 +29,19 @@ type UserDAO interface {
 	FindById(ctx context.Context, id int64) (User, error)
 	FindByPhone(ctx context.Context, phone string) (User, error)
 	FindByWechat(ctx context.Context, openID string) (User, error)
+	// UpdateProfile 更新昵称、简介、生日和头像，昵称被别人用了返回 ErrUserDuplicateNickname
+	UpdateProfile(ctx context.Context, u User) error
+	UpdatePassword(ctx context.Context, id int64, password string) error
+	// UpdateEmail 绑定或者换邮箱，被别人用了返回 ErrUserDuplicateEmail
+	UpdateEmail(ctx context.Context, id int64, email string) error
+	// UpdatePhone 绑定或者换手机号，被别人用了返回 ErrUserDuplicatePhone
+	UpdatePhone(ctx context.Context, id int64, phone string) error
+	// UpdateWechat 绑定微信，被别人用了返回 ErrUserDuplicateWechat
+	UpdateWechat(ctx context.Context, id int64, openID, unionID string) error
+	// ClearEmail ClearPhone ClearWechat 解绑，剩下的登录方式一个都没有的话返回 ErrUserLastLoginMethod
+	ClearEmail(ctx context.Context, id int64) error
+	ClearPhone(ctx context.Context, id int64) error
+	ClearWechat(ctx context.Context, id int64) error
 }
 
 type GormUserDAO struct {
@@ -36,12 +61,33 @@ func (ud *GormUserDAO) Insert(ctx context.Context, u User) error {
 	u.Ctime = now
 	// 插入数据库
 	err := ud.db.WithContext(ctx).Create(&u).Error
+	return uniqueErr(err)
+}
+
+// uniqueKeyErrs 唯一索引的名字对应的错误
+var uniqueKeyErrs = map[string]error{
+	"users.email":          ErrUserDuplicateEmail,
+	"users.phone":          ErrUserDuplicatePhone,
+	"users.wechat_open_id": ErrUserDuplicateWechat,
+	"users.nickname":       ErrUserDuplicateNickname,
+}
+
+// uniqueErr 唯一索引冲突的时候，根据冲突的是哪个索引返回对应的错误
+// 别的索引冲突了原样返回
+func uniqueErr(err error) error {
 	mysqlErr, ok := err.(*mysql.MySQLError)
-	if ok {
-		const uniqueIndexErrNo uint16 = 1062
-		if mysqlErr.Number == uniqueIndexErrNo {
-			return ErrUserDuplicateEmail
-		}
+	if !ok || mysqlErr.Number != uniqueIndexErrNo {
+		return err
+	}
+	// Message 形如 Duplicate entry 'xxx' for key 'users.phone'
+	const prefix = "for key '"
+	idx := strings.LastIndex(mysqlErr.Message, prefix)
+	if idx < 0 {
+		return err
+	}
+	key := strings.TrimSuffix(mysqlErr.Message[idx+len(prefix):], "'")
+	if e, ok := uniqueKeyErrs[key]; ok {
+		return e
 	}
 	return err
 }
@@ -82,6 +128,90 @@ func (ud *GormUserDAO) FindByWechat(ctx context.Context, openID string) (User, e
 	return u, err
 }
 
+func (ud *GormUserDAO) UpdateProfile(ctx context.Context, u User) error {
+	// 两个人同时改成同一个昵称，靠唯一索引挡住后面那个
+	err := ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", u.Id).
+		Updates(map[string]any{
+			"nickname": u.Nickname,
+			"bio":      u.Bio,
+			"birthday": u.Birthday,
+			"avatar":   u.Avatar,
+			"utime":    time.Now().UnixMilli(),
+		}).Error
+	return uniqueErr(err)
+}
+
+func (ud *GormUserDAO) UpdatePassword(ctx context.Context, id int64, password string) error {
+	return ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"password": password,
+			"utime":    time.Now().UnixMilli(),
+		}).Error
+}
+
+func (ud *GormUserDAO) UpdateEmail(ctx context.Context, id int64, email string) error {
+	return ud.update(ctx, id, map[string]any{
+		"email": email,
+	})
+}
+
+func (ud *GormUserDAO) UpdatePhone(ctx context.Context, id int64, phone string) error {
+	return ud.update(ctx, id, map[string]any{
+		"phone": phone,
+	})
+}
+
+func (ud *GormUserDAO) UpdateWechat(ctx context.Context, id int64, openID, unionID string) error {
+	return ud.update(ctx, id, map[string]any{
+		"wechat_open_id": openID,
+		"wechat_union_id": sql.NullString{
+			String: unionID,
+			Valid:  unionID != "",
+		},
+	})
+}
+
+func (ud *GormUserDAO) update(ctx context.Context, id int64, fields map[string]any) error {
+	fields["utime"] = time.Now().UnixMilli()
+	err := ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).Updates(fields).Error
+	return uniqueErr(err)
+}
+
+func (ud *GormUserDAO) ClearEmail(ctx context.Context, id int64) error {
+	return ud.clear(ctx, id, map[string]any{
+		"email": nil,
+	}, "phone IS NOT NULL OR wechat_open_id IS NOT NULL")
+}
+
+func (ud *GormUserDAO) ClearPhone(ctx context.Context, id int64) error {
+	return ud.clear(ctx, id, map[string]any{
+		"phone": nil,
+	}, "email IS NOT NULL OR wechat_open_id IS NOT NULL")
+}
+
+func (ud *GormUserDAO) ClearWechat(ctx context.Context, id int64) error {
+	return ud.clear(ctx, id, map[string]any{
+		"wechat_open_id":  nil,
+		"wechat_union_id": nil,
+	}, "email IS NOT NULL OR phone IS NOT NULL")
+}
+
+// clear 剩下的登录方式放在 WHERE 里面，两个解绑请求同时来也只会有一个成功
+func (ud *GormUserDAO) clear(ctx context.Context, id int64, fields map[string]any, others string) error {
+	fields["utime"] = time.Now().UnixMilli()
+	res := ud.db.WithContext(ctx).Model(&User{}).
+		Where("id = ?", id).
+		Where(others).
+		Updates(fields)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrUserLastLoginMethod
+	}
+	return nil
+}
+
 type User struct {
 	Id            int64          `gorm:"primaryKey,autoIncrement"`
 	Email         sql.NullString `gorm:"unique"`
@@ -89,7 +219,18 @@ type User struct {
 	Ctime         int64
 	Utime         int64
 	WechatUnionID sql.NullString // 微信的字段
-	WechatOpenID  sql.NullString `gorm:"unique"` // 微信的字段
-	Phone         sql.NullString `gorm:"unique"` // 和 email一样是唯一索引，但是null值不冲突
-	Nickname      string
+	WechatOpenID  sql.NullString `gorm:"unique"`                   // 微信的字段
+	Phone         sql.NullString `gorm:"unique"`                   // 和 email一样是唯一索引，但是null值不冲突
+	Nickname      sql.NullString `gorm:"type:varchar(128);unique"` // 没有填昵称的是 NULL，唯一索引里面 NULL 不冲突
+	// Bio 个人简介
+	Bio string `gorm:"type:varchar(1024)"`
+	// Birthday 生日的毫秒数，没有填就是 NULL
+	Birthday sql.NullInt64
+	Avatar   string `gorm:"type:varchar(1024)"`
+	// MergedInto 被合并到哪个账号了，没有合并过是 0
+	MergedInto int64
+	// Status 加字段之前的用户默认是正常的
+	Status uint8 `gorm:"not null;default:1"`
+	// DeleteAt 注销冷静期结束的时间，毫秒数，清理注销的账号按照这个字段来找
+	DeleteAt int64 `gorm:"index"`
 }
@@ -53,7 +53,27 @@ func TestGORMUserDAO_Insert(t *testing.T) {
 				// 这个写法的意思就是，只要是 INSERT 到 users 的语句
 				mock.ExpectExec("INSERT INTO `users` .*").
 					WillReturnError(&mysql.MySQLError{
-						Number: 1062,
+						Number:  1062,
+						Message: "Duplicate entry 'a@qq.com' for key 'users.email'",
+					})
+				require.NoError(t, err)
+				return mockDB
+			},
+		},
+		{
+			name: "别的唯一索引冲突，原样返回",
+			ctx:  context.Background(),
+			user: User{},
+			wantErr: &mysql.MySQLError{
+				Number:  1062,
+				Message: "Duplicate entry '1' for key 'users.PRIMARY'",
+			},
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				mock.ExpectExec("INSERT INTO `users` .*").
+					WillReturnError(&mysql.MySQLError{
+						Number:  1062,
+						Message: "Duplicate entry '1' for key 'users.PRIMARY'",
 					})
 				require.NoError(t, err)
 				return mockDB
@@ -95,3 +115,148 @@ func TestGORMUserDAO_Insert(t *testing.T) {
 		})
 	}
 }
+
+func TestGORMUserDAO_UpdateProfile(t *testing.T) {
+	testcases := []struct {
+		name    string
+		user    User
+		wantErr error
+		sqlmock func(t *testing.T) *sql.DB
+	}{
+		{
+			name: "更新成功",
+			user: User{Id: 1, Nickname: sql.NullString{String: "大明", Valid: true}},
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .*`nickname`=.* WHERE id = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				return mockDB
+			},
+		},
+		{
+			name:    "昵称被别人用了",
+			user:    User{Id: 1, Nickname: sql.NullString{String: "大明", Valid: true}},
+			wantErr: ErrUserDuplicateNickname,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .*").
+					WillReturnError(&mysql.MySQLError{
+						Number:  1062,
+						Message: "Duplicate entry '大明' for key 'users.nickname'",
+					})
+				return mockDB
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+				Conn:                      tc.sqlmock(t),
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			err = NewGormUserDAO(db).UpdateProfile(context.Background(), tc.user)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestGORMUserDAO_UpdatePhone(t *testing.T) {
+	testcases := []struct {
+		name    string
+		wantErr error
+		sqlmock func(t *testing.T) *sql.DB
+	}{
+		{
+			name: "绑定成功",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET `phone`=.*,`utime`=.* WHERE id = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				return mockDB
+			},
+		},
+		{
+			name:    "手机号被别人用了",
+			wantErr: ErrUserDuplicatePhone,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .*").
+					WillReturnError(&mysql.MySQLError{
+						Number:  1062,
+						Message: "Duplicate entry '13800000000' for key 'users.phone'",
+					})
+				return mockDB
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+				Conn:                      tc.sqlmock(t),
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			err = NewGormUserDAO(db).UpdatePhone(context.Background(), 1, "13800000000")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestGORMUserDAO_ClearPhone(t *testing.T) {
+	testcases := []struct {
+		name    string
+		wantErr error
+		sqlmock func(t *testing.T) *sql.DB
+	}{
+		{
+			name: "解绑成功",
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET `phone`=.*,`utime`=.* " +
+					"WHERE id = .* AND \\(email IS NOT NULL OR wechat_open_id IS NOT NULL\\)").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				return mockDB
+			},
+		},
+		{
+			name:    "最后一种登录方式",
+			wantErr: ErrUserLastLoginMethod,
+			sqlmock: func(t *testing.T) *sql.DB {
+				mockDB, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectExec("UPDATE `users` SET .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				return mockDB
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+				Conn:                      tc.sqlmock(t),
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			err = NewGormUserDAO(db).ClearPhone(context.Background(), 1)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -1,7 +1,54 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/dao"
+)
 
+//go:generate mockgen -source=history.go -package=repomocks -destination=mock/history.mock.go HistoryRecordRepository
 type HistoryRecordRepository interface {
-	AddRecord(ctx context.Context)
+	AddRecord(ctx context.Context, r domain.HistoryRecord) error
+	ListRecords(ctx context.Context, uid int64, offset, limit int) ([]domain.HistoryRecord, error)
+	ClearRecords(ctx context.Context, uid int64) error
+}
+
+type historyRecordRepository struct {
+	dao dao.HistoryRecordDAO
+}
+
+func NewHistoryRecordRepository(dao dao.HistoryRecordDAO) HistoryRecordRepository {
+	return &historyRecordRepository{
+		dao: dao,
+	}
+}
+
+func (h *historyRecordRepository) AddRecord(ctx context.Context, r domain.HistoryRecord) error {
+	return h.dao.Upsert(ctx, dao.HistoryRecord{
+		Uid:      r.Uid,
+		Biz:      r.Biz,
+		BizId:    r.BizId,
+		ReadTime: r.ReadTime.UnixMilli(),
+	})
+}
+
+func (h *historyRecordRepository) ListRecords(ctx context.Context, uid int64, offset, limit int) ([]domain.HistoryRecord, error) {
+	rs, err := h.dao.FindByUid(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.HistoryRecord, domain.HistoryRecord](rs, func(idx int, src dao.HistoryRecord) domain.HistoryRecord {
+		return domain.HistoryRecord{
+			Uid:      src.Uid,
+			Biz:      src.Biz,
+			BizId:    src.BizId,
+			ReadTime: time.UnixMilli(src.ReadTime),
+		}
+	}), nil
+}
+
+func (h *historyRecordRepository) ClearRecords(ctx context.Context, uid int64) error {
+	return h.dao.DeleteByUid(ctx, uid)
 }
@@ -14,6 +14,7 @@ type JobRepository interface {
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, next time.Time) error
 	Stop(ctx context.Context, id int64) error
+	AddJob(ctx context.Context, j domain.Job) error
 }
 
 type CronJobRepository struct {
@@ -42,6 +43,17 @@ func (c *CronJobRepository) Stop(ctx context.Context, id int64) error {
 	return c.dao.Stop(ctx, id)
 }
 
+func (c *CronJobRepository) AddJob(ctx context.Context, j domain.Job) error {
+	return c.dao.Insert(ctx, dao.Job{
+		Name:     j.Name,
+		Cron:     j.Cron,
+		Executor: j.Executor,
+		Cfg:      j.Cfg,
+		// 插入之后马上就可以被调度
+		NextTime: time.Now().UnixMilli(),
+	})
+}
+
 func (c *CronJobRepository) Preempt(ctx context.Context, refreshInterval time.Duration) (domain.Job, error) {
 	job, err := c.dao.Preempt(ctx, refreshInterval)
 	if err != nil {
@@ -52,5 +64,6 @@ func (c *CronJobRepository) Preempt(ctx context.Context, refreshInterval time.Du
 		Id:       job.Id,
 		Name:     job.Name,
 		Executor: job.Executor,
+		Cron:     job.Cron,
 	}, nil
 }
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account.go
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountRepository is a mock of AccountRepository interface.
+type MockAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountRepositoryMockRecorder
+}
+
+// MockAccountRepositoryMockRecorder is the mock recorder for MockAccountRepository.
+type MockAccountRepositoryMockRecorder struct {
+	mock *MockAccountRepository
+}
+
+// NewMockAccountRepository creates a new mock instance.
+func NewMockAccountRepository(ctrl *gomock.Controller) *MockAccountRepository {
+	mock := &MockAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountRepository) EXPECT() *MockAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Anonymise mocks base method.
+func (m *MockAccountRepository) Anonymise(ctx context.Context, id int64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymise", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Anonymise indicates an expected call of Anonymise.
+func (mr *MockAccountRepositoryMockRecorder) Anonymise(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymise", reflect.TypeOf((*MockAccountRepository)(nil).Anonymise), ctx, id, now)
+}
+
+// ConsumeRestoreTicket mocks base method.
+func (m *MockAccountRepository) ConsumeRestoreTicket(ctx context.Context, ticket string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeRestoreTicket", ctx, ticket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeRestoreTicket indicates an expected call of ConsumeRestoreTicket.
+func (mr *MockAccountRepositoryMockRecorder) ConsumeRestoreTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeRestoreTicket", reflect.TypeOf((*MockAccountRepository)(nil).ConsumeRestoreTicket), ctx, ticket)
+}
+
+// FindDeleting mocks base method.
+func (m *MockAccountRepository) FindDeleting(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeleting", ctx, now, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeleting indicates an expected call of FindDeleting.
+func (mr *MockAccountRepositoryMockRecorder) FindDeleting(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeleting", reflect.TypeOf((*MockAccountRepository)(nil).FindDeleting), ctx, now, limit)
+}
+
+// StoreRestoreTicket mocks base method.
+func (m *MockAccountRepository) StoreRestoreTicket(ctx context.Context, ticket string, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRestoreTicket", ctx, ticket, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRestoreTicket indicates an expected call of StoreRestoreTicket.
+func (mr *MockAccountRepositoryMockRecorder) StoreRestoreTicket(ctx, ticket, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRestoreTicket", reflect.TypeOf((*MockAccountRepository)(nil).StoreRestoreTicket), ctx, ticket, uid)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockAccountRepository) UpdateStatus(ctx context.Context, id int64, from []domain.UserStatus, to domain.UserStatus, deleteAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, deleteAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockAccountRepositoryMockRecorder) UpdateStatus(ctx, id, from, to, deleteAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockAccountRepository)(nil).UpdateStatus), ctx, id, from, to, deleteAt)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_merge.go
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountMergeRepository is a mock of AccountMergeRepository interface.
+type MockAccountMergeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountMergeRepositoryMockRecorder
+}
+
+// MockAccountMergeRepositoryMockRecorder is the mock recorder for MockAccountMergeRepository.
+type MockAccountMergeRepositoryMockRecorder struct {
+	mock *MockAccountMergeRepository
+}
+
+// NewMockAccountMergeRepository creates a new mock instance.
+func NewMockAccountMergeRepository(ctrl *gomock.Controller) *MockAccountMergeRepository {
+	mock := &MockAccountMergeRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountMergeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountMergeRepository) EXPECT() *MockAccountMergeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeTicket mocks base method.
+func (m *MockAccountMergeRepository) ConsumeTicket(ctx context.Context, ticket string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeTicket", ctx, ticket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeTicket indicates an expected call of ConsumeTicket.
+func (mr *MockAccountMergeRepositoryMockRecorder) ConsumeTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeTicket", reflect.TypeOf((*MockAccountMergeRepository)(nil).ConsumeTicket), ctx, ticket)
+}
+
+// Create mocks base method.
+func (m_2 *MockAccountMergeRepository) Create(ctx context.Context, m domain.AccountMerge) (int64, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Create", ctx, m)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccountMergeRepositoryMockRecorder) Create(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountMergeRepository)(nil).Create), ctx, m)
+}
+
+// FindByStatus mocks base method.
+func (m *MockAccountMergeRepository) FindByStatus(ctx context.Context, status domain.AccountMergeStatus, before time.Time, limit int) ([]domain.AccountMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", ctx, status, before, limit)
+	ret0, _ := ret[0].([]domain.AccountMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockAccountMergeRepositoryMockRecorder) FindByStatus(ctx, status, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockAccountMergeRepository)(nil).FindByStatus), ctx, status, before, limit)
+}
+
+// Move mocks base method.
+func (m_2 *MockAccountMergeRepository) Move(ctx context.Context, m domain.AccountMerge) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Move", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockAccountMergeRepositoryMockRecorder) Move(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockAccountMergeRepository)(nil).Move), ctx, m)
+}
+
+// StoreTicket mocks base method.
+func (m *MockAccountMergeRepository) StoreTicket(ctx context.Context, ticket string, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTicket", ctx, ticket, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTicket indicates an expected call of StoreTicket.
+func (mr *MockAccountMergeRepositoryMockRecorder) StoreTicket(ctx, ticket, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTicket", reflect.TypeOf((*MockAccountMergeRepository)(nil).StoreTicket), ctx, ticket, uid)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockAccountMergeRepository) UpdateStatus(ctx context.Context, id int64, status domain.AccountMergeStatus, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockAccountMergeRepositoryMockRecorder) UpdateStatus(ctx, id, status, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockAccountMergeRepository)(nil).UpdateStatus), ctx, id, status, reason)
+}
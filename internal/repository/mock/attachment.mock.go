@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachment.go
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BindArticle mocks base method.
+func (m *MockAttachmentRepository) BindArticle(ctx context.Context, uid, artId int64, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindArticle", ctx, uid, artId, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindArticle indicates an expected call of BindArticle.
+func (mr *MockAttachmentRepositoryMockRecorder) BindArticle(ctx, uid, artId, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindArticle", reflect.TypeOf((*MockAttachmentRepository)(nil).BindArticle), ctx, uid, artId, keys)
+}
+
+// Create mocks base method.
+func (m *MockAttachmentRepository) Create(ctx context.Context, a domain.Attachment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, a)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentRepositoryMockRecorder) Create(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentRepository)(nil).Create), ctx, a)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentRepository) Delete(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, ids)
+}
+
+// FindOrphans mocks base method.
+func (m *MockAttachmentRepository) FindOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrphans", ctx, before, limit)
+	ret0, _ := ret[0].([]domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrphans indicates an expected call of FindOrphans.
+func (mr *MockAttachmentRepositoryMockRecorder) FindOrphans(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrphans", reflect.TypeOf((*MockAttachmentRepository)(nil).FindOrphans), ctx, before, limit)
+}
+
+// UnbindArticle mocks base method.
+func (m *MockAttachmentRepository) UnbindArticle(ctx context.Context, artId int64, keepKeys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindArticle", ctx, artId, keepKeys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbindArticle indicates an expected call of UnbindArticle.
+func (mr *MockAttachmentRepositoryMockRecorder) UnbindArticle(ctx, artId, keepKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindArticle", reflect.TypeOf((*MockAttachmentRepository)(nil).UnbindArticle), ctx, artId, keepKeys)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: history.go
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHistoryRecordRepository is a mock of HistoryRecordRepository interface.
+type MockHistoryRecordRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryRecordRepositoryMockRecorder
+}
+
+// MockHistoryRecordRepositoryMockRecorder is the mock recorder for MockHistoryRecordRepository.
+type MockHistoryRecordRepositoryMockRecorder struct {
+	mock *MockHistoryRecordRepository
+}
+
+// NewMockHistoryRecordRepository creates a new mock instance.
+func NewMockHistoryRecordRepository(ctrl *gomock.Controller) *MockHistoryRecordRepository {
+	mock := &MockHistoryRecordRepository{ctrl: ctrl}
+	mock.recorder = &MockHistoryRecordRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryRecordRepository) EXPECT() *MockHistoryRecordRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddRecord mocks base method.
+func (m *MockHistoryRecordRepository) AddRecord(ctx context.Context, r domain.HistoryRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecord", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecord indicates an expected call of AddRecord.
+func (mr *MockHistoryRecordRepositoryMockRecorder) AddRecord(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecord", reflect.TypeOf((*MockHistoryRecordRepository)(nil).AddRecord), ctx, r)
+}
+
+// ClearRecords mocks base method.
+func (m *MockHistoryRecordRepository) ClearRecords(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecords", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRecords indicates an expected call of ClearRecords.
+func (mr *MockHistoryRecordRepositoryMockRecorder) ClearRecords(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecords", reflect.TypeOf((*MockHistoryRecordRepository)(nil).ClearRecords), ctx, uid)
+}
+
+// ListRecords mocks base method.
+func (m *MockHistoryRecordRepository) ListRecords(ctx context.Context, uid int64, offset, limit int) ([]domain.HistoryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecords", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.HistoryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecords indicates an expected call of ListRecords.
+func (mr *MockHistoryRecordRepositoryMockRecorder) ListRecords(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockHistoryRecordRepository)(nil).ListRecords), ctx, uid, offset, limit)
+}
@@ -0,0 +1,221 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: two_factor.go
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTwoFactorRepository is a mock of TwoFactorRepository interface.
+type MockTwoFactorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorRepositoryMockRecorder
+}
+
+// MockTwoFactorRepositoryMockRecorder is the mock recorder for MockTwoFactorRepository.
+type MockTwoFactorRepositoryMockRecorder struct {
+	mock *MockTwoFactorRepository
+}
+
+// NewMockTwoFactorRepository creates a new mock instance.
+func NewMockTwoFactorRepository(ctrl *gomock.Controller) *MockTwoFactorRepository {
+	mock := &MockTwoFactorRepository{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorRepository) EXPECT() *MockTwoFactorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DelChallenge mocks base method.
+func (m *MockTwoFactorRepository) DelChallenge(ctx context.Context, challenge string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelChallenge", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelChallenge indicates an expected call of DelChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) DelChallenge(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).DelChallenge), ctx, challenge)
+}
+
+// Delete mocks base method.
+func (m *MockTwoFactorRepository) Delete(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTwoFactorRepositoryMockRecorder) Delete(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTwoFactorRepository)(nil).Delete), ctx, uid)
+}
+
+// Enable mocks base method.
+func (m *MockTwoFactorRepository) Enable(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockTwoFactorRepositoryMockRecorder) Enable(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockTwoFactorRepository)(nil).Enable), ctx, uid)
+}
+
+// FailChallenge mocks base method.
+func (m *MockTwoFactorRepository) FailChallenge(ctx context.Context, challenge string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailChallenge", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailChallenge indicates an expected call of FailChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) FailChallenge(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).FailChallenge), ctx, challenge)
+}
+
+// FailVerify mocks base method.
+func (m *MockTwoFactorRepository) FailVerify(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailVerify", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailVerify indicates an expected call of FailVerify.
+func (mr *MockTwoFactorRepositoryMockRecorder) FailVerify(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailVerify", reflect.TypeOf((*MockTwoFactorRepository)(nil).FailVerify), ctx, uid)
+}
+
+// FindChallenge mocks base method.
+func (m *MockTwoFactorRepository) FindChallenge(ctx context.Context, challenge string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChallenge", ctx, challenge)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChallenge indicates an expected call of FindChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) FindChallenge(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).FindChallenge), ctx, challenge)
+}
+
+// FindSecret mocks base method.
+func (m *MockTwoFactorRepository) FindSecret(ctx context.Context, uid int64) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecret", ctx, uid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindSecret indicates an expected call of FindSecret.
+func (mr *MockTwoFactorRepositoryMockRecorder) FindSecret(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecret", reflect.TypeOf((*MockTwoFactorRepository)(nil).FindSecret), ctx, uid)
+}
+
+// Locked mocks base method.
+func (m *MockTwoFactorRepository) Locked(ctx context.Context, uid int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locked", ctx, uid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Locked indicates an expected call of Locked.
+func (mr *MockTwoFactorRepositoryMockRecorder) Locked(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locked", reflect.TypeOf((*MockTwoFactorRepository)(nil).Locked), ctx, uid)
+}
+
+// ResetFailures mocks base method.
+func (m *MockTwoFactorRepository) ResetFailures(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetFailures", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetFailures indicates an expected call of ResetFailures.
+func (mr *MockTwoFactorRepositoryMockRecorder) ResetFailures(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailures", reflect.TypeOf((*MockTwoFactorRepository)(nil).ResetFailures), ctx, uid)
+}
+
+// Save mocks base method.
+func (m *MockTwoFactorRepository) Save(ctx context.Context, uid int64, secret string, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, uid, secret, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTwoFactorRepositoryMockRecorder) Save(ctx, uid, secret, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTwoFactorRepository)(nil).Save), ctx, uid, secret, codeHashes)
+}
+
+// StoreChallenge mocks base method.
+func (m *MockTwoFactorRepository) StoreChallenge(ctx context.Context, challenge string, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreChallenge", ctx, challenge, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreChallenge indicates an expected call of StoreChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) StoreChallenge(ctx, challenge, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).StoreChallenge), ctx, challenge, uid)
+}
+
+// UseCounter mocks base method.
+func (m *MockTwoFactorRepository) UseCounter(ctx context.Context, uid, counter int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseCounter", ctx, uid, counter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseCounter indicates an expected call of UseCounter.
+func (mr *MockTwoFactorRepositoryMockRecorder) UseCounter(ctx, uid, counter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseCounter", reflect.TypeOf((*MockTwoFactorRepository)(nil).UseCounter), ctx, uid, counter)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockTwoFactorRepository) UseRecoveryCode(ctx context.Context, uid int64, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, uid, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockTwoFactorRepositoryMockRecorder) UseRecoveryCode(ctx, uid, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockTwoFactorRepository)(nil).UseRecoveryCode), ctx, uid, hash)
+}
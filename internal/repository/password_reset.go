@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"webookpro/internal/repository/cache"
+)
+
+var ErrResetTokenNotFound = cache.ErrResetTokenNotFound
+
+type PasswordResetRepository interface {
+	StoreToken(ctx context.Context, token string, uid int64) error
+	// ConsumeToken 凭证只能用一次，不存在的时候返回 ErrResetTokenNotFound
+	ConsumeToken(ctx context.Context, token string) (int64, error)
+}
+
+type CachedPasswordResetRepository struct {
+	cache cache.PasswordResetCache
+}
+
+func NewCachedPasswordResetRepository(cache cache.PasswordResetCache) PasswordResetRepository {
+	return &CachedPasswordResetRepository{
+		cache: cache,
+	}
+}
+
+func (repo *CachedPasswordResetRepository) StoreToken(ctx context.Context, token string, uid int64) error {
+	return repo.cache.Set(ctx, token, uid)
+}
+
+func (repo *CachedPasswordResetRepository) ConsumeToken(ctx context.Context, token string) (int64, error) {
+	return repo.cache.GetDel(ctx, token)
+}
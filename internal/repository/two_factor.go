@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"gorm.io/gorm"
+	"webookpro/internal/repository/cache"
+	"webookpro/internal/repository/dao"
+)
+
+var (
+	ErrTwoFactorEnabled    = dao.ErrTwoFactorEnabled
+	ErrRecoveryCodeInvalid = dao.ErrRecoveryCodeInvalid
+	ErrTOTPCounterUsed     = dao.ErrTOTPCounterUsed
+	ErrChallengeNotFound   = cache.ErrChallengeNotFound
+)
+
+//go:generate mockgen -source=two_factor.go -package=repomocks -destination=mock/two_factor.mock.go TwoFactorRepository
+type TwoFactorRepository interface {
+	// Save 保存还没有确认的密钥，恢复码传进来的时候已经哈希过了
+	Save(ctx context.Context, uid int64, secret string, codeHashes []string) error
+	// FindSecret 返回密钥和是否已经开启，没有绑定过的时候 secret 是空的
+	FindSecret(ctx context.Context, uid int64) (string, bool, error)
+	Enable(ctx context.Context, uid int64) error
+	Delete(ctx context.Context, uid int64) error
+	UseRecoveryCode(ctx context.Context, uid int64, hash string) error
+	// UseCounter 同一个时间窗口的验证码只能用一次，用过了返回 ErrTOTPCounterUsed
+	UseCounter(ctx context.Context, uid int64, counter int64) error
+
+	StoreChallenge(ctx context.Context, challenge string, uid int64) error
+	FindChallenge(ctx context.Context, challenge string) (int64, error)
+	FailChallenge(ctx context.Context, challenge string) error
+	DelChallenge(ctx context.Context, challenge string) error
+
+	// FailVerify 记一次输错，输错太多次 Locked 返回 true
+	FailVerify(ctx context.Context, uid int64) error
+	Locked(ctx context.Context, uid int64) (bool, error)
+	ResetFailures(ctx context.Context, uid int64) error
+}
+
+type CachedTwoFactorRepository struct {
+	dao   dao.TwoFactorDAO
+	cache cache.TwoFactorCache
+}
+
+func NewCachedTwoFactorRepository(dao dao.TwoFactorDAO, cache cache.TwoFactorCache) TwoFactorRepository {
+	return &CachedTwoFactorRepository{
+		dao:   dao,
+		cache: cache,
+	}
+}
+
+func (repo *CachedTwoFactorRepository) Save(ctx context.Context, uid int64, secret string, codeHashes []string) error {
+	return repo.dao.Upsert(ctx, dao.UserTOTP{
+		Uid:    uid,
+		Secret: secret,
+	}, slice.Map(codeHashes, func(idx int, src string) dao.RecoveryCode {
+		return dao.RecoveryCode{Hash: src}
+	}))
+}
+
+func (repo *CachedTwoFactorRepository) FindSecret(ctx context.Context, uid int64) (string, bool, error) {
+	t, err := repo.dao.FindByUid(ctx, uid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", false, nil
+	}
+	return t.Secret, t.Enabled, err
+}
+
+func (repo *CachedTwoFactorRepository) Enable(ctx context.Context, uid int64) error {
+	return repo.dao.Enable(ctx, uid)
+}
+
+func (repo *CachedTwoFactorRepository) Delete(ctx context.Context, uid int64) error {
+	return repo.dao.Delete(ctx, uid)
+}
+
+func (repo *CachedTwoFactorRepository) UseRecoveryCode(ctx context.Context, uid int64, hash string) error {
+	return repo.dao.UseRecoveryCode(ctx, uid, hash)
+}
+
+func (repo *CachedTwoFactorRepository) UseCounter(ctx context.Context, uid int64, counter int64) error {
+	return repo.dao.UseCounter(ctx, uid, counter)
+}
+
+func (repo *CachedTwoFactorRepository) StoreChallenge(ctx context.Context, challenge string, uid int64) error {
+	return repo.cache.Set(ctx, challenge, uid)
+}
+
+func (repo *CachedTwoFactorRepository) FindChallenge(ctx context.Context, challenge string) (int64, error) {
+	return repo.cache.Get(ctx, challenge)
+}
+
+func (repo *CachedTwoFactorRepository) FailChallenge(ctx context.Context, challenge string) error {
+	return repo.cache.Fail(ctx, challenge)
+}
+
+func (repo *CachedTwoFactorRepository) DelChallenge(ctx context.Context, challenge string) error {
+	return repo.cache.Del(ctx, challenge)
+}
+
+func (repo *CachedTwoFactorRepository) FailVerify(ctx context.Context, uid int64) error {
+	return repo.cache.FailUser(ctx, uid)
+}
+
+func (repo *CachedTwoFactorRepository) Locked(ctx context.Context, uid int64) (bool, error) {
+	return repo.cache.UserLocked(ctx, uid)
+}
+
+func (repo *CachedTwoFactorRepository) ResetFailures(ctx context.Context, uid int64) error {
+	return repo.cache.ResetUser(ctx, uid)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 	"webookpro/internal/domain"
 	"webookpro/internal/repository/cache"
@@ -10,8 +11,12 @@ import (
 )
 
 var (
-	ErrUserDuplicateEmail error = dao.ErrUserDuplicateEmail
-	ErrKeyNotExist        error = cache.ErrKeyNotExist
+	ErrUserDuplicateEmail    error = dao.ErrUserDuplicateEmail
+	ErrUserDuplicatePhone    error = dao.ErrUserDuplicatePhone
+	ErrUserDuplicateWechat   error = dao.ErrUserDuplicateWechat
+	ErrUserDuplicateNickname error = dao.ErrUserDuplicateNickname
+	ErrUserLastLoginMethod   error = dao.ErrUserLastLoginMethod
+	ErrKeyNotExist           error = cache.ErrKeyNotExist
 )
 
 type UserRepository interface {
@@ -20,6 +25,15 @@ type UserRepository interface {
 	FindById(ctx context.Context, id int64) (domain.User, error)
 	FindByPhone(ctx context.Context, phone string) (domain.User, error)
 	FindByWechat(ctx context.Context, openID string) (domain.User, error)
+	// UpdateProfile 只更新昵称、简介、生日和头像
+	UpdateProfile(ctx context.Context, user domain.User) error
+	// UpdatePassword password 是加密之后的
+	UpdatePassword(ctx context.Context, id int64, password string) error
+	BindEmail(ctx context.Context, id int64, email string) error
+	BindPhone(ctx context.Context, id int64, phone string) error
+	BindWechat(ctx context.Context, id int64, info domain.WeChatInfo) error
+	// Unbind 解绑之后一种登录方式都没有的话返回 ErrUserLastLoginMethod
+	Unbind(ctx context.Context, id int64, method domain.LoginMethod) error
 }
 
 type CachedUserRepository struct {
@@ -87,19 +101,92 @@ func (u *CachedUserRepository) FindByWechat(ctx context.Context, openID string)
 	return u.entityToDomain(user), err
 }
 
+// UpdateProfile 先更新数据库再删缓存，帖子里面的作者昵称也是从这里查的
+func (u *CachedUserRepository) UpdateProfile(ctx context.Context, user domain.User) error {
+	err := u.dao.UpdateProfile(ctx, u.domainToEntity(user))
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, user.Id)
+}
+
+// UpdatePassword 缓存里面也有密码，一样要删掉
+func (u *CachedUserRepository) UpdatePassword(ctx context.Context, id int64, password string) error {
+	err := u.dao.UpdatePassword(ctx, id, password)
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, id)
+}
+
+func (u *CachedUserRepository) BindEmail(ctx context.Context, id int64, email string) error {
+	err := u.dao.UpdateEmail(ctx, id, email)
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, id)
+}
+
+func (u *CachedUserRepository) BindPhone(ctx context.Context, id int64, phone string) error {
+	err := u.dao.UpdatePhone(ctx, id, phone)
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, id)
+}
+
+func (u *CachedUserRepository) BindWechat(ctx context.Context, id int64, info domain.WeChatInfo) error {
+	err := u.dao.UpdateWechat(ctx, id, info.OpenID, info.UnionID)
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, id)
+}
+
+func (u *CachedUserRepository) Unbind(ctx context.Context, id int64, method domain.LoginMethod) error {
+	var err error
+	switch method {
+	case domain.LoginMethodEmail:
+		err = u.dao.ClearEmail(ctx, id)
+	case domain.LoginMethodPhone:
+		err = u.dao.ClearPhone(ctx, id)
+	case domain.LoginMethodWechat:
+		err = u.dao.ClearWechat(ctx, id)
+	default:
+		return fmt.Errorf("未知的登录方式 %d", method)
+	}
+	if err != nil {
+		return err
+	}
+	return u.cache.Del(ctx, id)
+}
+
 // entityToDomain 实体对象转领域对象
 func (u *CachedUserRepository) entityToDomain(user dao.User) domain.User {
-	return domain.User{
+	var birthday time.Time
+	if user.Birthday.Valid {
+		birthday = time.UnixMilli(user.Birthday.Int64)
+	}
+	res := domain.User{
 		Id:       user.Id,
 		Email:    user.Email.String,
 		Password: user.Password,
 		Ctime:    time.UnixMilli(user.Ctime),
 		Phone:    user.Phone.String,
+		Nickname: user.Nickname.String,
+		Bio:      user.Bio,
+		Birthday: birthday,
+		Avatar:   user.Avatar,
 		Wechat: domain.WeChatInfo{
 			OpenID:  user.WechatOpenID.String,
 			UnionID: user.WechatUnionID.String,
 		},
+		Status: domain.UserStatus(user.Status),
 	}
+	if user.DeleteAt > 0 {
+		res.DeleteAt = time.UnixMilli(user.DeleteAt)
+	}
+	return res
 }
 
 // domainToEntity 领域对象转实体对象
@@ -116,6 +203,16 @@ func (u *CachedUserRepository) domainToEntity(user domain.User) dao.User {
 		},
 		Password: user.Password,
 		Ctime:    user.Ctime.UnixMilli(),
+		Nickname: sql.NullString{
+			String: user.Nickname,
+			Valid:  user.Nickname != "",
+		},
+		Bio: user.Bio,
+		Birthday: sql.NullInt64{
+			Int64: user.Birthday.UnixMilli(),
+			Valid: !user.Birthday.IsZero(),
+		},
+		Avatar: user.Avatar,
 		WechatUnionID: sql.NullString{
 			String: user.Wechat.UnionID,
 			Valid:  user.Wechat.UnionID != "",
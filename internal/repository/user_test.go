@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -99,3 +100,51 @@ func TestCachedUserRepository_FindById(t *testing.T) {
 		})
 	}
 }
+
+func TestCachedUserRepository_UpdateProfile(t *testing.T) {
+	birthday := time.UnixMilli(time.Date(1990, 1, 2, 0, 0, 0, 0, time.Local).UnixMilli())
+	testcases := []struct {
+		name    string
+		user    domain.User
+		mock    func(controller *gomock.Controller) (dao.UserDAO, cache.UserCache)
+		wantErr error
+	}{
+		{
+			name: "更新成功，删掉缓存",
+			user: domain.User{Id: 1, Nickname: "大明", Birthday: birthday},
+			mock: func(controller *gomock.Controller) (dao.UserDAO, cache.UserCache) {
+				uc := cachemocks.NewMockUserCache(controller)
+				ud := daomocks.NewMockUserDAO(controller)
+				ud.EXPECT().UpdateProfile(gomock.Any(), dao.User{
+					Id:       1,
+					Nickname: sql.NullString{String: "大明", Valid: true},
+					Birthday: sql.NullInt64{Int64: birthday.UnixMilli(), Valid: true},
+					Ctime:    time.Time{}.UnixMilli(),
+				}).Return(nil)
+				uc.EXPECT().Del(gomock.Any(), int64(1)).Return(nil)
+				return ud, uc
+			},
+		},
+		{
+			name: "昵称重复，不用删缓存",
+			user: domain.User{Id: 1, Nickname: "大明"},
+			mock: func(controller *gomock.Controller) (dao.UserDAO, cache.UserCache) {
+				uc := cachemocks.NewMockUserCache(controller)
+				ud := daomocks.NewMockUserDAO(controller)
+				ud.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).Return(dao.ErrUserDuplicateNickname)
+				return ud, uc
+			},
+			wantErr: ErrUserDuplicateNickname,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ud, uc := tc.mock(ctrl)
+			err := NewCachedUserRepository(ud, uc).UpdateProfile(context.Background(), tc.user)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/google/uuid"
+	"time"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	"webookpro/internal/domain"
+	events "webookpro/internal/events/article"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+)
+
+const (
+	// AccountDeleteGracePeriod 注销的冷静期，期间登录可以恢复账号
+	AccountDeleteGracePeriod = time.Hour * 24 * 15
+	accountScanBatch         = 100
+)
+
+var (
+	ErrUserStatusConflict = repository.ErrUserStatusConflict
+	// ErrInvalidRestoreTicket 恢复账号的凭证过期了或者已经用过了
+	ErrInvalidRestoreTicket = errors.New("恢复账号的凭证无效")
+)
+
+// AccountService 停用、注销账号和导出个人数据
+// 停用和注销中的账号不能登录，登录的时候会拿到一个恢复凭证，带着凭证确认之后才恢复
+//
+//go:generate mockgen -source=account.go -package=svcmocks -destination=mock/account.mock.go AccountService
+type AccountService interface {
+	// Deactivate 停用账号，发表的帖子也藏起来
+	Deactivate(ctx context.Context, uid int64) error
+	// Delete 申请注销，返回冷静期结束的时间，停用的账号也可以直接注销
+	Delete(ctx context.Context, uid int64) (time.Time, error)
+	// RestoreTicket 给停用或者注销中的账号发一个恢复凭证，登录的时候调用
+	RestoreTicket(ctx context.Context, uid int64) (string, error)
+	// Restore 恢复账号，返回恢复的用户 id
+	Restore(ctx context.Context, ticket string) (int64, error)
+	// Export 导出个人资料、自己创作的帖子、点赞、收藏和阅读记录
+	Export(ctx context.Context, uid int64) (domain.PersonalData, error)
+	// PurgeDeleted 抹掉冷静期已经结束的账号的个人信息，返回处理了的账号，定时任务调用
+	PurgeDeleted(ctx context.Context) ([]int64, error)
+}
+
+type accountService struct {
+	repo       repository.AccountRepository
+	userRepo   repository.UserRepository
+	artRepo    article.ArticleRepository
+	historySvc HistoryService
+	intrSvc    intrv1.InteractiveServiceClient
+	// producer 帖子藏起来或者重新可见之后，通知搜索之类的下游
+	producer events.Producer
+	l        logger.Logger
+}
+
+func NewAccountService(repo repository.AccountRepository,
+	userRepo repository.UserRepository,
+	artRepo article.ArticleRepository,
+	historySvc HistoryService,
+	intrSvc intrv1.InteractiveServiceClient,
+	producer events.Producer,
+	l logger.Logger) AccountService {
+	return &accountService{
+		repo:       repo,
+		userRepo:   userRepo,
+		artRepo:    artRepo,
+		historySvc: historySvc,
+		intrSvc:    intrSvc,
+		producer:   producer,
+		l:          l,
+	}
+}
+
+func (s *accountService) Deactivate(ctx context.Context, uid int64) error {
+	return s.updateStatus(ctx, uid, []domain.UserStatus{domain.UserStatusActive},
+		domain.UserStatusDeactivated, time.Time{})
+}
+
+func (s *accountService) Delete(ctx context.Context, uid int64) (time.Time, error) {
+	deleteAt := time.Now().Add(AccountDeleteGracePeriod)
+	err := s.updateStatus(ctx, uid,
+		[]domain.UserStatus{domain.UserStatusActive, domain.UserStatusDeactivated},
+		domain.UserStatusDeleting, deleteAt)
+	return deleteAt, err
+}
+
+func (s *accountService) RestoreTicket(ctx context.Context, uid int64) (string, error) {
+	ticket := uuid.New().String()
+	err := s.repo.StoreRestoreTicket(ctx, ticket, uid)
+	return ticket, err
+}
+
+// Restore 冷静期结束之后定时任务还没来得及处理的，也还能恢复
+func (s *accountService) Restore(ctx context.Context, ticket string) (int64, error) {
+	uid, err := s.repo.ConsumeRestoreTicket(ctx, ticket)
+	if errors.Is(err, repository.ErrRestoreTicketNotFound) {
+		return 0, ErrInvalidRestoreTicket
+	}
+	if err != nil {
+		return 0, err
+	}
+	err = s.updateStatus(ctx, uid,
+		[]domain.UserStatus{domain.UserStatusDeactivated, domain.UserStatusDeleting},
+		domain.UserStatusActive, time.Time{})
+	return uid, err
+}
+
+// updateStatus 变成正常状态的时候线上库的帖子重新可见，变成别的状态的时候藏起来
+// 帖子和账号不在一个库里面，所以先改帖子，账号的状态对不上的话再把这次改了的帖子改回去
+func (s *accountService) updateStatus(ctx context.Context, uid int64,
+	from []domain.UserStatus, to domain.UserStatus, deleteAt time.Time) error {
+	artFrom, artTo := domain.ArticleStatusPublished, domain.ArticleStatusHidden
+	if to == domain.UserStatusActive {
+		artFrom, artTo = artTo, artFrom
+	} else {
+		err := s.cancelSchedules(ctx, uid)
+		if err != nil {
+			return err
+		}
+	}
+	ids, err := s.artRepo.SetAuthorPubStatus(ctx, uid, nil, artFrom, artTo)
+	if err != nil {
+		return err
+	}
+	err = s.repo.UpdateStatus(ctx, uid, from, to, deleteAt)
+	if err != nil {
+		if len(ids) > 0 {
+			_, er := s.artRepo.SetAuthorPubStatus(ctx, uid, ids, artTo, artFrom)
+			if er != nil {
+				s.l.Error("还原作者帖子的状态失败",
+					logger.Int64("uid", uid),
+					logger.Error(er))
+			}
+		}
+		return err
+	}
+	for _, id := range ids {
+		er := s.producer.ProducePublishEvent(ctx, events.PublishEvent{
+			Aid:       id,
+			Uid:       uid,
+			Withdrawn: artTo == domain.ArticleStatusHidden,
+		})
+		if er != nil {
+			s.l.Error("发送帖子发表事件失败",
+				logger.Int64("art_id", id),
+				logger.Error(er))
+		}
+	}
+	return nil
+}
+
+// cancelSchedules 停用、注销的账号，定时发表的帖子到点了也不能发出去
+// 取消之后变回草稿，恢复账号之后作者自己重新设置
+func (s *accountService) cancelSchedules(ctx context.Context, uid int64) error {
+	for {
+		// 取消了的就不在列表里面了，每次都从头开始查
+		arts, err := s.artRepo.ListScheduled(ctx, uid, 0, accountScanBatch)
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			err = s.artRepo.CancelSchedule(ctx, art.Id, uid)
+			// 刚好到点发表了的，会被 SetAuthorPubStatus 隐藏
+			if err != nil && !errors.Is(err, article.ErrScheduleNotFound) {
+				return err
+			}
+		}
+		if len(arts) < accountScanBatch {
+			return nil
+		}
+	}
+}
+
+func (s *accountService) Export(ctx context.Context, uid int64) (domain.PersonalData, error) {
+	u, err := s.userRepo.FindById(ctx, uid)
+	if err != nil {
+		return domain.PersonalData{}, err
+	}
+	res := domain.PersonalData{
+		User:       u,
+		ExportedAt: time.Now(),
+	}
+	err = ownArticles(ctx, s.artRepo, uid, func(art domain.Article) error {
+		res.Articles = append(res.Articles, art)
+		return nil
+	})
+	if err != nil {
+		return domain.PersonalData{}, err
+	}
+	records, err := s.intrSvc.ListUserRecords(ctx, &intrv1.ListUserRecordsRequest{Uid: uid})
+	if err != nil {
+		return domain.PersonalData{}, err
+	}
+	res.Likes = slice.Map(records.GetLikes(), s.toInteractionRecord)
+	res.Collects = slice.Map(records.GetCollects(), s.toInteractionRecord)
+	for offset := 0; ; offset += accountScanBatch {
+		rs, er := s.historySvc.ListRecords(ctx, uid, offset, accountScanBatch)
+		if er != nil {
+			return domain.PersonalData{}, er
+		}
+		res.History = append(res.History, rs...)
+		if len(rs) < accountScanBatch {
+			break
+		}
+	}
+	return res, nil
+}
+
+// PurgeDeleted 两次查询之间被恢复了的账号会返回 ErrUserStatusConflict，直接跳过
+func (s *accountService) PurgeDeleted(ctx context.Context) ([]int64, error) {
+	now := time.Now()
+	var res []int64
+	err := runBatches(ctx, func(ctx context.Context, limit int) ([]int64, error) {
+		return s.repo.FindDeleting(ctx, now, limit)
+	}, func(ctx context.Context, ids []int64) (int, error) {
+		// 被恢复了的账号下一批也不会再查出来，一样算处理掉了
+		done := 0
+		for _, id := range ids {
+			er := s.repo.Anonymise(ctx, id, now)
+			switch {
+			case er == nil:
+				res = append(res, id)
+				done++
+			case errors.Is(er, repository.ErrUserStatusConflict):
+				done++
+			default:
+				s.l.Error("抹掉注销账号的个人信息失败",
+					logger.Int64("uid", id),
+					logger.Error(er))
+			}
+		}
+		return done, nil
+	})
+	return res, err
+}
+
+func (s *accountService) toInteractionRecord(idx int, src *intrv1.UserRecord) domain.InteractionRecord {
+	return domain.InteractionRecord{
+		Biz:   src.GetBiz(),
+		BizId: src.GetBizId(),
+		Cid:   src.GetCid(),
+		Ctime: time.UnixMilli(src.GetCtime()),
+	}
+}
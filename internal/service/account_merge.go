@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/pkg/logger"
+)
+
+var (
+	ErrUserMerged = repository.ErrUserMerged
+	// ErrMergeTwoFactor 要被合并的账号开启了两步验证，要先关掉
+	ErrMergeTwoFactor = repository.ErrMergeTwoFactor
+	// ErrMergeTicketInvalid 合并账号的凭证过期了或者已经用过了
+	ErrMergeTicketInvalid = errors.New("合并账号的凭证无效")
+	// ErrMergeSelf 凭证是当前账号自己的
+	ErrMergeSelf = errors.New("不能合并自己")
+)
+
+// AccountMergeService 合并同一个人用不同方式注册的两个账号
+// 先登录要被合并的账号拿凭证，再登录主账号带着凭证合并，两个账号都要登录一次才能证明是自己的
+//
+//go:generate mockgen -source=account_merge.go -package=svcmocks -destination=mock/account_merge.mock.go AccountMergeService
+type AccountMergeService interface {
+	// Ticket 给当前登录的账号发一个一次性的凭证，十分钟内有效
+	Ticket(ctx context.Context, uid int64) (string, error)
+	// Merge 把凭证对应的账号合并到 primary 里面，返回被合并的账号
+	Merge(ctx context.Context, primary int64, ticket string) (int64, error)
+	// Resume 点赞、收藏没有转成功的合并记录再试一次，定时任务调用
+	Resume(ctx context.Context) error
+}
+
+type accountMergeService struct {
+	repo    repository.AccountMergeRepository
+	intrSvc intrv1.InteractiveServiceClient
+	l       logger.Logger
+}
+
+func NewAccountMergeService(repo repository.AccountMergeRepository,
+	intrSvc intrv1.InteractiveServiceClient,
+	l logger.Logger) AccountMergeService {
+	return &accountMergeService{
+		repo:    repo,
+		intrSvc: intrSvc,
+		l:       l,
+	}
+}
+
+func (s *accountMergeService) Ticket(ctx context.Context, uid int64) (string, error) {
+	ticket := uuid.New().String()
+	err := s.repo.StoreTicket(ctx, ticket, uid)
+	return ticket, err
+}
+
+// Merge 本地的数据在一个事务里面转移，点赞、收藏在交互服务里面，只能事后补偿
+// 交互服务失败了记录停在 moved，合并本身算成功，Resume 会接着做
+func (s *accountMergeService) Merge(ctx context.Context, primary int64, ticket string) (int64, error) {
+	secondary, err := s.repo.ConsumeTicket(ctx, ticket)
+	if errors.Is(err, repository.ErrMergeTicketNotFound) {
+		return 0, ErrMergeTicketInvalid
+	}
+	if err != nil {
+		return 0, err
+	}
+	if secondary == primary {
+		return 0, ErrMergeSelf
+	}
+	m := domain.AccountMerge{
+		Primary:   primary,
+		Secondary: secondary,
+		Status:    domain.AccountMergeStatusPending,
+	}
+	m.Id, err = s.repo.Create(ctx, m)
+	if err != nil {
+		return 0, err
+	}
+	err = s.repo.Move(ctx, m)
+	if err != nil {
+		er := s.repo.UpdateStatus(ctx, m.Id, domain.AccountMergeStatusFailed, err.Error())
+		if er != nil {
+			s.l.Error("记录合并账号失败的原因失败",
+				logger.Int64("id", m.Id),
+				logger.Error(er))
+		}
+		return 0, err
+	}
+	s.mergeIntr(ctx, m)
+	return secondary, nil
+}
+
+func (s *accountMergeService) Resume(ctx context.Context) error {
+	const batchSize = 100
+	// 刚刚转完本地数据的记录可能还在 Merge 里面，留一点时间
+	before := time.Now().Add(-time.Minute)
+	ms, err := s.repo.FindByStatus(ctx, domain.AccountMergeStatusMoved, before, batchSize)
+	if err != nil {
+		return err
+	}
+	for _, m := range ms {
+		s.mergeIntr(ctx, m)
+	}
+	return nil
+}
+
+// mergeIntr 交互服务那边是幂等的，失败了就把原因记下来，状态不变
+func (s *accountMergeService) mergeIntr(ctx context.Context, m domain.AccountMerge) {
+	status, reason := domain.AccountMergeStatusDone, ""
+	_, err := s.intrSvc.MergeUser(ctx, &intrv1.MergeUserRequest{
+		SrcUid: m.Secondary,
+		DstUid: m.Primary,
+	})
+	if err != nil {
+		s.l.Error("合并账号的点赞、收藏失败",
+			logger.Int64("id", m.Id),
+			logger.Int64("primary", m.Primary),
+			logger.Int64("secondary", m.Secondary),
+			logger.Error(err))
+		status, reason = domain.AccountMergeStatusMoved, err.Error()
+	}
+	err = s.repo.UpdateStatus(ctx, m.Id, status, reason)
+	if err != nil {
+		s.l.Error("更新合并账号的状态失败",
+			logger.Int64("id", m.Id),
+			logger.Error(err))
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	intrv1mocks "webookpro/api/proto/gen/intr/v1/mocks"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestAccountMergeService_Merge(t *testing.T) {
+	pending := domain.AccountMerge{
+		Primary:   1,
+		Secondary: 2,
+		Status:    domain.AccountMergeStatusPending,
+	}
+	moved := pending
+	moved.Id = 10
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient)
+
+		wantSecondary int64
+		wantErr       error
+	}{
+		{
+			name: "合并成功",
+			mock: func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockAccountMergeRepository(ctrl)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().ConsumeTicket(gomock.Any(), "ticket").Return(int64(2), nil)
+				repo.EXPECT().Create(gomock.Any(), pending).Return(int64(10), nil)
+				repo.EXPECT().Move(gomock.Any(), moved).Return(nil)
+				intrSvc.EXPECT().MergeUser(gomock.Any(), &intrv1.MergeUserRequest{
+					SrcUid: 2,
+					DstUid: 1,
+				}).Return(&intrv1.MergeUserResponse{}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(10), domain.AccountMergeStatusDone, "").Return(nil)
+				return repo, intrSvc
+			},
+			wantSecondary: 2,
+		},
+		{
+			name: "点赞收藏没有转成功，等定时任务补偿",
+			mock: func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockAccountMergeRepository(ctrl)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().ConsumeTicket(gomock.Any(), "ticket").Return(int64(2), nil)
+				repo.EXPECT().Create(gomock.Any(), pending).Return(int64(10), nil)
+				repo.EXPECT().Move(gomock.Any(), moved).Return(nil)
+				intrSvc.EXPECT().MergeUser(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("超时"))
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(10), domain.AccountMergeStatusMoved, "超时").Return(nil)
+				return repo, intrSvc
+			},
+			wantSecondary: 2,
+		},
+		{
+			name: "本地事务失败",
+			mock: func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockAccountMergeRepository(ctrl)
+				repo.EXPECT().ConsumeTicket(gomock.Any(), "ticket").Return(int64(2), nil)
+				repo.EXPECT().Create(gomock.Any(), pending).Return(int64(10), nil)
+				repo.EXPECT().Move(gomock.Any(), moved).Return(ErrUserMerged)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(10), domain.AccountMergeStatusFailed,
+					ErrUserMerged.Error()).Return(nil)
+				return repo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			wantErr: ErrUserMerged,
+		},
+		{
+			name: "凭证无效",
+			mock: func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockAccountMergeRepository(ctrl)
+				repo.EXPECT().ConsumeTicket(gomock.Any(), "ticket").
+					Return(int64(0), repository.ErrMergeTicketNotFound)
+				return repo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			wantErr: ErrMergeTicketInvalid,
+		},
+		{
+			name: "合并自己",
+			mock: func(ctrl *gomock.Controller) (repository.AccountMergeRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockAccountMergeRepository(ctrl)
+				repo.EXPECT().ConsumeTicket(gomock.Any(), "ticket").Return(int64(1), nil)
+				return repo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			wantErr: ErrMergeSelf,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intrSvc := tc.mock(ctrl)
+			svc := NewAccountMergeService(repo, intrSvc, &logger.NopLogger{})
+			secondary, err := svc.Merge(context.Background(), 1, "ticket")
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantSecondary, secondary)
+		})
+	}
+}
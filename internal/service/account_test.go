@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"webookpro/internal/domain"
+	events "webookpro/internal/events/article"
+	evtmocks "webookpro/internal/events/article/mocks"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestAccountService_Deactivate(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer)
+
+		wantErr error
+	}{
+		{
+			name: "停用之后帖子从搜索里面去掉，定时发表的取消",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				artRepo.EXPECT().ListScheduled(gomock.Any(), int64(1), 0, 100).
+					Return([]domain.Article{{Id: 4}, {Id: 5}}, nil)
+				artRepo.EXPECT().CancelSchedule(gomock.Any(), int64(4), int64(1)).Return(nil)
+				// 刚好到点发表了
+				artRepo.EXPECT().CancelSchedule(gomock.Any(), int64(5), int64(1)).Return(article.ErrScheduleNotFound)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), gomock.Nil(),
+					domain.ArticleStatusPublished, domain.ArticleStatusHidden).Return([]int64{2, 3}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), []domain.UserStatus{domain.UserStatusActive},
+					domain.UserStatusDeactivated, gomock.Any()).Return(nil)
+				producer.EXPECT().ProducePublishEvent(gomock.Any(),
+					events.PublishEvent{Aid: 2, Uid: 1, Withdrawn: true}).Return(nil)
+				producer.EXPECT().ProducePublishEvent(gomock.Any(),
+					events.PublishEvent{Aid: 3, Uid: 1, Withdrawn: true}).Return(nil)
+				return repo, artRepo, producer
+			},
+		},
+		{
+			name: "账号状态不对，只把这次藏起来的帖子改回去",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListScheduled(gomock.Any(), int64(1), 0, 100).Return(nil, nil)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), gomock.Nil(),
+					domain.ArticleStatusPublished, domain.ArticleStatusHidden).Return([]int64{2}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), gomock.Any(),
+					domain.UserStatusDeactivated, gomock.Any()).Return(repository.ErrUserStatusConflict)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), []int64{2},
+					domain.ArticleStatusHidden, domain.ArticleStatusPublished).Return([]int64{2}, nil)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrUserStatusConflict,
+		},
+		{
+			name: "已经停用了，没有帖子要藏",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListScheduled(gomock.Any(), int64(1), 0, 100).Return(nil, nil)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), gomock.Nil(),
+					domain.ArticleStatusPublished, domain.ArticleStatusHidden).Return(nil, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), gomock.Any(),
+					domain.UserStatusDeactivated, gomock.Any()).Return(repository.ErrUserStatusConflict)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrUserStatusConflict,
+		},
+		{
+			name: "取消定时发表失败",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListScheduled(gomock.Any(), int64(1), 0, 100).
+					Return([]domain.Article{{Id: 4}}, nil)
+				artRepo.EXPECT().CancelSchedule(gomock.Any(), int64(4), int64(1)).Return(errors.New("数据库错误"))
+				return repomocks.NewMockAccountRepository(ctrl), artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: errors.New("数据库错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo, producer := tc.mock(ctrl)
+			svc := NewAccountService(repo, nil, artRepo, nil, nil, producer, &logger.NopLogger{})
+			err := svc.Deactivate(context.Background(), 1)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestAccountService_Restore(t *testing.T) {
+	inactive := []domain.UserStatus{domain.UserStatusDeactivated, domain.UserStatusDeleting}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer)
+
+		wantUid int64
+		wantErr error
+	}{
+		{
+			name: "恢复成功，帖子重新进搜索",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ConsumeRestoreTicket(gomock.Any(), "ticket").Return(int64(1), nil)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), gomock.Nil(),
+					domain.ArticleStatusHidden, domain.ArticleStatusPublished).Return([]int64{2}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), inactive,
+					domain.UserStatusActive, gomock.Any()).Return(nil)
+				producer.EXPECT().ProducePublishEvent(gomock.Any(),
+					events.PublishEvent{Aid: 2, Uid: 1}).Return(nil)
+				return repo, artRepo, producer
+			},
+			wantUid: 1,
+		},
+		{
+			name: "凭证无效",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				repo.EXPECT().ConsumeRestoreTicket(gomock.Any(), "ticket").
+					Return(int64(0), repository.ErrRestoreTicketNotFound)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl), evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrInvalidRestoreTicket,
+		},
+		{
+			name: "已经被抹掉了",
+			mock: func(ctrl *gomock.Controller) (repository.AccountRepository, article.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().ConsumeRestoreTicket(gomock.Any(), "ticket").Return(int64(1), nil)
+				artRepo.EXPECT().SetAuthorPubStatus(gomock.Any(), int64(1), gomock.Nil(),
+					domain.ArticleStatusHidden, domain.ArticleStatusPublished).Return(nil, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), inactive,
+					domain.UserStatusActive, gomock.Any()).Return(repository.ErrUserStatusConflict)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantUid: 1,
+			wantErr: ErrUserStatusConflict,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo, producer := tc.mock(ctrl)
+			svc := NewAccountService(repo, nil, artRepo, nil, nil, producer, &logger.NopLogger{})
+			uid, err := svc.Restore(context.Background(), "ticket")
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantUid, uid)
+		})
+	}
+}
+
+func TestAccountService_PurgeDeleted(t *testing.T) {
+	fullBatch := func(start int64) []int64 {
+		res := make([]int64, 0, cleanupBatchSize)
+		for i := int64(0); i < cleanupBatchSize; i++ {
+			res = append(res, start+i)
+		}
+		return res
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.AccountRepository
+
+		wantUids []int64
+		wantErr  error
+	}{
+		{
+			name: "跳过恢复了的和失败的",
+			mock: func(ctrl *gomock.Controller) repository.AccountRepository {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				repo.EXPECT().FindDeleting(gomock.Any(), gomock.Any(), cleanupBatchSize).
+					Return([]int64{1, 2, 3}, nil)
+				repo.EXPECT().Anonymise(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				repo.EXPECT().Anonymise(gomock.Any(), int64(2), gomock.Any()).
+					Return(repository.ErrUserStatusConflict)
+				repo.EXPECT().Anonymise(gomock.Any(), int64(3), gomock.Any()).
+					Return(errors.New("数据库错误"))
+				return repo
+			},
+			wantUids: []int64{1},
+		},
+		{
+			name: "满一批的话接着查下一批",
+			mock: func(ctrl *gomock.Controller) repository.AccountRepository {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				gomock.InOrder(
+					repo.EXPECT().FindDeleting(gomock.Any(), gomock.Any(), cleanupBatchSize).
+						Return(fullBatch(1), nil),
+					repo.EXPECT().FindDeleting(gomock.Any(), gomock.Any(), cleanupBatchSize).
+						Return([]int64{101}, nil),
+				)
+				repo.EXPECT().Anonymise(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(cleanupBatchSize + 1)
+				return repo
+			},
+			wantUids: append(fullBatch(1), 101),
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) repository.AccountRepository {
+				repo := repomocks.NewMockAccountRepository(ctrl)
+				repo.EXPECT().FindDeleting(gomock.Any(), gomock.Any(), cleanupBatchSize).
+					Return(nil, errors.New("数据库错误"))
+				return repo
+			},
+			wantErr: errors.New("数据库错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewAccountService(tc.mock(ctrl), nil, nil, nil, nil, nil, &logger.NopLogger{})
+			uids, err := svc.PurgeDeleted(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantUids, uids)
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/ossx"
+)
+
+const (
+	// MaxArchiveSize 导入的 zip 最大 50M
+	MaxArchiveSize = 50 << 20
+	// maxArchiveArticles 一次最多导入这么多篇
+	maxArchiveArticles = 1000
+	// maxArchiveFileSize 单篇帖子解压之后的大小，防止 zip 炸弹
+	maxArchiveFileSize = 5 << 20
+	archiveManifest    = "manifest.json"
+	archiveVersion     = 1
+	// exportStaleAfter 打包中的任务超过这么久没有完成，就认为实例挂了，重新打包
+	exportStaleAfter = time.Minute * 10
+	exportListLimit  = 10
+	exportBatch      = 10
+	archiveScanBatch = 100
+)
+
+var (
+	ErrExportNotFound = article.ErrExportNotFound
+	// ErrExportInProgress 上一次导出还没有打包完
+	ErrExportInProgress = errors.New("上一次导出还没有完成")
+	// ErrExportNotReady 还没有打包完，或者打包失败了
+	ErrExportNotReady = errors.New("导出还没有完成")
+	// ErrInvalidArchive 不是导出的 zip，或者 manifest.json 不对
+	ErrInvalidArchive = errors.New("导入的文件格式不对")
+)
+
+//go:generate mockgen -source=archive.go -package=svcmocks -destination=mock/archive.mock.go ArchiveService
+type ArchiveService interface {
+	// Export 发起导出，由定时任务打包，上一次还没有完成的话返回 ErrExportInProgress
+	Export(ctx context.Context, uid int64) (domain.ArticleExport, error)
+	// ListExports 最近几次的导出
+	ListExports(ctx context.Context, uid int64) ([]domain.ArticleExport, error)
+	// Download 打包好的 zip
+	Download(ctx context.Context, uid, id int64) ([]byte, error)
+	// RunExports 打包等着的导出任务，由定时任务调用
+	RunExports(ctx context.Context) error
+	// Import 把导出的 zip 导入成草稿，内容和已有的帖子一样的会跳过
+	// 单篇失败不影响其它的，结果按照 manifest 里面的顺序返回
+	Import(ctx context.Context, uid int64, data []byte) ([]domain.ArticleImportResult, error)
+}
+
+type archiveService struct {
+	repo      article.ExportRepository
+	artRepo   article.ArticleRepository
+	attachSvc AttachmentService
+	oss       ossx.Store
+	l         logger.Logger
+}
+
+func NewArchiveService(repo article.ExportRepository, artRepo article.ArticleRepository,
+	attachSvc AttachmentService, oss ossx.Store, l logger.Logger) ArchiveService {
+	return &archiveService{
+		repo:      repo,
+		artRepo:   artRepo,
+		attachSvc: attachSvc,
+		oss:       oss,
+		l:         l,
+	}
+}
+
+func (s *archiveService) Export(ctx context.Context, uid int64) (domain.ArticleExport, error) {
+	latest, err := s.repo.ListByUid(ctx, uid, 1)
+	if err != nil {
+		return domain.ArticleExport{}, err
+	}
+	if len(latest) > 0 && latest[0].Status.InProgress() {
+		return domain.ArticleExport{}, ErrExportInProgress
+	}
+	e := domain.ArticleExport{
+		Uid:    uid,
+		Status: domain.ArticleExportStatusPending,
+	}
+	e.Id, err = s.repo.Create(ctx, e)
+	return e, err
+}
+
+func (s *archiveService) ListExports(ctx context.Context, uid int64) ([]domain.ArticleExport, error) {
+	return s.repo.ListByUid(ctx, uid, exportListLimit)
+}
+
+func (s *archiveService) Download(ctx context.Context, uid, id int64) ([]byte, error) {
+	e, err := s.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Uid != uid {
+		return nil, ErrExportNotFound
+	}
+	if e.Status != domain.ArticleExportStatusDone {
+		return nil, ErrExportNotReady
+	}
+	return s.oss.Get(ctx, e.Key)
+}
+
+func (s *archiveService) RunExports(ctx context.Context) error {
+	staleBefore := time.Now().Add(-exportStaleAfter)
+	exports, err := s.repo.ListRunnable(ctx, staleBefore, exportBatch)
+	if err != nil {
+		return err
+	}
+	for _, e := range exports {
+		err = s.repo.Start(ctx, e.Id, staleBefore)
+		if errors.Is(err, article.ErrExportTaken) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		e, err = s.export(ctx, e)
+		if err != nil {
+			s.l.Error("打包导出的帖子失败",
+				logger.Int64("id", e.Id),
+				logger.Int64("uid", e.Uid),
+				logger.Error(err))
+			e.Status = domain.ArticleExportStatusFailed
+		}
+		err = s.repo.Finish(ctx, e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// export 打包成 zip 放到对象存储里面
+// 制作库是按照更新时间翻页的，打包过程中改了的帖子可能会重复或者漏掉，重新导出一次就可以
+func (s *archiveService) export(ctx context.Context, e domain.ArticleExport) (domain.ArticleExport, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	manifest := archiveManifestV1{
+		Version:    archiveVersion,
+		Uid:        e.Uid,
+		ExportedAt: time.Now(),
+		Articles:   []archiveItem{},
+	}
+	err := ownArticles(ctx, s.artRepo, e.Uid, func(art domain.Article) error {
+		item := archiveItem{
+			Id:       art.Id,
+			Title:    art.Title,
+			File:     fmt.Sprintf("articles/%d.md", art.Id),
+			Status:   art.Status.String(),
+			Category: art.Category,
+			Tags:     art.Tags,
+			Hash:     contentHash(art.Content),
+			Ctime:    art.Ctime,
+			Utime:    art.Utime,
+		}
+		er := writeZipFile(zw, item.File, art.Content)
+		if er != nil {
+			return er
+		}
+		// 发表之后又改过的，线上的版本也一起导出来
+		pub, er := s.artRepo.GetPublishedById(ctx, art.Id)
+		switch {
+		case er == nil:
+			item.Published = pub.Status == domain.ArticleStatusPublished
+			if item.Published && pub.Content != art.Content {
+				item.PublishedFile = fmt.Sprintf("articles/%d.published.md", art.Id)
+				er = writeZipFile(zw, item.PublishedFile, pub.Content)
+				if er != nil {
+					return er
+				}
+			}
+		case !errors.Is(er, article.ErrRecordNotFound):
+			return er
+		}
+		manifest.Articles = append(manifest.Articles, item)
+		return nil
+	})
+	if err != nil {
+		return e, err
+	}
+	bs, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return e, err
+	}
+	err = writeZipFile(zw, archiveManifest, string(bs))
+	if err != nil {
+		return e, err
+	}
+	err = zw.Close()
+	if err != nil {
+		return e, err
+	}
+	key := fmt.Sprintf("exports/%d/%d.zip", e.Uid, e.Id)
+	err = s.oss.Put(ctx, key, buf.Bytes(), "application/zip")
+	if err != nil {
+		return e, err
+	}
+	e.Status = domain.ArticleExportStatusDone
+	e.Key = key
+	e.Cnt = len(manifest.Articles)
+	e.Size = int64(buf.Len())
+	return e, nil
+}
+
+func (s *archiveService) Import(ctx context.Context, uid int64, data []byte) ([]domain.ArticleImportResult, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	mf, ok := files[archiveManifest]
+	if !ok {
+		return nil, ErrInvalidArchive
+	}
+	bs, err := readZipFile(mf)
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+	var manifest archiveManifestV1
+	err = json.Unmarshal([]byte(bs), &manifest)
+	if err != nil || manifest.Version != archiveVersion ||
+		len(manifest.Articles) > maxArchiveArticles {
+		return nil, ErrInvalidArchive
+	}
+
+	// 已有帖子的内容哈希，导入同一个包里面重复的也算
+	existing := make(map[string]int64)
+	err = ownArticles(ctx, s.artRepo, uid, func(art domain.Article) error {
+		existing[contentHash(art.Content)] = art.Id
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.ArticleImportResult, 0, len(manifest.Articles))
+	for _, item := range manifest.Articles {
+		r := domain.ArticleImportResult{
+			File:  item.File,
+			Title: item.Title,
+		}
+		art, hash, reason := s.importItem(uid, item, files)
+		if reason != "" {
+			r.Status = domain.ArticleImportStatusFailed
+			r.Reason = reason
+			res = append(res, r)
+			continue
+		}
+		if id, ok := existing[hash]; ok {
+			r.Status = domain.ArticleImportStatusDuplicate
+			r.ArticleId = id
+			res = append(res, r)
+			continue
+		}
+		id, er := s.artRepo.Create(ctx, art)
+		if er != nil {
+			s.l.Error("导入帖子失败",
+				logger.Int64("uid", uid),
+				logger.String("file", item.File),
+				logger.Error(er))
+			r.Status = domain.ArticleImportStatusFailed
+			r.Reason = "系统错误"
+			res = append(res, r)
+			continue
+		}
+		existing[hash] = id
+		r.Status = domain.ArticleImportStatusCreated
+		r.ArticleId = id
+		res = append(res, r)
+		// 和保存草稿一样，记下来引用了哪些图片
+		er = s.attachSvc.BindArticle(ctx, uid, id, art.Content)
+		if er != nil {
+			s.l.Error("导入的帖子绑定附件失败",
+				logger.Int64("art_id", id),
+				logger.Error(er))
+		}
+	}
+	return res, nil
+}
+
+// importItem 校验 manifest 里面的一项，不合法的时候返回失败原因
+func (s *archiveService) importItem(uid int64, item archiveItem,
+	files map[string]*zip.File) (domain.Article, string, string) {
+	f, ok := files[item.File]
+	if item.File == "" || !ok {
+		return domain.Article{}, "", "文件不存在"
+	}
+	content, err := readZipFile(f)
+	if err != nil {
+		return domain.Article{}, "", "文件读取失败"
+	}
+	hash := contentHash(content)
+	if item.Hash != "" && item.Hash != hash {
+		return domain.Article{}, "", "内容校验失败"
+	}
+	art := domain.Article{
+		Title:    strings.TrimSpace(item.Title),
+		Content:  content,
+		Author:   domain.Author{Id: uid},
+		Status:   domain.ArticleStatusUnpublished,
+		Category: strings.TrimSpace(item.Category),
+		Tags:     domain.NormalizeTags(item.Tags),
+	}
+	if art.Title == "" {
+		return domain.Article{}, "", "标题不能为空"
+	}
+	if !art.ValidTaxonomy() {
+		return domain.Article{}, "", "分类或标签不合法"
+	}
+	return art, hash, ""
+}
+
+// ownArticles 遍历自己创作的帖子，不包括参与协作的和回收站里面的
+func ownArticles(ctx context.Context, artRepo article.ArticleRepository, uid int64,
+	fn func(art domain.Article) error) error {
+	var cursor domain.ArticleCursor
+	for {
+		arts, err := artRepo.ListByCursor(ctx, uid, cursor, archiveScanBatch)
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			if art.Author.Id != uid {
+				continue
+			}
+			err = fn(art)
+			if err != nil {
+				return err
+			}
+		}
+		if len(arts) < archiveScanBatch {
+			return nil
+		}
+		cursor = arts[len(arts)-1].Cursor()
+	}
+}
+
+// archiveManifestV1 导出的 zip 里面的 manifest.json，导入的时候也是靠它找到每一篇帖子
+type archiveManifestV1 struct {
+	Version    int           `json:"version"`
+	Uid        int64         `json:"uid"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Articles   []archiveItem `json:"articles"`
+}
+
+type archiveItem struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+	// File 制作库里面的最新内容，Markdown
+	File     string   `json:"file"`
+	Status   string   `json:"status"`
+	Category string   `json:"category,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	// Hash File 内容的 SHA-256，导入的时候用来校验和去重
+	Hash string `json:"sha256"`
+	// Published 读者现在能不能看到
+	Published bool `json:"published"`
+	// PublishedFile 线上的版本和最新的内容不一样的时候才有
+	PublishedFile string    `json:"published_file,omitempty"`
+	Ctime         time.Time `json:"ctime"`
+	Utime         time.Time `json:"utime"`
+}
+
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeZipFile(zw *zip.Writer, name string, content string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, content)
+	return err
+}
+
+func readZipFile(f *zip.File) (string, error) {
+	if f.UncompressedSize64 > maxArchiveFileSize {
+		return "", ErrInvalidArchive
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	// 头里面的大小可以造假，读的时候还要再限制一次
+	bs, err := io.ReadAll(io.LimitReader(rc, maxArchiveFileSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(bs) > maxArchiveFileSize {
+		return "", ErrInvalidArchive
+	}
+	return string(bs), nil
+}
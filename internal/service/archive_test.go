@@ -0,0 +1,239 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	svcmocks "webookpro/internal/service/mock"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/ossx/memory"
+)
+
+func TestArchiveService_Export(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) article.ExportRepository
+		wantErr error
+	}{
+		{
+			name: "发起导出",
+			mock: func(ctrl *gomock.Controller) article.ExportRepository {
+				repo := artrepomocks.NewMockExportRepository(ctrl)
+				repo.EXPECT().ListByUid(gomock.Any(), int64(123), 1).
+					Return([]domain.ArticleExport{{Id: 1, Status: domain.ArticleExportStatusDone}}, nil)
+				repo.EXPECT().Create(gomock.Any(), domain.ArticleExport{
+					Uid:    123,
+					Status: domain.ArticleExportStatusPending,
+				}).Return(int64(2), nil)
+				return repo
+			},
+		},
+		{
+			name: "上一次还没有完成",
+			mock: func(ctrl *gomock.Controller) article.ExportRepository {
+				repo := artrepomocks.NewMockExportRepository(ctrl)
+				repo.EXPECT().ListByUid(gomock.Any(), int64(123), 1).
+					Return([]domain.ArticleExport{{Id: 1, Status: domain.ArticleExportStatusRunning}}, nil)
+				return repo
+			},
+			wantErr: ErrExportInProgress,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewArchiveService(tc.mock(ctrl), nil, nil, nil, &logger.NopLogger{})
+			_, err := svc.Export(context.Background(), 123)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestArchiveService_RunExports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockExportRepository(ctrl)
+	repo.EXPECT().ListRunnable(gomock.Any(), gomock.Any(), exportBatch).
+		Return([]domain.ArticleExport{
+			{Id: 1, Uid: 123, Status: domain.ArticleExportStatusPending},
+			{Id: 2, Uid: 234, Status: domain.ArticleExportStatusPending},
+		}, nil)
+	repo.EXPECT().Start(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+	// 被别的实例拿走了
+	repo.EXPECT().Start(gomock.Any(), int64(2), gomock.Any()).Return(article.ErrExportTaken)
+	artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+	artRepo.EXPECT().ListByCursor(gomock.Any(), int64(123), domain.ArticleCursor{}, archiveScanBatch).
+		Return([]domain.Article{
+			{Id: 1, Title: "草稿", Content: "草稿内容", Author: domain.Author{Id: 123},
+				Status: domain.ArticleStatusUnpublished, Tags: []string{"Go"}},
+			{Id: 2, Title: "参与协作的", Author: domain.Author{Id: 234}},
+			{Id: 3, Title: "发表之后又改了", Content: "新的内容", Author: domain.Author{Id: 123},
+				Status: domain.ArticleStatusUnpublished},
+		}, nil)
+	artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+		Return(domain.Article{}, article.ErrRecordNotFound)
+	artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(3)).
+		Return(domain.Article{Id: 3, Content: "线上的内容", Status: domain.ArticleStatusPublished}, nil)
+	var finished domain.ArticleExport
+	repo.EXPECT().Finish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, e domain.ArticleExport) error {
+			finished = e
+			return nil
+		})
+	store := memory.NewStore()
+	svc := NewArchiveService(repo, artRepo, nil, store, &logger.NopLogger{})
+	err := svc.RunExports(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.ArticleExportStatusDone, finished.Status)
+	assert.Equal(t, "exports/123/1.zip", finished.Key)
+	assert.Equal(t, 2, finished.Cnt)
+	data, err := store.Get(context.Background(), finished.Key)
+	require.NoError(t, err)
+	assert.Equal(t, finished.Size, int64(len(data)))
+	files := readTestZip(t, data)
+	assert.Equal(t, "草稿内容", files["articles/1.md"])
+	assert.Equal(t, "新的内容", files["articles/3.md"])
+	assert.Equal(t, "线上的内容", files["articles/3.published.md"])
+	var manifest archiveManifestV1
+	require.NoError(t, json.Unmarshal([]byte(files[archiveManifest]), &manifest))
+	require.Len(t, manifest.Articles, 2)
+	assert.Equal(t, archiveItem{
+		Id:     1,
+		Title:  "草稿",
+		File:   "articles/1.md",
+		Status: "unpublished",
+		Tags:   []string{"Go"},
+		Hash:   contentHash("草稿内容"),
+	}, clearItemTime(manifest.Articles[0]))
+	assert.Equal(t, archiveItem{
+		Id:            3,
+		Title:         "发表之后又改了",
+		File:          "articles/3.md",
+		Status:        "unpublished",
+		Hash:          contentHash("新的内容"),
+		Published:     true,
+		PublishedFile: "articles/3.published.md",
+	}, clearItemTime(manifest.Articles[1]))
+}
+
+func TestArchiveService_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+	artRepo.EXPECT().ListByCursor(gomock.Any(), int64(123), domain.ArticleCursor{}, archiveScanBatch).
+		Return([]domain.Article{
+			{Id: 10, Content: "已经有了", Author: domain.Author{Id: 123}},
+		}, nil)
+	artRepo.EXPECT().Create(gomock.Any(), domain.Article{
+		Title:    "新的",
+		Content:  "新的内容",
+		Author:   domain.Author{Id: 123},
+		Status:   domain.ArticleStatusUnpublished,
+		Category: "后端",
+		Tags:     []string{"Go"},
+	}).Return(int64(11), nil)
+	attachSvc := svcmocks.NewMockAttachmentService(ctrl)
+	attachSvc.EXPECT().BindArticle(gomock.Any(), int64(123), int64(11), "新的内容").Return(nil)
+
+	data := writeTestZip(t, map[string]string{
+		"articles/1.md": "新的内容",
+		"articles/2.md": "已经有了",
+		"articles/3.md": "被改过了",
+		"articles/5.md": "新的内容",
+		"articles/6.md": "标签太多",
+	}, []archiveItem{
+		{Title: " 新的 ", File: "articles/1.md", Category: "后端", Tags: []string{"Go", " Go "}, Hash: contentHash("新的内容")},
+		{Title: "重复的", File: "articles/2.md"},
+		{Title: "校验失败", File: "articles/3.md", Hash: contentHash("原来的内容")},
+		{Title: "文件不存在", File: "articles/4.md"},
+		{Title: "包里面重复的", File: "articles/5.md"},
+		{Title: "标签太多", File: "articles/6.md", Tags: []string{"1", "2", "3", "4", "5", "6"}},
+	})
+	svc := NewArchiveService(nil, artRepo, attachSvc, nil, &logger.NopLogger{})
+	res, err := svc.Import(context.Background(), 123, data)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ArticleImportResult{
+		{File: "articles/1.md", Title: " 新的 ", Status: domain.ArticleImportStatusCreated, ArticleId: 11},
+		{File: "articles/2.md", Title: "重复的", Status: domain.ArticleImportStatusDuplicate, ArticleId: 10},
+		{File: "articles/3.md", Title: "校验失败", Status: domain.ArticleImportStatusFailed, Reason: "内容校验失败"},
+		{File: "articles/4.md", Title: "文件不存在", Status: domain.ArticleImportStatusFailed, Reason: "文件不存在"},
+		{File: "articles/5.md", Title: "包里面重复的", Status: domain.ArticleImportStatusDuplicate, ArticleId: 11},
+		{File: "articles/6.md", Title: "标签太多", Status: domain.ArticleImportStatusFailed, Reason: "分类或标签不合法"},
+	}, res)
+}
+
+func TestArchiveService_ImportInvalid(t *testing.T) {
+	svc := NewArchiveService(nil, nil, nil, nil, &logger.NopLogger{})
+	_, err := svc.Import(context.Background(), 123, []byte("不是 zip"))
+	assert.Equal(t, ErrInvalidArchive, err)
+
+	// 没有 manifest.json
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	require.NoError(t, writeZipFile(zw, "articles/1.md", "内容"))
+	require.NoError(t, zw.Close())
+	_, err = svc.Import(context.Background(), 123, buf.Bytes())
+	assert.Equal(t, ErrInvalidArchive, err)
+}
+
+func TestArchiveService_Download(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockExportRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), int64(1)).
+		Return(domain.ArticleExport{Id: 1, Uid: 234, Status: domain.ArticleExportStatusDone}, nil)
+	repo.EXPECT().GetById(gomock.Any(), int64(2)).
+		Return(domain.ArticleExport{Id: 2, Uid: 123, Status: domain.ArticleExportStatusFailed}, nil)
+	svc := NewArchiveService(repo, nil, nil, memory.NewStore(), &logger.NopLogger{})
+	// 别人的导出
+	_, err := svc.Download(context.Background(), 123, 1)
+	assert.Equal(t, ErrExportNotFound, err)
+	_, err = svc.Download(context.Background(), 123, 2)
+	assert.Equal(t, ErrExportNotReady, err)
+}
+
+func writeTestZip(t *testing.T, files map[string]string, items []archiveItem) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		require.NoError(t, writeZipFile(zw, name, content))
+	}
+	bs, err := json.Marshal(archiveManifestV1{Version: archiveVersion, Articles: items})
+	require.NoError(t, err)
+	require.NoError(t, writeZipFile(zw, archiveManifest, string(bs)))
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func readTestZip(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	res := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		bs, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		res[f.Name] = string(bs)
+	}
+	return res
+}
+
+// clearItemTime 零值的时间经过 JSON 之后时区对不上，比较之前去掉
+func clearItemTime(item archiveItem) archiveItem {
+	item.Ctime, item.Utime = time.Time{}, time.Time{}
+	return item
+}
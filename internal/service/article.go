@@ -2,15 +2,43 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"time"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
 	"webookpro/internal/domain"
 	events "webookpro/internal/events/article"
 	"webookpro/internal/repository/article"
+	"webookpro/internal/service/moderation"
+	"webookpro/pkg/diffx"
 	"webookpro/pkg/logger"
+	"webookpro/pkg/markdownx"
 )
 
+// ErrRevisionNotMatch 历史版本不是这个创作者的，或者两个历史版本不是同一篇帖子的
+var ErrRevisionNotMatch = errors.New("历史版本不匹配")
+
+// ErrInvalidPublishTime 定时发表的时间必须在将来
+var ErrInvalidPublishTime = errors.New("定时发表的时间不合法")
+
+var ErrScheduleNotFound = article.ErrScheduleNotFound
+
+// ErrArticleNotFound 帖子不存在，或者已经超过了回收站的保留期限
+var ErrArticleNotFound = article.ErrArticleNotFound
+
+// ErrVersionConflict 保存的时候带的版本号不是最新的，帖子已经被别的页面或者协作者改过了
+var ErrVersionConflict = article.ErrVersionConflict
+
+// ErrArticleUnderReview 没有通过自动审核，已经保存下来了，等管理员人工审核通过之后才会发表
+var ErrArticleUnderReview = errors.New("帖子需要人工审核")
+
+// ErrArticleBlocked 包含违规内容，保存成了草稿，原因在审核记录里面
+var ErrArticleBlocked = errors.New("帖子包含违规内容")
+
+// DeletedArticleRetention 帖子在回收站里面保留的时间，过了就会被彻底删除
+const DeletedArticleRetention = time.Hour * 24 * 30
+
 //go:generate mockgen -source=article.go -package=svcmocks -destination=mock/article.mock.go ArticleService
 type ArticleService interface {
 	Store(ctx context.Context, article domain.Article) (int64, error)
@@ -18,10 +46,41 @@ type ArticleService interface {
 	PublishV1(ctx context.Context, article domain.Article) (int64, error)
 	Withdraw(ctx context.Context, art domain.Article) error
 	List(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// ListByCursor 按照更新时间倒序游标翻页，cursor 是零值就是第一页
+	ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
 	// ListPub 只会取 start 七天内的数据
 	ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error)
+	// ListPubByCursor 线上库游标翻页，翻页过程中有帖子更新也不会重复或者漏掉
+	ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 	GetPublishedById(ctx *gin.Context, id, uid int64) (domain.Article, error)
+	// ListRevisions 创作者查看帖子的历史版本
+	ListRevisions(ctx context.Context, artId, uid int64, offset, limit int) ([]domain.ArticleRevision, error)
+	// DiffRevisions 比较同一篇帖子的两个历史版本
+	DiffRevisions(ctx context.Context, uid, from, to int64) (domain.ArticleRevisionDiff, error)
+	// RestoreRevision 把某个历史版本恢复成当前的草稿
+	RestoreRevision(ctx context.Context, uid, revId int64) (int64, error)
+	// Schedule 定时发表，到了 at 的时候才会真的发表
+	Schedule(ctx context.Context, article domain.Article, at time.Time) (int64, error)
+	ListScheduled(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error)
+	Reschedule(ctx context.Context, uid, artId int64, at time.Time) error
+	CancelSchedule(ctx context.Context, uid, artId int64) error
+	// PublishDue 发表已经到点的定时帖子，由定时任务调用
+	PublishDue(ctx context.Context) error
+	// ListPubByTag 某个标签下已发表的帖子
+	ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]domain.Article, error)
+	// ListPubByCategory 某个分类下已发表的帖子
+	ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]domain.Article, error)
+	// ListTags 标签以及标签下已发表的帖子数量，最多返回 limit 个
+	ListTags(ctx context.Context, limit int) ([]domain.TagCount, error)
+	// Delete 把帖子放进回收站，已经发表了的话读者就看不到了
+	Delete(ctx context.Context, uid, artId int64) error
+	// ListDeleted 回收站里面的帖子，最近删除的在前面
+	ListDeleted(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error)
+	// Restore 从回收站恢复，恢复之后是未发表的状态
+	Restore(ctx context.Context, uid, artId int64) error
+	// PurgeDeleted 彻底删除超过保留期限的帖子，由定时任务调用
+	PurgeDeleted(ctx context.Context) error
 }
 
 type articleService struct {
@@ -34,26 +93,66 @@ type articleService struct {
 	// 引入logger
 	l        logger.Logger
 	producer events.Producer
+	// 彻底删除帖子的时候，顺便删掉互动的计数
+	intrSvc intrv1.InteractiveServiceClient
+
+	// 发表之前先过一遍内容审核，没通过的记在 reviewRepo 里面
+	moderator  moderation.Checker
+	reviewRepo article.ReviewRepository
 }
 
 func NewArticleService(repo article.ArticleRepository,
+	reviewRepo article.ReviewRepository,
+	moderator moderation.Checker,
 	producer events.Producer,
+	intrSvc intrv1.InteractiveServiceClient,
 	l logger.Logger) ArticleService {
 	return &articleService{
-		repo:     repo,
-		l:        l,
-		producer: producer,
+		repo:       repo,
+		reviewRepo: reviewRepo,
+		moderator:  moderator,
+		l:          l,
+		producer:   producer,
+		intrSvc:    intrSvc,
 	}
 }
 
+func (s *articleService) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return s.repo.ListPubByCursor(ctx, cursor, limit)
+}
+
 // ListPub 只会取7天内的数据
 func (s *articleService) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error) {
 	return s.repo.ListPub(ctx, start, offset, limit)
 }
 
+func (s *articleService) ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]domain.Article, error) {
+	return s.repo.ListPubByTag(ctx, tag, offset, limit)
+}
+
+func (s *articleService) ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]domain.Article, error) {
+	return s.repo.ListPubByCategory(ctx, category, offset, limit)
+}
+
+func (s *articleService) ListTags(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	return s.repo.CountPubTags(ctx, limit)
+}
+
 // GetPublishedById 获取线上库帖子详情
 func (s *articleService) GetPublishedById(ctx *gin.Context, artId int64, uid int64) (domain.Article, error) {
 	art, err := s.repo.GetPublishedById(ctx, artId)
+	if errors.Is(err, article.ErrRecordNotFound) {
+		// 从来没有发表过，或者已经删除了
+		return domain.Article{}, ErrArticleNotFound
+	}
+	if err == nil && art.Status.NonPublished() {
+		// 仅自己可见、撤回了，或者作者停用、注销了账号，读者都当成不存在
+		return domain.Article{}, ErrArticleNotFound
+	}
+	if err == nil && art.HTML == "" {
+		// 渲染功能上线之前发表的帖子，线上库里面没有 HTML，临时渲染一下
+		art = render(art)
+	}
 	if err == nil {
 		go func() {
 			er := s.producer.ProduceReadEvent(
@@ -61,10 +160,11 @@ func (s *articleService) GetPublishedById(ctx *gin.Context, artId int64, uid int
 				events.ReadEvent{
 					// 即便你的消费者要用 art 的里面的数据，
 					// 让它去查询，你不要在 event 里面带
-					Uid: uid,
-					Aid: artId,
+					Uid:  uid,
+					Aid:  artId,
+					Time: time.Now().UnixMilli(),
 				})
-			if er == nil {
+			if er != nil {
 				s.l.Error("发送读者阅读事件失败")
 			}
 		}()
@@ -82,9 +182,216 @@ func (s *articleService) List(ctx context.Context, uid int64, offset int, limit
 	return s.repo.List(ctx, uid, offset, limit)
 }
 
+func (s *articleService) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return s.repo.ListByCursor(ctx, uid, cursor, limit)
+}
+
 // Withdraw 撤回帖子发表
 func (s *articleService) Withdraw(ctx context.Context, art domain.Article) error {
-	return s.repo.SyncStatus(ctx, art, domain.ArticleStatusUnpublished)
+	err := s.repo.SyncStatus(ctx, art, domain.ArticleStatusUnpublished)
+	if err == nil {
+		s.producePublishEvent(ctx, events.PublishEvent{
+			Aid:       art.Id,
+			Uid:       art.Author.Id,
+			Withdrawn: true,
+		})
+	}
+	return err
+}
+
+// Delete 线上库的帖子也删掉了，跟撤回一样要通知搜索之类的下游
+func (s *articleService) Delete(ctx context.Context, uid, artId int64) error {
+	err := s.repo.Delete(ctx, artId, uid)
+	if err == nil {
+		s.producePublishEvent(ctx, events.PublishEvent{
+			Aid:       artId,
+			Uid:       uid,
+			Withdrawn: true,
+		})
+	}
+	return err
+}
+
+func (s *articleService) ListDeleted(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error) {
+	return s.repo.ListDeleted(ctx, uid, offset, limit)
+}
+
+func (s *articleService) Restore(ctx context.Context, uid, artId int64) error {
+	return s.repo.Restore(ctx, artId, uid, time.Now().Add(-DeletedArticleRetention))
+}
+
+// PurgeDeleted 互动计数删不掉的帖子先不删，留着下一次再试
+func (s *articleService) PurgeDeleted(ctx context.Context) error {
+	before := time.Now().Add(-DeletedArticleRetention)
+	return runBatches(ctx, func(ctx context.Context, limit int) ([]domain.Article, error) {
+		return s.repo.ListPurgeable(ctx, before, limit)
+	}, func(ctx context.Context, arts []domain.Article) (int, error) {
+		ids := make([]int64, 0, len(arts))
+		for _, art := range arts {
+			_, er := s.intrSvc.Delete(ctx, &intrv1.DeleteRequest{
+				Biz: "article", BizId: art.Id,
+			})
+			if er != nil {
+				s.l.Error("删除帖子的互动计数失败",
+					logger.Int64("art_id", art.Id),
+					logger.Error(er))
+				continue
+			}
+			ids = append(ids, art.Id)
+		}
+		return len(ids), s.repo.Purge(ctx, ids)
+	})
+}
+
+// producePublishEvent 发表和撤回的事件发送失败不影响业务本身，只记录日志
+func (s *articleService) producePublishEvent(ctx context.Context, evt events.PublishEvent) {
+	err := s.producer.ProducePublishEvent(ctx, evt)
+	if err != nil {
+		s.l.Error("发送帖子发表事件失败",
+			logger.Int64("art_id", evt.Aid),
+			logger.Error(err))
+	}
+}
+
+func (s *articleService) ListRevisions(ctx context.Context, artId, uid int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	return s.repo.ListRevisions(ctx, artId, uid, offset, limit)
+}
+
+func (s *articleService) DiffRevisions(ctx context.Context, uid, from, to int64) (domain.ArticleRevisionDiff, error) {
+	fromRev, err := s.getRevision(ctx, uid, from)
+	if err != nil {
+		return domain.ArticleRevisionDiff{}, err
+	}
+	toRev, err := s.getRevision(ctx, uid, to)
+	if err != nil {
+		return domain.ArticleRevisionDiff{}, err
+	}
+	if fromRev.ArticleId != toRev.ArticleId {
+		return domain.ArticleRevisionDiff{}, ErrRevisionNotMatch
+	}
+	return domain.ArticleRevisionDiff{
+		From:  fromRev,
+		To:    toRev,
+		Lines: diffx.Lines(fromRev.Content, toRev.Content),
+	}, nil
+}
+
+// RestoreRevision 恢复历史版本，本质上就是拿历史版本的内容再保存一次
+// 所以恢复本身也会生成一个新的历史版本，恢复错了还可以再恢复回去
+func (s *articleService) RestoreRevision(ctx context.Context, uid, revId int64) (int64, error) {
+	rev, err := s.getRevision(ctx, uid, revId)
+	if err != nil {
+		return 0, err
+	}
+	// 带上读到的版本号，这期间别的地方改了帖子的话返回 ErrVersionConflict，不能直接覆盖
+	art, err := s.repo.GetById(ctx, rev.ArticleId)
+	if err != nil {
+		return 0, err
+	}
+	return s.Store(ctx, domain.Article{
+		Id:      rev.ArticleId,
+		Title:   rev.Title,
+		Content: rev.Content,
+		Author: domain.Author{
+			Id: uid,
+		},
+		Category: art.Category,
+		Tags:     art.Tags,
+		Version:  art.Version,
+	})
+}
+
+// Schedule 定时发表只保存在制作库，等到点了再由定时任务同步到线上库
+func (s *articleService) Schedule(ctx context.Context, article domain.Article, at time.Time) (int64, error) {
+	if !at.After(time.Now()) {
+		return 0, ErrInvalidPublishTime
+	}
+	article.Status = domain.ArticleStatusScheduled
+	article.PublishAt = at
+	if article.Id > 0 {
+		err := s.repo.Update(ctx, article)
+		return article.Id, err
+	}
+	return s.repo.Create(ctx, article)
+}
+
+func (s *articleService) ListScheduled(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error) {
+	return s.repo.ListScheduled(ctx, uid, offset, limit)
+}
+
+func (s *articleService) Reschedule(ctx context.Context, uid, artId int64, at time.Time) error {
+	if !at.After(time.Now()) {
+		return ErrInvalidPublishTime
+	}
+	return s.repo.Reschedule(ctx, artId, uid, at)
+}
+
+func (s *articleService) CancelSchedule(ctx context.Context, uid, artId int64) error {
+	return s.repo.CancelSchedule(ctx, artId, uid)
+}
+
+// PublishDue 每次最多处理一批，剩下的等下一次调度
+// 单篇发表失败了只记录日志，它还是定时发表状态，下一次调度还会再试
+func (s *articleService) PublishDue(ctx context.Context) error {
+	const batchSize = 100
+	arts, err := s.repo.ListDueScheduled(ctx, time.Now(), batchSize)
+	if err != nil {
+		return err
+	}
+	for _, art := range arts {
+		res, er := s.moderator.Check(ctx, art)
+		if er != nil {
+			s.l.Error("定时发表帖子审核失败",
+				logger.Int64("art_id", art.Id),
+				logger.Error(er))
+			continue
+		}
+		if res.Verdict != domain.ModerationPass {
+			// 到点了才发现没通过，作者在审核记录里面能看到原因
+			_, er = s.hold(ctx, art, res)
+			if er != nil && !errors.Is(er, ErrArticleUnderReview) && !errors.Is(er, ErrArticleBlocked) {
+				s.l.Error("定时发表帖子保存审核结果失败",
+					logger.Int64("art_id", art.Id),
+					logger.Error(er))
+			}
+			continue
+		}
+		art.Status = domain.ArticleStatusPublished
+		_, er = s.repo.Sync(ctx, render(art))
+		if er != nil {
+			s.l.Error("定时发表帖子失败",
+				logger.Int64("art_id", art.Id),
+				logger.Error(er))
+			continue
+		}
+		s.producePublishEvent(ctx, events.PublishEvent{
+			Aid: art.Id,
+			Uid: art.Author.Id,
+		})
+	}
+	return nil
+}
+
+func (s *articleService) getRevision(ctx context.Context, uid, revId int64) (domain.ArticleRevision, error) {
+	rev, err := s.repo.GetRevision(ctx, revId)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	if rev.AuthorId != uid {
+		s.l.Warn("非法访问历史版本",
+			logger.Int64("uid", uid),
+			logger.Int64("rev_id", revId))
+		return domain.ArticleRevision{}, ErrRevisionNotMatch
+	}
+	return rev, nil
+}
+
+// render 发表的时候把 Markdown 渲染成 HTML 和目录，一起存到线上库
+func render(art domain.Article) domain.Article {
+	doc := markdownx.Render(art.Content)
+	art.HTML = doc.HTML
+	art.TOC = doc.TOC
+	return art
 }
 
 // v1: NewArticleServiceV1 在serice层操作 author和 reader 两个repo
@@ -109,13 +416,58 @@ func (s *articleService) Store(ctx context.Context, article domain.Article) (int
 	return id, err
 }
 
-// Publish 发表帖子
+// Publish 发表帖子，没有通过内容审核的不会同步到线上库
 func (s *articleService) Publish(ctx context.Context, article domain.Article) (int64, error) {
+	res, err := s.moderator.Check(ctx, article)
+	if err != nil {
+		return article.Id, err
+	}
+	if res.Verdict != domain.ModerationPass {
+		return s.hold(ctx, article, res)
+	}
 	article.Status = domain.ArticleStatusPublished
-	artId, err := s.repo.Sync(ctx, article)
+	artId, err := s.repo.Sync(ctx, render(article))
+	if err == nil {
+		s.producePublishEvent(ctx, events.PublishEvent{
+			Aid: artId,
+			Uid: article.Author.Id,
+		})
+	}
 	return artId, err
 }
 
+// hold 没有通过自动审核，只保存到制作库，线上库保持原样
+// 要人工审核的是审核中的状态，拦下来的直接变成草稿，两种都会留下审核记录
+func (s *articleService) hold(ctx context.Context, art domain.Article, res domain.ModerationResult) (int64, error) {
+	status, reviewStatus, holdErr := domain.ArticleStatusReviewing, domain.ArticleReviewStatusPending, ErrArticleUnderReview
+	if res.Verdict == domain.ModerationBlock {
+		status, reviewStatus, holdErr = domain.ArticleStatusUnpublished, domain.ArticleReviewStatusBlocked, ErrArticleBlocked
+	}
+	art.Status = status
+	art.PublishAt = time.Time{}
+	var err error
+	if art.Id > 0 {
+		err = s.repo.Update(ctx, art)
+	} else {
+		art.Id, err = s.repo.Create(ctx, art)
+	}
+	if err != nil {
+		return art.Id, err
+	}
+	_, err = s.reviewRepo.Create(ctx, domain.ArticleReview{
+		ArticleId: art.Id,
+		Author:    art.Author,
+		Title:     art.Title,
+		Content:   art.Content,
+		Status:    reviewStatus,
+		Reasons:   res.Reasons,
+	})
+	if err != nil {
+		return art.Id, err
+	}
+	return art.Id, holdErr
+}
+
 // PublishV1 V1: publish层操作两个repo
 func (s *articleService) PublishV1(ctx context.Context, article domain.Article) (int64, error) {
 	var (
@@ -6,10 +6,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"testing"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	intrv1mocks "webookpro/api/proto/gen/intr/v1/mocks"
 	"webookpro/internal/domain"
+	events "webookpro/internal/events/article"
+	evtmocks "webookpro/internal/events/article/mocks"
 	"webookpro/internal/repository/article"
 	artrepomocks "webookpro/internal/repository/article/mocks"
+	"webookpro/internal/service/moderation/memory"
 	"webookpro/pkg/logger"
+	"webookpro/pkg/markdownx"
 )
 
 func TestArticleService_PublishV1(t *testing.T) {
@@ -194,3 +200,345 @@ func TestArticleService_PublishV1(t *testing.T) {
 		})
 	}
 }
+
+func TestArticleService_RestoreRevision(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) article.ArticleRepository
+		uid     int64
+		revId   int64
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetRevision(gomock.Any(), int64(10)).
+					Return(domain.ArticleRevision{
+						Id:        10,
+						ArticleId: 1,
+						Title:     "旧标题",
+						Content:   "旧内容",
+						AuthorId:  123,
+					}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{
+						Id:       1,
+						Title:    "新标题",
+						Content:  "新内容",
+						Author:   domain.Author{Id: 123},
+						Category: "后端",
+						Tags:     []string{"Go"},
+						Version:  3,
+					}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:       1,
+					Title:    "旧标题",
+					Content:  "旧内容",
+					Author:   domain.Author{Id: 123},
+					Status:   domain.ArticleStatusUnpublished,
+					Category: "后端",
+					Tags:     []string{"Go"},
+					Version:  3,
+				}).Return(nil)
+				return repo
+			},
+			uid:    123,
+			revId:  10,
+			wantId: 1,
+		},
+		{
+			name: "恢复的时候帖子被改过了",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetRevision(gomock.Any(), int64(10)).
+					Return(domain.ArticleRevision{
+						Id:        10,
+						ArticleId: 1,
+						Title:     "旧标题",
+						Content:   "旧内容",
+						AuthorId:  123,
+					}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 123}, Version: 3}, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(ErrVersionConflict)
+				return repo
+			},
+			uid:     123,
+			revId:   10,
+			wantId:  1,
+			wantErr: ErrVersionConflict,
+		},
+		{
+			name: "不是自己的历史版本",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetRevision(gomock.Any(), int64(10)).
+					Return(domain.ArticleRevision{
+						Id:        10,
+						ArticleId: 1,
+						AuthorId:  456,
+					}, nil)
+				return repo
+			},
+			uid:     123,
+			revId:   10,
+			wantErr: ErrRevisionNotMatch,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewArticleService(tc.mock(ctrl), nil, nil, nil, nil, &logger.NopLogger{})
+			id, err := svc.RestoreRevision(context.Background(), tc.uid, tc.revId)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func TestArticleService_PublishDue(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (article.ArticleRepository, events.Producer)
+		wantErr error
+	}{
+		{
+			name: "发表到点的帖子，单篇失败不影响其它的",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListDueScheduled(gomock.Any(), gomock.Any(), 100).
+					Return([]domain.Article{
+						{Id: 1, Author: domain.Author{Id: 123}, Status: domain.ArticleStatusScheduled, Version: 2},
+						{Id: 2, Author: domain.Author{Id: 123}, Status: domain.ArticleStatusScheduled, Version: 5},
+					}, nil)
+				// 带上读到的版本号，到点之前作者又改过的话不会覆盖
+				repo.EXPECT().Sync(gomock.Any(), domain.Article{
+					Id:      1,
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPublished,
+					Version: 2,
+				}).Return(int64(1), errors.New("mock db error"))
+				repo.EXPECT().Sync(gomock.Any(), domain.Article{
+					Id:      2,
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPublished,
+					Version: 5,
+				}).Return(int64(2), nil)
+				// 只有发表成功的才会发事件
+				producer.EXPECT().ProducePublishEvent(gomock.Any(), events.PublishEvent{
+					Aid: 2,
+					Uid: 123,
+				}).Return(nil)
+				return repo, producer
+			},
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().ListDueScheduled(gomock.Any(), gomock.Any(), 100).
+					Return(nil, errors.New("mock db error"))
+				return repo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, memory.NewChecker(), producer, nil, &logger.NopLogger{})
+			err := svc.PublishDue(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestArticleService_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockArticleRepository(ctrl)
+	producer := evtmocks.NewMockProducer(ctrl)
+	// 发表的时候渲染好 HTML 和目录，一起同步到线上库
+	repo.EXPECT().Sync(gomock.Any(), domain.Article{
+		Title:   "标题",
+		Content: "# 第一节\n\n<script>alert(1)</script>",
+		Author:  domain.Author{Id: 123},
+		Status:  domain.ArticleStatusPublished,
+		HTML:    "<h1 id=\"第一节\">第一节</h1>\n<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		TOC: []markdownx.Heading{
+			{Level: 1, Id: "第一节", Title: "第一节"},
+		},
+	}).Return(int64(1), nil)
+	producer.EXPECT().ProducePublishEvent(gomock.Any(), events.PublishEvent{
+		Aid: 1,
+		Uid: 123,
+	}).Return(nil)
+	svc := NewArticleService(repo, nil, memory.NewChecker(), producer, nil, &logger.NopLogger{})
+	id, err := svc.Publish(context.Background(), domain.Article{
+		Title:   "标题",
+		Content: "# 第一节\n\n<script>alert(1)</script>",
+		Author:  domain.Author{Id: 123},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+}
+
+func TestArticleService_PublishModeration(t *testing.T) {
+	testcases := []struct {
+		name    string
+		res     domain.ModerationResult
+		mock    func(ctrl *gomock.Controller) (article.ArticleRepository, article.ReviewRepository)
+		wantErr error
+	}{
+		{
+			name: "要人工审核，保存成审核中",
+			res: domain.ModerationResult{
+				Verdict: domain.ModerationReview,
+				Reasons: []string{"包含敏感词：发票"},
+			},
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, article.ReviewRepository) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				reviewRepo := artrepomocks.NewMockReviewRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "标题",
+					Content: "发票",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusReviewing,
+					Version: 3,
+				}).Return(nil)
+				reviewRepo.EXPECT().Create(gomock.Any(), domain.ArticleReview{
+					ArticleId: 1,
+					Author:    domain.Author{Id: 123},
+					Title:     "标题",
+					Content:   "发票",
+					Status:    domain.ArticleReviewStatusPending,
+					Reasons:   []string{"包含敏感词：发票"},
+				}).Return(int64(10), nil)
+				return repo, reviewRepo
+			},
+			wantErr: ErrArticleUnderReview,
+		},
+		{
+			name: "拦下来，保存成草稿",
+			res: domain.ModerationResult{
+				Verdict: domain.ModerationBlock,
+				Reasons: []string{"包含被屏蔽的链接：bad.com"},
+			},
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, article.ReviewRepository) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				reviewRepo := artrepomocks.NewMockReviewRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "标题",
+					Content: "发票",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+					Version: 3,
+				}).Return(nil)
+				reviewRepo.EXPECT().Create(gomock.Any(), domain.ArticleReview{
+					ArticleId: 1,
+					Author:    domain.Author{Id: 123},
+					Title:     "标题",
+					Content:   "发票",
+					Status:    domain.ArticleReviewStatusBlocked,
+					Reasons:   []string{"包含被屏蔽的链接：bad.com"},
+				}).Return(int64(10), nil)
+				return repo, reviewRepo
+			},
+			wantErr: ErrArticleBlocked,
+		},
+		{
+			name: "版本冲突",
+			res:  domain.ModerationResult{Verdict: domain.ModerationReview},
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, article.ReviewRepository) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(ErrVersionConflict)
+				return repo, artrepomocks.NewMockReviewRepository(ctrl)
+			},
+			wantErr: ErrVersionConflict,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, reviewRepo := tc.mock(ctrl)
+			checker := memory.NewChecker()
+			checker.SetResult(tc.res, nil)
+			// 没有发表，不会发事件
+			svc := NewArticleService(repo, reviewRepo, checker, evtmocks.NewMockProducer(ctrl), nil, &logger.NopLogger{})
+			id, err := svc.Publish(context.Background(), domain.Article{
+				Id:      1,
+				Title:   "标题",
+				Content: "发票",
+				Author:  domain.Author{Id: 123},
+				Version: 3,
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, int64(1), id)
+		})
+	}
+}
+
+func TestArticleService_PurgeDeleted(t *testing.T) {
+	testcases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (article.ArticleRepository, intrv1.InteractiveServiceClient)
+		wantErr error
+	}{
+		{
+			name: "互动计数删不掉的帖子留着下次再试",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().ListPurgeable(gomock.Any(), gomock.Any(), 100).
+					Return([]domain.Article{{Id: 1}, {Id: 2}}, nil)
+				intrSvc.EXPECT().Delete(gomock.Any(), &intrv1.DeleteRequest{
+					Biz: "article", BizId: 1,
+				}).Return(nil, errors.New("mock rpc error"))
+				intrSvc.EXPECT().Delete(gomock.Any(), &intrv1.DeleteRequest{
+					Biz: "article", BizId: 2,
+				}).Return(&intrv1.DeleteResponse{}, nil)
+				repo.EXPECT().Purge(gomock.Any(), []int64{2}).Return(nil)
+				return repo, intrSvc
+			},
+		},
+		{
+			name: "彻底删除失败",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().ListPurgeable(gomock.Any(), gomock.Any(), 100).
+					Return([]domain.Article{{Id: 1}}, nil)
+				intrSvc.EXPECT().Delete(gomock.Any(), &intrv1.DeleteRequest{
+					Biz: "article", BizId: 1,
+				}).Return(&intrv1.DeleteResponse{}, nil)
+				repo.EXPECT().Purge(gomock.Any(), []int64{1}).
+					Return(errors.New("mock db error"))
+				return repo, intrSvc
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intrSvc := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, nil, intrSvc, &logger.NopLogger{})
+			err := svc.PurgeDeleted(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	// 注册 GIF 的解码器，image.Decode 才认识
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/pkg/imagex"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/ossx"
+)
+
+const (
+	// MaxAttachmentSize 单个附件最大 5M
+	MaxAttachmentSize = 5 << 20
+	// 像素太多的图片解码出来会占很多内存，哪怕文件本身不大
+	maxAttachmentPixels = 40_000_000
+	thumbnailSize       = 320
+	// 上传之后一天都没有帖子引用，就认为是没用的附件
+	orphanAttachmentTTL = time.Hour * 24
+)
+
+var (
+	ErrUnsupportedAttachment = errors.New("不支持的附件类型")
+	ErrAttachmentTooLarge    = errors.New("附件太大")
+	ErrAttachmentNotFound    = errors.New("附件不存在")
+)
+
+// attachmentExts 支持的图片类型，key 用的就是这里的扩展名
+var attachmentExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+var (
+	// attachmentKeyRegexp 附件的 key，对象存储是和线上库共用的，不能让别人通过附件的接口读到其它东西
+	attachmentKeyRegexp = regexp.MustCompile(`^attachments/\d+/[0-9a-f]{32}(?:_thumb)?\.(?:jpg|png|gif)$`)
+	// attachmentRefRegexp 帖子内容里面引用的附件地址
+	attachmentRefRegexp = regexp.MustCompile(regexp.QuoteMeta(domain.AttachmentURLPrefix) +
+		`(attachments/\d+/[0-9a-f]{32}(?:_thumb)?\.(?:jpg|png|gif))`)
+)
+
+//go:generate mockgen -source=attachment.go -package=svcmocks -destination=mock/attachment.mock.go AttachmentService
+type AttachmentService interface {
+	// Upload 校验类型和大小，生成缩略图，原图和缩略图都保存到对象存储
+	Upload(ctx context.Context, uid int64, data []byte) (domain.Attachment, error)
+	// Get 读取附件，返回内容和 MIME 类型
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	// BindArticle 保存草稿的时候调用，只会增加引用
+	// 草稿里面删掉的图片，线上的版本可能还在用，所以不能解除引用
+	BindArticle(ctx context.Context, uid int64, artId int64, content string) error
+	// SyncArticle 发表的时候调用，帖子不再引用的附件要是别的帖子也没有引用，会在一段时间之后被清理掉
+	SyncArticle(ctx context.Context, uid int64, artId int64, content string) error
+	// CleanOrphans 清理没有帖子引用的附件，由定时任务调用
+	CleanOrphans(ctx context.Context) error
+}
+
+type attachmentService struct {
+	repo repository.AttachmentRepository
+	oss  ossx.Store
+	l    logger.Logger
+}
+
+func NewAttachmentService(repo repository.AttachmentRepository,
+	oss ossx.Store, l logger.Logger) AttachmentService {
+	return &attachmentService{
+		repo: repo,
+		oss:  oss,
+		l:    l,
+	}
+}
+
+func (s *attachmentService) Upload(ctx context.Context, uid int64, data []byte) (domain.Attachment, error) {
+	if len(data) > MaxAttachmentSize {
+		return domain.Attachment{}, ErrAttachmentTooLarge
+	}
+	// 不相信客户端传过来的类型，按照内容来判断
+	mimeType := http.DetectContentType(data)
+	ext, ok := attachmentExts[mimeType]
+	if !ok {
+		return domain.Attachment{}, ErrUnsupportedAttachment
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return domain.Attachment{}, ErrUnsupportedAttachment
+	}
+	if cfg.Width*cfg.Height > maxAttachmentPixels {
+		return domain.Attachment{}, ErrAttachmentTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return domain.Attachment{}, ErrUnsupportedAttachment
+	}
+	name, err := randomName()
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	att := domain.Attachment{
+		Uid:      uid,
+		Key:      fmt.Sprintf("attachments/%d/%s%s", uid, name, ext),
+		MimeType: mimeType,
+		Size:     int64(len(data)),
+		Width:    cfg.Width,
+		Height:   cfg.Height,
+	}
+	att.ThumbKey = att.Key
+	err = s.oss.Put(ctx, att.Key, data, mimeType)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	// 原图本来就不大的话，缩略图就是原图
+	if cfg.Width > thumbnailSize || cfg.Height > thumbnailSize {
+		thumbData, thumbExt, er := encodeThumbnail(imagex.Thumbnail(img, thumbnailSize), mimeType)
+		if er != nil {
+			return domain.Attachment{}, er
+		}
+		att.ThumbKey = fmt.Sprintf("attachments/%d/%s_thumb%s", uid, name, thumbExt)
+		er = s.oss.Put(ctx, att.ThumbKey, thumbData, mime.TypeByExtension(thumbExt))
+		if er != nil {
+			return domain.Attachment{}, er
+		}
+	}
+	// 先传文件再写数据库，写数据库失败的话，对象存储上面会多出来没人知道的文件，但是不会有指向不存在的文件的记录
+	att.Id, err = s.repo.Create(ctx, att)
+	return att, err
+}
+
+func (s *attachmentService) Get(ctx context.Context, key string) ([]byte, string, error) {
+	if !attachmentKeyRegexp.MatchString(key) {
+		return nil, "", ErrAttachmentNotFound
+	}
+	data, err := s.oss.Get(ctx, key)
+	if errors.Is(err, ossx.ErrObjectNotFound) {
+		return nil, "", ErrAttachmentNotFound
+	}
+	return data, mime.TypeByExtension(path.Ext(key)), err
+}
+
+func (s *attachmentService) BindArticle(ctx context.Context, uid int64, artId int64, content string) error {
+	return s.repo.BindArticle(ctx, uid, artId, referencedKeys(content))
+}
+
+func (s *attachmentService) SyncArticle(ctx context.Context, uid int64, artId int64, content string) error {
+	keys := referencedKeys(content)
+	err := s.repo.BindArticle(ctx, uid, artId, keys)
+	if err != nil {
+		return err
+	}
+	return s.repo.UnbindArticle(ctx, artId, keys)
+}
+
+// CleanOrphans 对象存储上的文件删不掉的话，数据库里面的记录也先留着，下一次再试
+func (s *attachmentService) CleanOrphans(ctx context.Context) error {
+	before := time.Now().Add(-orphanAttachmentTTL)
+	return runBatches(ctx, func(ctx context.Context, limit int) ([]domain.Attachment, error) {
+		return s.repo.FindOrphans(ctx, before, limit)
+	}, func(ctx context.Context, atts []domain.Attachment) (int, error) {
+		ids := make([]int64, 0, len(atts))
+		for _, att := range atts {
+			er := s.deleteObjects(ctx, att)
+			if er != nil {
+				s.l.Error("删除附件文件失败",
+					logger.Int64("id", att.Id),
+					logger.Error(er))
+				continue
+			}
+			ids = append(ids, att.Id)
+		}
+		return len(ids), s.repo.Delete(ctx, ids)
+	})
+}
+
+func (s *attachmentService) deleteObjects(ctx context.Context, att domain.Attachment) error {
+	err := s.oss.Delete(ctx, att.Key)
+	if err != nil || att.ThumbKey == att.Key {
+		return err
+	}
+	return s.oss.Delete(ctx, att.ThumbKey)
+}
+
+// referencedKeys 帖子内容里面引用的附件，去重
+func referencedKeys(content string) []string {
+	matches := attachmentRefRegexp.FindAllStringSubmatch(content, -1)
+	keys := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, m := range matches {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		keys = append(keys, m[1])
+	}
+	return keys
+}
+
+// encodeThumbnail JPEG 的缩略图还是 JPEG，PNG 和 GIF 的缩略图都用 PNG，GIF 只取第一帧
+func encodeThumbnail(img image.Image, mimeType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if mimeType == "image/jpeg" {
+		err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80})
+		return buf.Bytes(), ".jpg", err
+	}
+	err := png.Encode(&buf, img)
+	return buf.Bytes(), ".png", err
+}
+
+func randomName() (string, error) {
+	bs := make([]byte, 16)
+	_, err := rand.Read(bs)
+	return hex.EncodeToString(bs), err
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"webookpro/internal/domain"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/ossx"
+	"webookpro/pkg/ossx/memory"
+)
+
+func TestAttachmentService_Upload(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      func(t *testing.T) []byte
+		mock      func(ctrl *gomock.Controller) *repomocks.MockAttachmentRepository
+		wantThumb bool
+		wantErr   error
+	}{
+		{
+			name: "大图生成缩略图",
+			data: func(t *testing.T) []byte {
+				return pngData(t, 800, 400)
+			},
+			mock: func(ctrl *gomock.Controller) *repomocks.MockAttachmentRepository {
+				repo := repomocks.NewMockAttachmentRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				return repo
+			},
+			wantThumb: true,
+		},
+		{
+			name: "小图的缩略图就是原图",
+			data: func(t *testing.T) []byte {
+				return pngData(t, 100, 50)
+			},
+			mock: func(ctrl *gomock.Controller) *repomocks.MockAttachmentRepository {
+				repo := repomocks.NewMockAttachmentRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				return repo
+			},
+		},
+		{
+			name: "不是图片",
+			data: func(t *testing.T) []byte {
+				return []byte("<script>alert(1)</script>")
+			},
+			mock: func(ctrl *gomock.Controller) *repomocks.MockAttachmentRepository {
+				return repomocks.NewMockAttachmentRepository(ctrl)
+			},
+			wantErr: ErrUnsupportedAttachment,
+		},
+		{
+			name: "文件太大",
+			data: func(t *testing.T) []byte {
+				return make([]byte, MaxAttachmentSize+1)
+			},
+			mock: func(ctrl *gomock.Controller) *repomocks.MockAttachmentRepository {
+				return repomocks.NewMockAttachmentRepository(ctrl)
+			},
+			wantErr: ErrAttachmentTooLarge,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := memory.NewStore()
+			svc := NewAttachmentService(tc.mock(ctrl), store, &logger.NopLogger{})
+			att, err := svc.Upload(context.Background(), 123, tc.data(t))
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, int64(1), att.Id)
+			assert.Equal(t, "image/png", att.MimeType)
+			assert.True(t, strings.HasPrefix(att.URL(), domain.AttachmentURLPrefix+"attachments/123/"))
+			assert.Equal(t, tc.wantThumb, att.ThumbKey != att.Key)
+
+			// 上传之后通过 Get 能读回来
+			data, contentType, err := svc.Get(context.Background(), att.ThumbKey)
+			require.NoError(t, err)
+			assert.Equal(t, "image/png", contentType)
+			cfg, err := png.DecodeConfig(bytes.NewReader(data))
+			require.NoError(t, err)
+			assert.LessOrEqual(t, cfg.Width, 320)
+		})
+	}
+}
+
+func TestAttachmentService_Get(t *testing.T) {
+	store := memory.NewStore()
+	ctx := context.Background()
+	// 线上库的内容也在同一个对象存储里面，不能通过附件的接口读到
+	err := store.Put(ctx, "1", []byte("帖子内容"), "text/plain")
+	require.NoError(t, err)
+	svc := NewAttachmentService(nil, store, &logger.NopLogger{})
+	_, _, err = svc.Get(ctx, "1")
+	assert.Equal(t, ErrAttachmentNotFound, err)
+	_, _, err = svc.Get(ctx, "attachments/123/0123456789abcdef0123456789abcdef.png")
+	assert.Equal(t, ErrAttachmentNotFound, err)
+}
+
+func TestAttachmentService_SyncArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	content := "![a](/attachments/files/attachments/123/0123456789abcdef0123456789abcdef.png)\n" +
+		"![b](https://example.com/attachments/files/attachments/123/fedcba9876543210fedcba9876543210_thumb.jpg)\n" +
+		"![a](/attachments/files/attachments/123/0123456789abcdef0123456789abcdef.png)"
+	keys := []string{
+		"attachments/123/0123456789abcdef0123456789abcdef.png",
+		"attachments/123/fedcba9876543210fedcba9876543210_thumb.jpg",
+	}
+	repo := repomocks.NewMockAttachmentRepository(ctrl)
+	repo.EXPECT().BindArticle(gomock.Any(), int64(123), int64(1), keys).Return(nil)
+	repo.EXPECT().UnbindArticle(gomock.Any(), int64(1), keys).Return(nil)
+	svc := NewAttachmentService(repo, memory.NewStore(), &logger.NopLogger{})
+	err := svc.SyncArticle(context.Background(), 123, 1, content)
+	assert.NoError(t, err)
+}
+
+func TestAttachmentService_CleanOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	store := memory.NewStore()
+	for _, key := range []string{"a.png", "a_thumb.png", "b.png"} {
+		require.NoError(t, store.Put(ctx, key, []byte("data"), "image/png"))
+	}
+	repo := repomocks.NewMockAttachmentRepository(ctrl)
+	repo.EXPECT().FindOrphans(gomock.Any(), gomock.Any(), 100).Return([]domain.Attachment{
+		{Id: 1, Key: "a.png", ThumbKey: "a_thumb.png"},
+		{Id: 2, Key: "b.png", ThumbKey: "b.png"},
+	}, nil)
+	repo.EXPECT().Delete(gomock.Any(), []int64{1, 2}).Return(nil)
+	svc := NewAttachmentService(repo, store, &logger.NopLogger{})
+	err := svc.CleanOrphans(ctx)
+	require.NoError(t, err)
+	for _, key := range []string{"a.png", "a_thumb.png", "b.png"} {
+		_, err = store.Get(ctx, key)
+		assert.True(t, errors.Is(err, ossx.ErrObjectNotFound), key)
+	}
+}
+
+func pngData(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)))
+	require.NoError(t, err)
+	return buf.Bytes()
+}
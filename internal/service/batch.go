@@ -0,0 +1,27 @@
+package service
+
+import "context"
+
+// cleanupBatchSize 定时清理的任务每一批处理的数量
+const cleanupBatchSize = 100
+
+// runBatches 定时清理的任务分批处理，一直处理到查出来的不满一批为止
+// handle 返回这一批里面处理掉了多少条，处理失败的留在原地，下次查询还会查出来
+// 一条都处理不掉的话，再查还是同样的数据，只能停下来等下次调度
+func runBatches[T any](ctx context.Context,
+	find func(ctx context.Context, limit int) ([]T, error),
+	handle func(ctx context.Context, batch []T) (int, error)) error {
+	for {
+		batch, err := find(ctx, cleanupBatchSize)
+		if err != nil || len(batch) == 0 {
+			return err
+		}
+		done, err := handle(ctx, batch)
+		if err != nil {
+			return err
+		}
+		if len(batch) < cleanupBatchSize || done == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"math/rand"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/internal/service/email"
+	"webookpro/pkg/logger"
+)
+
+const (
+	bindEmailBiz = "bind_email"
+	bindPhoneBiz = "bind_phone"
+)
+
+var (
+	ErrUserDuplicatePhone  = repository.ErrUserDuplicatePhone
+	ErrUserDuplicateWechat = repository.ErrUserDuplicateWechat
+	ErrUserLastLoginMethod = repository.ErrUserLastLoginMethod
+	// ErrInvalidBindingCode 绑定邮箱或者手机号的验证码不对
+	ErrInvalidBindingCode = errors.New("验证码不对")
+	// ErrLoginMethodNotBound 解绑一个本来就没有绑定的登录方式
+	ErrLoginMethodNotBound = errors.New("没有绑定这种登录方式")
+)
+
+// BindingService 登录之后绑定、解绑邮箱、手机号和微信
+// 邮箱和手机号都要先验证，被别的账号用了会返回 ErrUserDuplicateXXX
+//
+//go:generate mockgen -source=binding.go -package=svcmocks -destination=mock/binding.mock.go BindingService
+type BindingService interface {
+	SendEmailCode(ctx context.Context, uid int64, email string) error
+	BindEmail(ctx context.Context, uid int64, email, code string) error
+	SendPhoneCode(ctx context.Context, uid int64, phone string) error
+	BindPhone(ctx context.Context, uid int64, phone, code string) error
+	// BindWechat 微信扫码回调回来的时候已经验证过了
+	BindWechat(ctx context.Context, uid int64, info domain.WeChatInfo) error
+	// Unbind 不能解绑最后一种登录方式
+	Unbind(ctx context.Context, uid int64, method domain.LoginMethod) error
+}
+
+type bindingService struct {
+	repo     repository.UserRepository
+	codeRepo repository.CodeRepository
+	codeSvc  CodeService
+	email    email.Service
+	l        logger.Logger
+}
+
+func NewBindingService(repo repository.UserRepository,
+	codeRepo repository.CodeRepository,
+	codeSvc CodeService,
+	email email.Service,
+	l logger.Logger) BindingService {
+	return &bindingService{
+		repo:     repo,
+		codeRepo: codeRepo,
+		codeSvc:  codeSvc,
+		email:    email,
+		l:        l,
+	}
+}
+
+func (s *bindingService) SendEmailCode(ctx context.Context, uid int64, email string) error {
+	user, err := s.repo.FindByEmail(ctx, email)
+	err = s.checkOwner(uid, user, err, ErrUserDuplicateEmail)
+	if err != nil {
+		return err
+	}
+	code := fmt.Sprintf("%06d", rand.Intn(1000000))
+	err = s.codeRepo.Store(ctx, bindEmailBiz, email, code)
+	if err != nil {
+		return err
+	}
+	return s.email.Send(ctx, email, "webook 绑定邮箱",
+		fmt.Sprintf("你的验证码是 %s，10 分钟内有效。\n如果不是你本人操作，请忽略这封邮件。", code))
+}
+
+func (s *bindingService) BindEmail(ctx context.Context, uid int64, email, code string) error {
+	ok, err := s.codeRepo.Verfiy(ctx, bindEmailBiz, email, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidBindingCode
+	}
+	return s.repo.BindEmail(ctx, uid, email)
+}
+
+func (s *bindingService) SendPhoneCode(ctx context.Context, uid int64, phone string) error {
+	user, err := s.repo.FindByPhone(ctx, phone)
+	err = s.checkOwner(uid, user, err, ErrUserDuplicatePhone)
+	if err != nil {
+		return err
+	}
+	return s.codeSvc.Send(ctx, bindPhoneBiz, phone)
+}
+
+func (s *bindingService) BindPhone(ctx context.Context, uid int64, phone, code string) error {
+	ok, err := s.codeSvc.Verify(ctx, bindPhoneBiz, phone, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidBindingCode
+	}
+	return s.repo.BindPhone(ctx, uid, phone)
+}
+
+func (s *bindingService) BindWechat(ctx context.Context, uid int64, info domain.WeChatInfo) error {
+	return s.repo.BindWechat(ctx, uid, info)
+}
+
+func (s *bindingService) Unbind(ctx context.Context, uid int64, method domain.LoginMethod) error {
+	user, err := s.repo.FindById(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if !user.Bound(method) {
+		return ErrLoginMethodNotBound
+	}
+	return s.repo.Unbind(ctx, uid, method)
+}
+
+// checkOwner 发验证码之前先看看是不是已经被别的账号用了，省得验证完了才告诉用户
+// 最终还是靠唯一索引兜底
+func (s *bindingService) checkOwner(uid int64, user domain.User, err error, dup error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Id != uid {
+		return dup
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/internal/service/email/memory"
+	"webookpro/pkg/logger"
+)
+
+func TestBindingService_SendEmailCode(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository)
+
+		wantSent int
+		wantErr  error
+	}{
+		{
+			name: "发送成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{}, gorm.ErrRecordNotFound)
+				codeRepo.EXPECT().Store(gomock.Any(), bindEmailBiz, "123@qq.com", gomock.Any()).Return(nil)
+				return userRepo, codeRepo
+			},
+			wantSent: 1,
+		},
+		{
+			name: "邮箱被别人用了",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{Id: 2, Email: "123@qq.com"}, nil)
+				return userRepo, repomocks.NewMockCodeRepository(ctrl)
+			},
+			wantErr: ErrUserDuplicateEmail,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo, codeRepo := tc.mock(ctrl)
+			emailSvc := memory.NewService(&logger.NopLogger{})
+			svc := NewBindingService(userRepo, codeRepo, nil, emailSvc, &logger.NopLogger{})
+			err := svc.SendEmailCode(context.Background(), 1, "123@qq.com")
+			assert.Equal(t, tc.wantErr, err)
+			require.Len(t, emailSvc.Sent(), tc.wantSent)
+		})
+	}
+}
+
+func TestBindingService_BindEmail(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository)
+
+		wantErr error
+	}{
+		{
+			name: "绑定成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), bindEmailBiz, "123@qq.com", "123456").Return(true, nil)
+				userRepo.EXPECT().BindEmail(gomock.Any(), int64(1), "123@qq.com").Return(nil)
+				return userRepo, codeRepo
+			},
+		},
+		{
+			name: "验证码不对",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), bindEmailBiz, "123@qq.com", "123456").Return(false, nil)
+				return repomocks.NewMockUserRepository(ctrl), codeRepo
+			},
+			wantErr: ErrInvalidBindingCode,
+		},
+		{
+			name: "验证之后邮箱被别人抢先绑定了",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), bindEmailBiz, "123@qq.com", "123456").Return(true, nil)
+				userRepo.EXPECT().BindEmail(gomock.Any(), int64(1), "123@qq.com").Return(ErrUserDuplicateEmail)
+				return userRepo, codeRepo
+			},
+			wantErr: ErrUserDuplicateEmail,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo, codeRepo := tc.mock(ctrl)
+			svc := NewBindingService(userRepo, codeRepo, nil, memory.NewService(&logger.NopLogger{}), &logger.NopLogger{})
+			err := svc.BindEmail(context.Background(), 1, "123@qq.com", "123456")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestBindingService_Unbind(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mock   func(ctrl *gomock.Controller) repository.UserRepository
+		method domain.LoginMethod
+
+		wantErr error
+	}{
+		{
+			name: "解绑成功",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Email: "123@qq.com", Phone: "13800000000"}, nil)
+				userRepo.EXPECT().Unbind(gomock.Any(), int64(1), domain.LoginMethodPhone).Return(nil)
+				return userRepo
+			},
+			method: domain.LoginMethodPhone,
+		},
+		{
+			name: "没有绑定",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Email: "123@qq.com"}, nil)
+				return userRepo
+			},
+			method:  domain.LoginMethodWechat,
+			wantErr: ErrLoginMethodNotBound,
+		},
+		{
+			name: "最后一种登录方式",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Phone: "13800000000"}, nil)
+				userRepo.EXPECT().Unbind(gomock.Any(), int64(1), domain.LoginMethodPhone).
+					Return(ErrUserLastLoginMethod)
+				return userRepo
+			},
+			method:  domain.LoginMethodPhone,
+			wantErr: ErrUserLastLoginMethod,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewBindingService(tc.mock(ctrl), repomocks.NewMockCodeRepository(ctrl),
+				nil, memory.NewService(&logger.NopLogger{}), &logger.NopLogger{})
+			err := svc.Unbind(context.Background(), 1, tc.method)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
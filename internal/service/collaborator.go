@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+)
+
+var (
+	ErrCollaboratorNotFound = article.ErrCollaboratorNotFound
+	// ErrArticleForbidden 用户在帖子上的角色不够
+	ErrArticleForbidden = errors.New("没有操作帖子的权限")
+	// ErrInvalidCollaborator 不能邀请自己，也不能把别人设置成创作者
+	ErrInvalidCollaborator = errors.New("协作者不合法")
+)
+
+//go:generate mockgen -source=collaborator.go -package=svcmocks -destination=mock/collaborator.mock.go CollaboratorService
+type CollaboratorService interface {
+	// Authorize uid 在帖子上至少要有 need 这个角色，通过的话返回帖子，帖子的 Author 就是创作者
+	Authorize(ctx context.Context, uid, artId int64, need domain.ArticleRole) (domain.Article, error)
+	// Role uid 在帖子上的角色
+	Role(ctx context.Context, uid int64, art domain.Article) (domain.ArticleRole, error)
+	// Invite 只有创作者能邀请，已经是协作者的话修改角色
+	Invite(ctx context.Context, uid, artId, collaborator int64, role domain.ArticleRole) error
+	// Remove 创作者移除协作者，协作者也可以自己退出
+	Remove(ctx context.Context, uid, artId, collaborator int64) error
+	// List 创作者和协作者都能看
+	List(ctx context.Context, uid, artId int64) ([]domain.ArticleCollaborator, error)
+	// ArticleChanged 帖子被修改了，协作者的帖子列表也要跟着变
+	ArticleChanged(ctx context.Context, artId int64) error
+}
+
+type collaboratorService struct {
+	repo    article.CollaboratorRepository
+	artRepo article.ArticleRepository
+}
+
+func NewCollaboratorService(repo article.CollaboratorRepository, artRepo article.ArticleRepository) CollaboratorService {
+	return &collaboratorService{
+		repo:    repo,
+		artRepo: artRepo,
+	}
+}
+
+func (s *collaboratorService) Authorize(ctx context.Context, uid, artId int64, need domain.ArticleRole) (domain.Article, error) {
+	art, err := s.artRepo.GetById(ctx, artId)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	role, err := s.Role(ctx, uid, art)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if role < need {
+		return domain.Article{}, ErrArticleForbidden
+	}
+	return art, nil
+}
+
+func (s *collaboratorService) Role(ctx context.Context, uid int64, art domain.Article) (domain.ArticleRole, error) {
+	if art.Author.Id == uid {
+		return domain.ArticleRoleOwner, nil
+	}
+	role, err := s.repo.GetRole(ctx, art.Id, uid)
+	if errors.Is(err, ErrCollaboratorNotFound) {
+		return domain.ArticleRoleNone, nil
+	}
+	return role, err
+}
+
+func (s *collaboratorService) Invite(ctx context.Context, uid, artId, collaborator int64, role domain.ArticleRole) error {
+	if collaborator <= 0 || collaborator == uid || !role.Invitable() {
+		return ErrInvalidCollaborator
+	}
+	_, err := s.Authorize(ctx, uid, artId, domain.ArticleRoleOwner)
+	if err != nil {
+		return err
+	}
+	return s.repo.Save(ctx, domain.ArticleCollaborator{
+		ArticleId: artId,
+		Uid:       collaborator,
+		Role:      role,
+	})
+}
+
+func (s *collaboratorService) Remove(ctx context.Context, uid, artId, collaborator int64) error {
+	// 自己退出不需要创作者同意
+	if collaborator != uid {
+		_, err := s.Authorize(ctx, uid, artId, domain.ArticleRoleOwner)
+		if err != nil {
+			return err
+		}
+	}
+	return s.repo.Remove(ctx, artId, collaborator)
+}
+
+func (s *collaboratorService) List(ctx context.Context, uid, artId int64) ([]domain.ArticleCollaborator, error) {
+	_, err := s.Authorize(ctx, uid, artId, domain.ArticleRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByArticle(ctx, artId)
+}
+
+func (s *collaboratorService) ArticleChanged(ctx context.Context, artId int64) error {
+	return s.repo.DelListCache(ctx, artId)
+}
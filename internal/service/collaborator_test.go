@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+)
+
+func TestCollaboratorService_Authorize(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) article.CollaboratorRepository
+		uid     int64
+		need    domain.ArticleRole
+		wantErr error
+	}{
+		{
+			name: "创作者什么都能做",
+			mock: func(ctrl *gomock.Controller) article.CollaboratorRepository {
+				return artrepomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			uid:  123,
+			need: domain.ArticleRoleOwner,
+		},
+		{
+			name: "编辑能编辑",
+			mock: func(ctrl *gomock.Controller) article.CollaboratorRepository {
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetRole(gomock.Any(), int64(1), int64(234)).
+					Return(domain.ArticleRoleEditor, nil)
+				return repo
+			},
+			uid:  234,
+			need: domain.ArticleRoleEditor,
+		},
+		{
+			name: "编辑不能管理协作者",
+			mock: func(ctrl *gomock.Controller) article.CollaboratorRepository {
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetRole(gomock.Any(), int64(1), int64(234)).
+					Return(domain.ArticleRoleEditor, nil)
+				return repo
+			},
+			uid:     234,
+			need:    domain.ArticleRoleOwner,
+			wantErr: ErrArticleForbidden,
+		},
+		{
+			name: "查看者不能编辑",
+			mock: func(ctrl *gomock.Controller) article.CollaboratorRepository {
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetRole(gomock.Any(), int64(1), int64(345)).
+					Return(domain.ArticleRoleViewer, nil)
+				return repo
+			},
+			uid:     345,
+			need:    domain.ArticleRoleEditor,
+			wantErr: ErrArticleForbidden,
+		},
+		{
+			name: "不是协作者什么都看不了",
+			mock: func(ctrl *gomock.Controller) article.CollaboratorRepository {
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetRole(gomock.Any(), int64(1), int64(456)).
+					Return(domain.ArticleRoleNone, ErrCollaboratorNotFound)
+				return repo
+			},
+			uid:     456,
+			need:    domain.ArticleRoleViewer,
+			wantErr: ErrArticleForbidden,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+			artRepo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+				Id:     1,
+				Author: domain.Author{Id: 123},
+			}, nil)
+			svc := NewCollaboratorService(tc.mock(ctrl), artRepo)
+			art, err := svc.Authorize(context.Background(), tc.uid, 1, tc.need)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, int64(123), art.Author.Id)
+			}
+		})
+	}
+}
+
+func TestCollaboratorService_Invite(t *testing.T) {
+	testCases := []struct {
+		name         string
+		mock         func(ctrl *gomock.Controller) (article.CollaboratorRepository, article.ArticleRepository)
+		uid          int64
+		collaborator int64
+		role         domain.ArticleRole
+		wantErr      error
+	}{
+		{
+			name: "邀请编辑",
+			mock: func(ctrl *gomock.Controller) (article.CollaboratorRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().Save(gomock.Any(), domain.ArticleCollaborator{
+					ArticleId: 1,
+					Uid:       234,
+					Role:      domain.ArticleRoleEditor,
+				}).Return(nil)
+				return repo, artRepo
+			},
+			uid:          123,
+			collaborator: 234,
+			role:         domain.ArticleRoleEditor,
+		},
+		{
+			name: "编辑不能邀请别人",
+			mock: func(ctrl *gomock.Controller) (article.CollaboratorRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo := artrepomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetRole(gomock.Any(), int64(1), int64(234)).
+					Return(domain.ArticleRoleEditor, nil)
+				return repo, artRepo
+			},
+			uid:          234,
+			collaborator: 345,
+			role:         domain.ArticleRoleViewer,
+			wantErr:      ErrArticleForbidden,
+		},
+		{
+			name: "不能把别人设置成创作者",
+			mock: func(ctrl *gomock.Controller) (article.CollaboratorRepository, article.ArticleRepository) {
+				return artrepomocks.NewMockCollaboratorRepository(ctrl), artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			uid:          123,
+			collaborator: 234,
+			role:         domain.ArticleRoleOwner,
+			wantErr:      ErrInvalidCollaborator,
+		},
+		{
+			name: "不能邀请自己",
+			mock: func(ctrl *gomock.Controller) (article.CollaboratorRepository, article.ArticleRepository) {
+				return artrepomocks.NewMockCollaboratorRepository(ctrl), artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			uid:          123,
+			collaborator: 123,
+			role:         domain.ArticleRoleEditor,
+			wantErr:      ErrInvalidCollaborator,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCollaboratorService(tc.mock(ctrl))
+			err := svc.Invite(context.Background(), tc.uid, 1, tc.collaborator, tc.role)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
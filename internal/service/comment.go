@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+)
+
+var ErrCommentNotFound = repository.ErrCommentNotFound
+
+// ErrInvalidParent 回复的评论不存在，已经被删除，或者不是同一个资源下的评论
+var ErrInvalidParent = errors.New("回复的评论不合法")
+
+// ErrInvalidTarget 评论的资源不存在，或者读者看不到，目前只能评论已经发表的帖子
+var ErrInvalidTarget = errors.New("评论的资源不合法")
+
+//go:generate mockgen -source=comment.go -package=svcmocks -destination=mock/comment.mock.go CommentService
+type CommentService interface {
+	// AddComment 发表评论，ParentId 不为 0 就是回复
+	AddComment(ctx context.Context, c domain.Comment) (int64, error)
+	// DeleteComment 只能删除自己的评论
+	DeleteComment(ctx context.Context, id int64, uid int64) error
+	// ListComments 根评论按照时间倒序，cursor 是上一页最后一条根评论的 ID
+	ListComments(ctx context.Context, biz string, bizId int64, cursor int64, limit int) ([]domain.Comment, error)
+	// ListReplies 回复按照时间正序，cursor 是上一页最后一条回复的 ID
+	ListReplies(ctx context.Context, rootId int64, cursor int64, limit int) ([]domain.Comment, error)
+}
+
+type commentService struct {
+	repo    repository.CommentRepository
+	artRepo article.ArticleRepository
+	intrSvc intrv1.InteractiveServiceClient
+	l       logger.Logger
+}
+
+func NewCommentService(repo repository.CommentRepository, artRepo article.ArticleRepository,
+	intrSvc intrv1.InteractiveServiceClient, l logger.Logger) CommentService {
+	return &commentService{
+		repo:    repo,
+		artRepo: artRepo,
+		intrSvc: intrSvc,
+		l:       l,
+	}
+}
+
+func (s *commentService) AddComment(ctx context.Context, c domain.Comment) (int64, error) {
+	err := s.checkTarget(ctx, c.Biz, c.BizId)
+	if err != nil {
+		return 0, err
+	}
+	if c.ParentId > 0 {
+		parent, err := s.repo.FindById(ctx, c.ParentId)
+		if errors.Is(err, ErrCommentNotFound) {
+			return 0, ErrInvalidParent
+		}
+		if err != nil {
+			return 0, err
+		}
+		if parent.Deleted || parent.Biz != c.Biz || parent.BizId != c.BizId {
+			return 0, ErrInvalidParent
+		}
+		// 回复的回复，都挂在同一个根评论下面
+		c.RootId = parent.RootId
+		if parent.IsRoot() {
+			c.RootId = parent.Id
+		}
+	} else {
+		c.RootId = 0
+	}
+	id, err := s.repo.AddComment(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	s.incrCommentCnt(ctx, c.Biz, c.BizId, 1)
+	return id, nil
+}
+
+// checkTarget 被评论的帖子要在线上库里面，而且是公开的
+func (s *commentService) checkTarget(ctx context.Context, biz string, bizId int64) error {
+	if biz != "article" {
+		return ErrInvalidTarget
+	}
+	art, err := s.artRepo.GetPublishedById(ctx, bizId)
+	if errors.Is(err, article.ErrRecordNotFound) {
+		return ErrInvalidTarget
+	}
+	if err != nil {
+		return err
+	}
+	if art.Status != domain.ArticleStatusPublished {
+		// 私密的，或者作者停用了账号
+		return ErrInvalidTarget
+	}
+	return nil
+}
+
+func (s *commentService) DeleteComment(ctx context.Context, id int64, uid int64) error {
+	c, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if c.Deleted || c.Commentator.Id != uid {
+		// 不告诉别人这条评论是不是存在
+		return ErrCommentNotFound
+	}
+	err = s.repo.Delete(ctx, c)
+	if err != nil {
+		return err
+	}
+	s.incrCommentCnt(ctx, c.Biz, c.BizId, -1)
+	return nil
+}
+
+func (s *commentService) ListComments(ctx context.Context, biz string, bizId int64, cursor int64, limit int) ([]domain.Comment, error) {
+	return s.repo.FindRoots(ctx, biz, bizId, cursor, limit)
+}
+
+func (s *commentService) ListReplies(ctx context.Context, rootId int64, cursor int64, limit int) ([]domain.Comment, error) {
+	return s.repo.FindReplies(ctx, rootId, cursor, limit)
+}
+
+// incrCommentCnt 评论数只是个展示用的计数，失败了记个日志就可以
+func (s *commentService) incrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err := s.intrSvc.IncrCommentCnt(ctx, &intrv1.IncrCommentCntRequest{
+		Biz:   biz,
+		BizId: bizId,
+		Delta: delta,
+	})
+	if err != nil {
+		s.l.Error("更新评论数失败",
+			logger.String("biz", biz),
+			logger.Int64("bizId", bizId),
+			logger.Int64("delta", delta),
+			logger.Error(err))
+	}
+}
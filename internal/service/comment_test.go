@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	intrv1mocks "webookpro/api/proto/gen/intr/v1/mocks"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestCommentService_AddComment(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient)
+		comment domain.Comment
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name: "根评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusPublished}, nil)
+				repo.EXPECT().AddComment(gomock.Any(), domain.Comment{
+					Biz:     "article",
+					BizId:   1,
+					Content: "评论",
+				}).Return(int64(10), nil)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				intrSvc.EXPECT().IncrCommentCnt(gomock.Any(), &intrv1.IncrCommentCntRequest{
+					Biz:   "article",
+					BizId: 1,
+					Delta: 1,
+				}).Return(&intrv1.IncrCommentCntResponse{}, nil)
+				return repo, artRepo, intrSvc
+			},
+			comment: domain.Comment{
+				Biz:     "article",
+				BizId:   1,
+				Content: "评论",
+			},
+			wantId: 10,
+		},
+		{
+			name: "回复的回复，挂在同一个根评论下面",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusPublished}, nil)
+				repo.EXPECT().FindById(gomock.Any(), int64(3)).Return(domain.Comment{
+					Id:     3,
+					Biz:    "article",
+					BizId:  1,
+					RootId: 2,
+				}, nil)
+				repo.EXPECT().AddComment(gomock.Any(), domain.Comment{
+					Biz:      "article",
+					BizId:    1,
+					Content:  "回复",
+					RootId:   2,
+					ParentId: 3,
+				}).Return(int64(11), nil)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				// 计数失败不影响评论
+				intrSvc.EXPECT().IncrCommentCnt(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock error"))
+				return repo, artRepo, intrSvc
+			},
+			comment: domain.Comment{
+				Biz:      "article",
+				BizId:    1,
+				Content:  "回复",
+				ParentId: 3,
+			},
+			wantId: 11,
+		},
+		{
+			name: "回复其它资源下的评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusPublished}, nil)
+				repo.EXPECT().FindById(gomock.Any(), int64(3)).Return(domain.Comment{
+					Id:    3,
+					Biz:   "article",
+					BizId: 2,
+				}, nil)
+				return repo, artRepo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			comment: domain.Comment{
+				Biz:      "article",
+				BizId:    1,
+				Content:  "回复",
+				ParentId: 3,
+			},
+			wantErr: ErrInvalidParent,
+		},
+		{
+			name: "回复已经删除的评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusPublished}, nil)
+				repo.EXPECT().FindById(gomock.Any(), int64(3)).Return(domain.Comment{
+					Id:      3,
+					Biz:     "article",
+					BizId:   1,
+					Deleted: true,
+				}, nil)
+				return repo, artRepo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			comment: domain.Comment{
+				Biz:      "article",
+				BizId:    1,
+				Content:  "回复",
+				ParentId: 3,
+			},
+			wantErr: ErrInvalidParent,
+		},
+		{
+			name: "评论不存在的帖子",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(100)).
+					Return(domain.Article{}, article.ErrRecordNotFound)
+				return repomocks.NewMockCommentRepository(ctrl), artRepo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			comment: domain.Comment{
+				Biz:     "article",
+				BizId:   100,
+				Content: "评论",
+			},
+			wantErr: ErrInvalidTarget,
+		},
+		{
+			name: "评论作者停用了账号的帖子",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusHidden}, nil)
+				return repomocks.NewMockCommentRepository(ctrl), artRepo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			comment: domain.Comment{
+				Biz:     "article",
+				BizId:   1,
+				Content: "评论",
+			},
+			wantErr: ErrInvalidTarget,
+		},
+		{
+			name: "不支持评论的资源",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, article.ArticleRepository, intrv1.InteractiveServiceClient) {
+				return repomocks.NewMockCommentRepository(ctrl), artrepomocks.NewMockArticleRepository(ctrl),
+					intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			comment: domain.Comment{
+				Biz:     "video",
+				BizId:   1,
+				Content: "评论",
+			},
+			wantErr: ErrInvalidTarget,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo, intrSvc := tc.mock(ctrl)
+			svc := NewCommentService(repo, artRepo, intrSvc, &logger.NopLogger{})
+			id, err := svc.AddComment(context.Background(), tc.comment)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func TestCommentService_DeleteComment(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (repository.CommentRepository, intrv1.InteractiveServiceClient)
+		id      int64
+		uid     int64
+		wantErr error
+	}{
+		{
+			name: "删除成功",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrv1.InteractiveServiceClient) {
+				c := domain.Comment{
+					Id:          1,
+					Biz:         "article",
+					BizId:       2,
+					Commentator: domain.Commentator{Id: 123},
+				}
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(c, nil)
+				repo.EXPECT().Delete(gomock.Any(), c).Return(nil)
+				intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+				intrSvc.EXPECT().IncrCommentCnt(gomock.Any(), &intrv1.IncrCommentCntRequest{
+					Biz:   "article",
+					BizId: 2,
+					Delta: -1,
+				}).Return(&intrv1.IncrCommentCntResponse{}, nil)
+				return repo, intrSvc
+			},
+			id:  1,
+			uid: 123,
+		},
+		{
+			name: "删除别人的评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(domain.Comment{
+					Id:          1,
+					Commentator: domain.Commentator{Id: 234},
+				}, nil)
+				return repo, intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			},
+			id:      1,
+			uid:     123,
+			wantErr: ErrCommentNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intrSvc := tc.mock(ctrl)
+			svc := NewCommentService(repo, nil, intrSvc, &logger.NopLogger{})
+			err := svc.DeleteComment(context.Background(), tc.id, tc.uid)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"webookpro/internal/service/email"
+	"webookpro/pkg/logger"
+)
+
+// maxSent 只记住最近发出去的这么多封邮件
+const maxSent = 100
+
+// Message 发出去的一封邮件
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Service 本地开发和测试用，邮件只是写到日志里面，并且在内存里面记住最近的几封
+type Service struct {
+	l    logger.Logger
+	mu   sync.Mutex
+	sent []Message
+}
+
+func NewService(l logger.Logger) *Service {
+	return &Service{l: l}
+}
+
+var _ email.Service = (*Service)(nil)
+
+func (s *Service) Send(ctx context.Context, to string, subject string, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.sent) >= maxSent {
+		s.sent = append(s.sent[:0], s.sent[1:]...)
+	}
+	s.sent = append(s.sent, Message{To: to, Subject: subject, Body: body})
+	// 本地开发的时候验证码要从日志里面看
+	s.l.Info("发送邮件",
+		logger.String("to", to),
+		logger.String("subject", subject),
+		logger.String("body", body))
+	return nil
+}
+
+// Sent 最近发出去的邮件，最早的在前面
+func (s *Service) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]Message, len(s.sent))
+	copy(res, s.sent)
+	return res
+}
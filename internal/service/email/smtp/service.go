@@ -0,0 +1,62 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+	"webookpro/internal/service/email"
+)
+
+// Service 通过 SMTP 发送纯文本邮件，大部分邮箱服务商都支持
+type Service struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewService addr 是 host:port，from 是发件人的邮箱
+func NewService(addr, username, password, from string) email.Service {
+	host, _, _ := net.SplitHostPort(addr)
+	return &Service{
+		addr: addr,
+		from: from,
+		auth: smtp.PlainAuth("", username, password, host),
+	}
+}
+
+func (s *Service) Send(ctx context.Context, to string, subject string, body string) error {
+	// 邮件头里面不能带换行，不然别人可以往邮件里面塞别的头
+	if strings.ContainsAny(to, "\r\n") {
+		return fmt.Errorf("收件人不合法 %q", to)
+	}
+	msg := s.message(to, subject, body)
+	// net/smtp 不支持 context，超时的话只能等它自己返回
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(s.addr, s.auth, s.from, []string{to}, msg)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Service) message(to, subject, body string) []byte {
+	var sb strings.Builder
+	sb.WriteString("From: " + s.from + "\r\n")
+	sb.WriteString("To: " + to + "\r\n")
+	sb.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	sb.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(sb.String())
+}
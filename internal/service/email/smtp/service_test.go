@@ -0,0 +1,23 @@
+package smtp
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestService_message(t *testing.T) {
+	s := NewService("smtp.example.com:587", "user", "pwd", "noreply@example.com").(*Service)
+	msg := string(s.message("a@example.com", "重置密码", "第一行\n第二行"))
+	assert.True(t, strings.HasPrefix(msg, "From: noreply@example.com\r\nTo: a@example.com\r\n"))
+	assert.Contains(t, msg, "Subject: =?UTF-8?b?6YeN572u5a+G56CB?=\r\n")
+	assert.Contains(t, msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\n第一行\r\n第二行"))
+}
+
+func TestService_SendInvalidRecipient(t *testing.T) {
+	s := NewService("smtp.example.com:587", "user", "pwd", "noreply@example.com")
+	err := s.Send(context.Background(), "a@example.com\r\nBcc: b@example.com", "主题", "内容")
+	assert.Error(t, err)
+}
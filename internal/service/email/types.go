@@ -0,0 +1,8 @@
+package email
+
+import "context"
+
+// Service 发送邮件，和 sms.Service 一样，具体用哪家由 ioc 决定
+type Service interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/feedx"
+	"webookpro/pkg/logger"
+)
+
+const (
+	// feedSize 订阅源里面只放最新的这么多篇
+	feedSize = 20
+	// feedAuthorScan 制作库里面有草稿和参与协作的帖子，多扫一些才凑得够 feedSize 篇
+	feedAuthorScan = 50
+	sitemapBatch   = 500
+)
+
+// FeedConfig 订阅源里面的链接都要指向前端页面，不是接口
+type FeedConfig struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	// Site 站点首页，比如 https://example.com
+	Site string `yaml:"site"`
+	// ArticleURL 帖子页面的格式，%d 是帖子 id
+	ArticleURL string `yaml:"articleURL"`
+	// API 订阅源自己的地址用的是接口的域名，不配置的话和 Site 一样
+	API string `yaml:"api"`
+}
+
+//go:generate mockgen -source=feed.go -package=svcmocks -destination=mock/feed.mock.go FeedService
+type FeedService interface {
+	// RSS author 为 0 的是全站的
+	RSS(ctx context.Context, author int64) ([]byte, error)
+	Atom(ctx context.Context, author int64) ([]byte, error)
+	// Sitemap 全部已发表的帖子，最多 feedx.MaxSitemapURLs 篇
+	Sitemap(ctx context.Context) ([]byte, error)
+}
+
+type feedService struct {
+	repo    article.FeedRepository
+	artRepo article.ArticleRepository
+	cfg     FeedConfig
+	l       logger.Logger
+}
+
+func NewFeedService(repo article.FeedRepository, artRepo article.ArticleRepository,
+	cfg FeedConfig, l logger.Logger) FeedService {
+	if cfg.API == "" {
+		cfg.API = cfg.Site
+	}
+	return &feedService{
+		repo:    repo,
+		artRepo: artRepo,
+		cfg:     cfg,
+		l:       l,
+	}
+}
+
+func (s *feedService) RSS(ctx context.Context, author int64) ([]byte, error) {
+	return s.cached(ctx, domain.FeedKindRSS, author, func() ([]byte, error) {
+		f, err := s.feed(ctx, author, "rss")
+		if err != nil {
+			return nil, err
+		}
+		return feedx.RSS(f)
+	})
+}
+
+func (s *feedService) Atom(ctx context.Context, author int64) ([]byte, error) {
+	return s.cached(ctx, domain.FeedKindAtom, author, func() ([]byte, error) {
+		f, err := s.feed(ctx, author, "atom")
+		if err != nil {
+			return nil, err
+		}
+		return feedx.Atom(f)
+	})
+}
+
+func (s *feedService) Sitemap(ctx context.Context) ([]byte, error) {
+	return s.cached(ctx, domain.FeedKindSitemap, 0, func() ([]byte, error) {
+		urls := make([]feedx.URL, 0, sitemapBatch)
+		var cursor domain.ArticleCursor
+		for len(urls) < feedx.MaxSitemapURLs {
+			arts, err := s.artRepo.ListPubByCursor(ctx, cursor, sitemapBatch)
+			if err != nil {
+				return nil, err
+			}
+			for _, art := range arts {
+				// 撤回了的帖子还留在线上库里面
+				if art.Status == domain.ArticleStatusPublished {
+					urls = append(urls, feedx.URL{Loc: s.articleURL(art.Id), LastMod: art.Utime})
+				}
+			}
+			if len(arts) < sitemapBatch {
+				break
+			}
+			cursor = arts[len(arts)-1].Cursor()
+		}
+		return feedx.Sitemap(urls)
+	})
+}
+
+// cached 先查缓存，没有的话用 build 生成之后回写
+func (s *feedService) cached(ctx context.Context, kind domain.FeedKind, author int64,
+	build func() ([]byte, error)) ([]byte, error) {
+	res, err := s.repo.Get(ctx, kind, author)
+	if err == nil {
+		return res, nil
+	}
+	res, err = build()
+	if err != nil {
+		return nil, err
+	}
+	er := s.repo.Set(ctx, kind, author, res)
+	if er != nil {
+		s.l.Error("回写订阅源缓存失败",
+			logger.String("kind", string(kind)),
+			logger.Int64("author", author),
+			logger.Error(er))
+	}
+	return res, nil
+}
+
+func (s *feedService) feed(ctx context.Context, author int64, path string) (feedx.Feed, error) {
+	var (
+		arts []domain.Article
+		err  error
+	)
+	if author > 0 {
+		arts, err = s.authorArticles(ctx, author)
+	} else {
+		arts, err = s.artRepo.ListPub(ctx, time.Now(), 0, feedSize)
+	}
+	if err != nil {
+		return feedx.Feed{}, err
+	}
+	res := feedx.Feed{
+		Title:       s.cfg.Title,
+		Link:        s.cfg.Site,
+		Description: s.cfg.Description,
+		Self:        fmt.Sprintf("%s/feeds/%s", s.cfg.API, path),
+		Author:      s.cfg.Title,
+		Updated:     time.Now(),
+		Items:       make([]feedx.Item, 0, len(arts)),
+	}
+	if author > 0 {
+		res.Self = fmt.Sprintf("%s/feeds/authors/%d/%s", s.cfg.API, author, path)
+	}
+	for _, art := range arts {
+		if art.Status != domain.ArticleStatusPublished {
+			continue
+		}
+		if art.Author.Name != "" {
+			res.Title = fmt.Sprintf("%s - %s", art.Author.Name, s.cfg.Title)
+			res.Author = art.Author.Name
+		}
+		link := s.articleURL(art.Id)
+		res.Items = append(res.Items, feedx.Item{
+			Id:        link,
+			Title:     art.Title,
+			Link:      link,
+			Summary:   art.Abstract(),
+			Author:    art.Author.Name,
+			Published: art.Ctime,
+			Updated:   art.Utime,
+		})
+	}
+	if len(res.Items) > 0 {
+		// 列表是按照更新时间倒序的，第一篇就是最新的
+		res.Updated = res.Items[0].Updated
+	}
+	return res, nil
+}
+
+// authorArticles 从制作库找到作者自己的帖子，再去线上库拿读者看到的版本
+// 制作库里面的状态在发表之后再编辑会变回未发表，所以不能直接用制作库的状态判断
+func (s *feedService) authorArticles(ctx context.Context, author int64) ([]domain.Article, error) {
+	arts, err := s.artRepo.ListByCursor(ctx, author, domain.ArticleCursor{}, feedAuthorScan)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Article, 0, feedSize)
+	for _, art := range arts {
+		// 参与协作的帖子算原作者的
+		if art.Author.Id != author {
+			continue
+		}
+		pub, err := s.artRepo.GetPublishedById(ctx, art.Id)
+		if errors.Is(err, article.ErrRecordNotFound) {
+			// 从来没有发表过
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if pub.Status != domain.ArticleStatusPublished {
+			continue
+		}
+		res = append(res, pub)
+		if len(res) == feedSize {
+			break
+		}
+	}
+	// 制作库和线上库的更新时间不一样，按照线上库的重新排一下
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Utime.After(res[j].Utime)
+	})
+	return res, nil
+}
+
+func (s *feedService) articleURL(id int64) string {
+	return fmt.Sprintf(s.cfg.ArticleURL, id)
+}
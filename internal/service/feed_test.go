@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	"webookpro/pkg/logger"
+)
+
+var testFeedConfig = FeedConfig{
+	Title:      "webook",
+	Site:       "https://example.com",
+	ArticleURL: "https://example.com/articles/%d",
+	API:        "https://api.example.com",
+}
+
+func TestFeedService_RSS(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mock   func(ctrl *gomock.Controller) (article.FeedRepository, article.ArticleRepository)
+		author int64
+
+		wantContains    []string
+		wantNotContains []string
+		wantErr         error
+	}{
+		{
+			name: "命中缓存",
+			mock: func(ctrl *gomock.Controller) (article.FeedRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockFeedRepository(ctrl)
+				repo.EXPECT().Get(gomock.Any(), domain.FeedKindRSS, int64(0)).
+					Return([]byte("<rss></rss>"), nil)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			wantContains: []string{"<rss></rss>"},
+		},
+		{
+			name: "全站的，撤回了的不要",
+			mock: func(ctrl *gomock.Controller) (article.FeedRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockFeedRepository(ctrl)
+				repo.EXPECT().Get(gomock.Any(), domain.FeedKindRSS, int64(0)).
+					Return(nil, errors.New("没有缓存"))
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, feedSize).
+					Return([]domain.Article{
+						{Id: 1, Title: "第一篇", Content: "内容", Status: domain.ArticleStatusPublished},
+						{Id: 2, Title: "撤回了的", Status: domain.ArticleStatusPrivate},
+					}, nil)
+				repo.EXPECT().Set(gomock.Any(), domain.FeedKindRSS, int64(0), gomock.Any()).Return(nil)
+				return repo, artRepo
+			},
+			wantContains:    []string{"<title>第一篇</title>", "https://example.com/articles/1", "<description>内容</description>"},
+			wantNotContains: []string{"撤回了的"},
+		},
+		{
+			name: "作者的，跳过草稿和参与协作的帖子",
+			mock: func(ctrl *gomock.Controller) (article.FeedRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockFeedRepository(ctrl)
+				repo.EXPECT().Get(gomock.Any(), domain.FeedKindRSS, int64(123)).
+					Return(nil, errors.New("没有缓存"))
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListByCursor(gomock.Any(), int64(123), domain.ArticleCursor{}, feedAuthorScan).
+					Return([]domain.Article{
+						{Id: 1, Author: domain.Author{Id: 123}, Status: domain.ArticleStatusUnpublished},
+						{Id: 2, Author: domain.Author{Id: 234}, Status: domain.ArticleStatusPublished},
+						{Id: 3, Author: domain.Author{Id: 123}, Status: domain.ArticleStatusUnpublished},
+					}, nil)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{}, article.ErrRecordNotFound)
+				// 发表之后又改了，制作库是未发表，线上库还是已发表
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(3)).
+					Return(domain.Article{
+						Id:     3,
+						Title:  "线上的标题",
+						Author: domain.Author{Id: 123, Name: "大明"},
+						Status: domain.ArticleStatusPublished,
+						Utime:  time.UnixMilli(100),
+					}, nil)
+				repo.EXPECT().Set(gomock.Any(), domain.FeedKindRSS, int64(123), gomock.Any()).Return(nil)
+				return repo, artRepo
+			},
+			author:       123,
+			wantContains: []string{"<title>大明 - webook</title>", "<title>线上的标题</title>", "<dc:creator>大明</dc:creator>"},
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (article.FeedRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockFeedRepository(ctrl)
+				repo.EXPECT().Get(gomock.Any(), domain.FeedKindRSS, int64(0)).
+					Return(nil, errors.New("没有缓存"))
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, feedSize).
+					Return(nil, errors.New("mock db error"))
+				return repo, artRepo
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo := tc.mock(ctrl)
+			svc := NewFeedService(repo, artRepo, testFeedConfig, &logger.NopLogger{})
+			res, err := svc.RSS(context.Background(), tc.author)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			for _, s := range tc.wantContains {
+				assert.Contains(t, string(res), s)
+			}
+			for _, s := range tc.wantNotContains {
+				assert.NotContains(t, string(res), s)
+			}
+		})
+	}
+}
+
+func TestFeedService_Atom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockFeedRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), domain.FeedKindAtom, int64(0)).
+		Return(nil, errors.New("没有缓存"))
+	repo.EXPECT().Set(gomock.Any(), domain.FeedKindAtom, int64(0), gomock.Any()).Return(nil)
+	artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+	artRepo.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, feedSize).Return(nil, nil)
+	svc := NewFeedService(repo, artRepo, testFeedConfig, &logger.NopLogger{})
+	res, err := svc.Atom(context.Background(), 0)
+	require.NoError(t, err)
+	// 订阅源自己的地址用的是接口的域名
+	assert.Contains(t, string(res), `<link href="https://api.example.com/feeds/atom" rel="self"></link>`)
+}
+
+func TestFeedService_Sitemap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockFeedRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), domain.FeedKindSitemap, int64(0)).
+		Return(nil, errors.New("没有缓存"))
+	repo.EXPECT().Set(gomock.Any(), domain.FeedKindSitemap, int64(0), gomock.Any()).Return(nil)
+	artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+	artRepo.EXPECT().ListPubByCursor(gomock.Any(), domain.ArticleCursor{}, sitemapBatch).
+		Return([]domain.Article{
+			{Id: 1, Status: domain.ArticleStatusPublished, Utime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+			{Id: 2, Status: domain.ArticleStatusPrivate},
+		}, nil)
+	svc := NewFeedService(repo, artRepo, testFeedConfig, &logger.NopLogger{})
+	res, err := svc.Sitemap(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, string(res), "<loc>https://example.com/articles/1</loc>")
+	assert.Contains(t, string(res), "<lastmod>2024-01-02T03:04:05Z</lastmod>")
+	assert.NotContains(t, string(res), "https://example.com/articles/2")
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"golang.org/x/sync/errgroup"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+)
+
+//go:generate mockgen -source=history.go -package=svcmocks -destination=mock/history.mock.go HistoryService
+type HistoryService interface {
+	// ListRecords 按照阅读时间倒序，顺便带上帖子的标题
+	ListRecords(ctx context.Context, uid int64, offset, limit int) ([]domain.HistoryRecord, error)
+	// ClearRecords 清空自己的阅读记录
+	ClearRecords(ctx context.Context, uid int64) error
+}
+
+type historyService struct {
+	repo    repository.HistoryRecordRepository
+	artRepo article.ArticleRepository
+	l       logger.Logger
+}
+
+func NewHistoryService(repo repository.HistoryRecordRepository,
+	artRepo article.ArticleRepository, l logger.Logger) HistoryService {
+	return &historyService{
+		repo:    repo,
+		artRepo: artRepo,
+		l:       l,
+	}
+}
+
+func (s *historyService) ListRecords(ctx context.Context, uid int64, offset, limit int) ([]domain.HistoryRecord, error) {
+	rs, err := s.repo.ListRecords(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	var eg errgroup.Group
+	for i := range rs {
+		r := &rs[i]
+		eg.Go(func() error {
+			art, er := s.artRepo.GetPublishedById(ctx, r.BizId)
+			if er != nil {
+				// 查不到标题不影响别的记录
+				s.l.Error("查询阅读记录的帖子失败",
+					logger.Int64("aid", r.BizId),
+					logger.Error(er))
+				return nil
+			}
+			if art.Status == domain.ArticleStatusPublished {
+				r.Title = art.Title
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	return rs, nil
+}
+
+func (s *historyService) ClearRecords(ctx context.Context, uid int64) error {
+	return s.repo.ClearRecords(ctx, uid)
+}
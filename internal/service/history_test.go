@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestHistoryService_ListRecords(t *testing.T) {
+	readTime := time.UnixMilli(1700000000000)
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (repository.HistoryRecordRepository, article.ArticleRepository)
+		want    []domain.HistoryRecord
+		wantErr error
+	}{
+		{
+			name: "带上帖子标题，撤回了的和查不到的没有标题",
+			mock: func(ctrl *gomock.Controller) (repository.HistoryRecordRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockHistoryRecordRepository(ctrl)
+				repo.EXPECT().ListRecords(gomock.Any(), int64(123), 0, 10).
+					Return([]domain.HistoryRecord{
+						{Uid: 123, Biz: "article", BizId: 1, ReadTime: readTime},
+						{Uid: 123, Biz: "article", BizId: 2, ReadTime: readTime},
+						{Uid: 123, Biz: "article", BizId: 3, ReadTime: readTime},
+					}, nil)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Title: "标题1", Status: domain.ArticleStatusPublished}, nil)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).
+					Return(domain.Article{Id: 2, Title: "标题2", Status: domain.ArticleStatusPrivate}, nil)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(3)).
+					Return(domain.Article{}, errors.New("mock error"))
+				return repo, artRepo
+			},
+			want: []domain.HistoryRecord{
+				{Uid: 123, Biz: "article", BizId: 1, ReadTime: readTime, Title: "标题1"},
+				{Uid: 123, Biz: "article", BizId: 2, ReadTime: readTime},
+				{Uid: 123, Biz: "article", BizId: 3, ReadTime: readTime},
+			},
+		},
+		{
+			name: "查询阅读记录失败",
+			mock: func(ctrl *gomock.Controller) (repository.HistoryRecordRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockHistoryRecordRepository(ctrl)
+				repo.EXPECT().ListRecords(gomock.Any(), int64(123), 0, 10).
+					Return(nil, errors.New("mock error"))
+				return repo, artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			wantErr: errors.New("mock error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo := tc.mock(ctrl)
+			svc := NewHistoryService(repo, artRepo, &logger.NopLogger{})
+			rs, err := svc.ListRecords(context.Background(), 123, 0, 10)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, rs)
+		})
+	}
+}
@@ -13,6 +13,8 @@ type JobService interface {
 	Preempt(ctx context.Context) (domain.Job, error)
 	// ResetNextTime
 	ResetNextTime(ctx context.Context, j domain.Job) error
+	// AddJob 注册一个任务，已经存在的话就忽略
+	AddJob(ctx context.Context, j domain.Job) error
 }
 
 type CronJobService struct {
@@ -49,6 +51,10 @@ func (c *CronJobService) Preempt(ctx context.Context) (domain.Job, error) {
 	return job, err
 }
 
+func (c *CronJobService) AddJob(ctx context.Context, j domain.Job) error {
+	return c.repo.AddJob(ctx, j)
+}
+
 // Refresh 刷新job的 utime
 func (c *CronJobService) Refresh(ctx context.Context, id int64) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_merge.go
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountMergeService is a mock of AccountMergeService interface.
+type MockAccountMergeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountMergeServiceMockRecorder
+}
+
+// MockAccountMergeServiceMockRecorder is the mock recorder for MockAccountMergeService.
+type MockAccountMergeServiceMockRecorder struct {
+	mock *MockAccountMergeService
+}
+
+// NewMockAccountMergeService creates a new mock instance.
+func NewMockAccountMergeService(ctrl *gomock.Controller) *MockAccountMergeService {
+	mock := &MockAccountMergeService{ctrl: ctrl}
+	mock.recorder = &MockAccountMergeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountMergeService) EXPECT() *MockAccountMergeServiceMockRecorder {
+	return m.recorder
+}
+
+// Merge mocks base method.
+func (m *MockAccountMergeService) Merge(ctx context.Context, primary int64, ticket string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, primary, ticket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockAccountMergeServiceMockRecorder) Merge(ctx, primary, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockAccountMergeService)(nil).Merge), ctx, primary, ticket)
+}
+
+// Resume mocks base method.
+func (m *MockAccountMergeService) Resume(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockAccountMergeServiceMockRecorder) Resume(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockAccountMergeService)(nil).Resume), ctx)
+}
+
+// Ticket mocks base method.
+func (m *MockAccountMergeService) Ticket(ctx context.Context, uid int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ticket", ctx, uid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ticket indicates an expected call of Ticket.
+func (mr *MockAccountMergeServiceMockRecorder) Ticket(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ticket", reflect.TypeOf((*MockAccountMergeService)(nil).Ticket), ctx, uid)
+}
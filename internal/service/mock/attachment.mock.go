@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachment.go
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentService is a mock of AttachmentService interface.
+type MockAttachmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentServiceMockRecorder
+}
+
+// MockAttachmentServiceMockRecorder is the mock recorder for MockAttachmentService.
+type MockAttachmentServiceMockRecorder struct {
+	mock *MockAttachmentService
+}
+
+// NewMockAttachmentService creates a new mock instance.
+func NewMockAttachmentService(ctrl *gomock.Controller) *MockAttachmentService {
+	mock := &MockAttachmentService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentService) EXPECT() *MockAttachmentServiceMockRecorder {
+	return m.recorder
+}
+
+// BindArticle mocks base method.
+func (m *MockAttachmentService) BindArticle(ctx context.Context, uid, artId int64, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindArticle", ctx, uid, artId, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindArticle indicates an expected call of BindArticle.
+func (mr *MockAttachmentServiceMockRecorder) BindArticle(ctx, uid, artId, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindArticle", reflect.TypeOf((*MockAttachmentService)(nil).BindArticle), ctx, uid, artId, content)
+}
+
+// CleanOrphans mocks base method.
+func (m *MockAttachmentService) CleanOrphans(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanOrphans", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanOrphans indicates an expected call of CleanOrphans.
+func (mr *MockAttachmentServiceMockRecorder) CleanOrphans(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanOrphans", reflect.TypeOf((*MockAttachmentService)(nil).CleanOrphans), ctx)
+}
+
+// Get mocks base method.
+func (m *MockAttachmentService) Get(ctx context.Context, key string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAttachmentServiceMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttachmentService)(nil).Get), ctx, key)
+}
+
+// SyncArticle mocks base method.
+func (m *MockAttachmentService) SyncArticle(ctx context.Context, uid, artId int64, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncArticle", ctx, uid, artId, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncArticle indicates an expected call of SyncArticle.
+func (mr *MockAttachmentServiceMockRecorder) SyncArticle(ctx, uid, artId, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncArticle", reflect.TypeOf((*MockAttachmentService)(nil).SyncArticle), ctx, uid, artId, content)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentService) Upload(ctx context.Context, uid int64, data []byte) (domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, uid, data)
+	ret0, _ := ret[0].(domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentServiceMockRecorder) Upload(ctx, uid, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentService)(nil).Upload), ctx, uid, data)
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: binding.go
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBindingService is a mock of BindingService interface.
+type MockBindingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBindingServiceMockRecorder
+}
+
+// MockBindingServiceMockRecorder is the mock recorder for MockBindingService.
+type MockBindingServiceMockRecorder struct {
+	mock *MockBindingService
+}
+
+// NewMockBindingService creates a new mock instance.
+func NewMockBindingService(ctrl *gomock.Controller) *MockBindingService {
+	mock := &MockBindingService{ctrl: ctrl}
+	mock.recorder = &MockBindingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBindingService) EXPECT() *MockBindingServiceMockRecorder {
+	return m.recorder
+}
+
+// BindEmail mocks base method.
+func (m *MockBindingService) BindEmail(ctx context.Context, uid int64, email, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindEmail", ctx, uid, email, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindEmail indicates an expected call of BindEmail.
+func (mr *MockBindingServiceMockRecorder) BindEmail(ctx, uid, email, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindEmail", reflect.TypeOf((*MockBindingService)(nil).BindEmail), ctx, uid, email, code)
+}
+
+// BindPhone mocks base method.
+func (m *MockBindingService) BindPhone(ctx context.Context, uid int64, phone, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindPhone", ctx, uid, phone, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindPhone indicates an expected call of BindPhone.
+func (mr *MockBindingServiceMockRecorder) BindPhone(ctx, uid, phone, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindPhone", reflect.TypeOf((*MockBindingService)(nil).BindPhone), ctx, uid, phone, code)
+}
+
+// BindWechat mocks base method.
+func (m *MockBindingService) BindWechat(ctx context.Context, uid int64, info domain.WeChatInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindWechat", ctx, uid, info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindWechat indicates an expected call of BindWechat.
+func (mr *MockBindingServiceMockRecorder) BindWechat(ctx, uid, info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindWechat", reflect.TypeOf((*MockBindingService)(nil).BindWechat), ctx, uid, info)
+}
+
+// SendEmailCode mocks base method.
+func (m *MockBindingService) SendEmailCode(ctx context.Context, uid int64, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailCode", ctx, uid, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailCode indicates an expected call of SendEmailCode.
+func (mr *MockBindingServiceMockRecorder) SendEmailCode(ctx, uid, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailCode", reflect.TypeOf((*MockBindingService)(nil).SendEmailCode), ctx, uid, email)
+}
+
+// SendPhoneCode mocks base method.
+func (m *MockBindingService) SendPhoneCode(ctx context.Context, uid int64, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPhoneCode", ctx, uid, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPhoneCode indicates an expected call of SendPhoneCode.
+func (mr *MockBindingServiceMockRecorder) SendPhoneCode(ctx, uid, phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPhoneCode", reflect.TypeOf((*MockBindingService)(nil).SendPhoneCode), ctx, uid, phone)
+}
+
+// Unbind mocks base method.
+func (m *MockBindingService) Unbind(ctx context.Context, uid int64, method domain.LoginMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbind", ctx, uid, method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unbind indicates an expected call of Unbind.
+func (mr *MockBindingServiceMockRecorder) Unbind(ctx, uid, method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbind", reflect.TypeOf((*MockBindingService)(nil).Unbind), ctx, uid, method)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share.go
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webookpro/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockShareService is a mock of ShareService interface.
+type MockShareService struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareServiceMockRecorder
+}
+
+// MockShareServiceMockRecorder is the mock recorder for MockShareService.
+type MockShareServiceMockRecorder struct {
+	mock *MockShareService
+}
+
+// NewMockShareService creates a new mock instance.
+func NewMockShareService(ctrl *gomock.Controller) *MockShareService {
+	mock := &MockShareService{ctrl: ctrl}
+	mock.recorder = &MockShareServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareService) EXPECT() *MockShareServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareService) Create(ctx context.Context, uid, artId int64, ttl time.Duration, maxViews int64) (domain.ArticleShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, uid, artId, ttl, maxViews)
+	ret0, _ := ret[0].(domain.ArticleShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareServiceMockRecorder) Create(ctx, uid, artId, ttl, maxViews any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareService)(nil).Create), ctx, uid, artId, ttl, maxViews)
+}
+
+// List mocks base method.
+func (m *MockShareService) List(ctx context.Context, uid, artId int64) ([]domain.ArticleShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, uid, artId)
+	ret0, _ := ret[0].([]domain.ArticleShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockShareServiceMockRecorder) List(ctx, uid, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockShareService)(nil).List), ctx, uid, artId)
+}
+
+// Revoke mocks base method.
+func (m *MockShareService) Revoke(ctx context.Context, uid, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockShareServiceMockRecorder) Revoke(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockShareService)(nil).Revoke), ctx, uid, id)
+}
+
+// View mocks base method.
+func (m *MockShareService) View(ctx context.Context, id int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", ctx, id)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// View indicates an expected call of View.
+func (mr *MockShareServiceMockRecorder) View(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockShareService)(nil).View), ctx, id)
+}
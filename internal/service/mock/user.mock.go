@@ -1,5 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: F:\GeekGoProjects\src\webookpro\internal\service\user.go
+// Source: user.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user.go -package=svcmocks -destination=mock/user.mock.go
+//
 
 // Package svcmocks is a generated GoMock package.
 package svcmocks
@@ -46,7 +51,7 @@ func (m *MockUserService) FindOrCreate(ctx *gin.Context, phone string) (domain.U
 }
 
 // FindOrCreate indicates an expected call of FindOrCreate.
-func (mr *MockUserServiceMockRecorder) FindOrCreate(ctx, phone interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) FindOrCreate(ctx, phone any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreate", reflect.TypeOf((*MockUserService)(nil).FindOrCreate), ctx, phone)
 }
@@ -61,7 +66,7 @@ func (m *MockUserService) FindOrCreateByWechat(ctx context.Context, wechatInfo d
 }
 
 // FindOrCreateByWechat indicates an expected call of FindOrCreateByWechat.
-func (mr *MockUserServiceMockRecorder) FindOrCreateByWechat(ctx, wechatInfo interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) FindOrCreateByWechat(ctx, wechatInfo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateByWechat", reflect.TypeOf((*MockUserService)(nil).FindOrCreateByWechat), ctx, wechatInfo)
 }
@@ -76,7 +81,7 @@ func (m *MockUserService) Login(ctx context.Context, email, password string) (do
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockUserServiceMockRecorder) Login(ctx, email, password interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) Login(ctx, email, password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserService)(nil).Login), ctx, email, password)
 }
@@ -91,7 +96,7 @@ func (m *MockUserService) Profile(ctx context.Context, id int64) (domain.User, e
 }
 
 // Profile indicates an expected call of Profile.
-func (mr *MockUserServiceMockRecorder) Profile(ctx, id interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) Profile(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Profile", reflect.TypeOf((*MockUserService)(nil).Profile), ctx, id)
 }
@@ -105,7 +110,21 @@ func (m *MockUserService) SignUp(ctx context.Context, user domain.User) error {
 }
 
 // SignUp indicates an expected call of SignUp.
-func (mr *MockUserServiceMockRecorder) SignUp(ctx, user interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) SignUp(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockUserService)(nil).SignUp), ctx, user)
 }
+
+// UpdateProfile mocks base method.
+func (m *MockUserService) UpdateProfile(ctx context.Context, user domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserServiceMockRecorder) UpdateProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserService)(nil).UpdateProfile), ctx, user)
+}
@@ -0,0 +1,50 @@
+package chain
+
+import (
+	"context"
+	"webookpro/internal/domain"
+	"webookpro/internal/service/moderation"
+	"webookpro/pkg/logger"
+)
+
+// Checker 按顺序执行多个审核，有一个拦下来就不再往后执行了
+// 要人工审核的原因会累积起来，一起给管理员看
+type Checker struct {
+	checkers []moderation.Checker
+	l        logger.Logger
+}
+
+func NewChecker(l logger.Logger, checkers ...moderation.Checker) moderation.Checker {
+	return &Checker{
+		checkers: checkers,
+		l:        l,
+	}
+}
+
+func (c *Checker) Check(ctx context.Context, art domain.Article) (domain.ModerationResult, error) {
+	res := domain.ModerationResult{Verdict: domain.ModerationPass}
+	for _, checker := range c.checkers {
+		r, err := checker.Check(ctx, art)
+		if err != nil {
+			// 第三方的审核服务挂了不能拦着不让发表，也不能直接放过，转人工
+			c.l.Error("内容审核失败",
+				logger.Int64("art_id", art.Id),
+				logger.Error(err))
+			r = domain.ModerationResult{
+				Verdict: domain.ModerationReview,
+				Reasons: []string{"自动审核失败，需要人工审核"},
+			}
+		}
+		if r.Verdict == domain.ModerationPass {
+			continue
+		}
+		if r.Verdict > res.Verdict {
+			res.Verdict = r.Verdict
+		}
+		res.Reasons = append(res.Reasons, r.Reasons...)
+		if res.Verdict == domain.ModerationBlock {
+			return res, nil
+		}
+	}
+	return res, nil
+}
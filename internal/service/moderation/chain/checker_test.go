@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/service/moderation"
+	"webookpro/internal/service/moderation/memory"
+	"webookpro/pkg/logger"
+)
+
+func TestChecker_Check(t *testing.T) {
+	testCases := []struct {
+		name    string
+		results []domain.ModerationResult
+		errs    []error
+		want    domain.ModerationResult
+	}{
+		{
+			name:    "全部通过",
+			results: []domain.ModerationResult{{}, {}},
+			errs:    []error{nil, nil},
+			want:    domain.ModerationResult{Verdict: domain.ModerationPass},
+		},
+		{
+			name: "人工审核的原因累积起来",
+			results: []domain.ModerationResult{
+				{Verdict: domain.ModerationReview, Reasons: []string{"原因一"}},
+				{},
+				{Verdict: domain.ModerationReview, Reasons: []string{"原因二"}},
+			},
+			errs: []error{nil, nil, nil},
+			want: domain.ModerationResult{
+				Verdict: domain.ModerationReview,
+				Reasons: []string{"原因一", "原因二"},
+			},
+		},
+		{
+			name: "拦下来之后不再往后执行",
+			results: []domain.ModerationResult{
+				{Verdict: domain.ModerationReview, Reasons: []string{"原因一"}},
+				{Verdict: domain.ModerationBlock, Reasons: []string{"违规"}},
+				{Verdict: domain.ModerationReview, Reasons: []string{"原因二"}},
+			},
+			errs: []error{nil, nil, nil},
+			want: domain.ModerationResult{
+				Verdict: domain.ModerationBlock,
+				Reasons: []string{"原因一", "违规"},
+			},
+		},
+		{
+			name:    "出错了转人工",
+			results: []domain.ModerationResult{{}},
+			errs:    []error{errors.New("mock 超时")},
+			want: domain.ModerationResult{
+				Verdict: domain.ModerationReview,
+				Reasons: []string{"自动审核失败，需要人工审核"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkers := make([]moderation.Checker, 0, len(tc.results))
+			for i, r := range tc.results {
+				c := memory.NewChecker()
+				c.SetResult(r, tc.errs[i])
+				checkers = append(checkers, c)
+			}
+			res, err := NewChecker(&logger.NopLogger{}, checkers...).
+				Check(context.Background(), domain.Article{Id: 1})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package links
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"webookpro/internal/domain"
+	"webookpro/internal/service/moderation"
+)
+
+// urlPattern Markdown 里面的链接、图片和直接写的网址都能匹配到
+var urlPattern = regexp.MustCompile(`(?i)https?://[^\s<>"'()\[\]]+`)
+
+// Checker 链接黑名单，屏蔽一个域名的同时也屏蔽了它的子域名
+type Checker struct {
+	domains map[string]struct{}
+	verdict domain.ModerationVerdict
+}
+
+func NewChecker(verdict domain.ModerationVerdict, domains []string) moderation.Checker {
+	m := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		d = strings.Trim(strings.ToLower(strings.TrimSpace(d)), ".")
+		if d != "" {
+			m[d] = struct{}{}
+		}
+	}
+	return &Checker{
+		domains: m,
+		verdict: verdict,
+	}
+}
+
+func (c *Checker) Check(ctx context.Context, art domain.Article) (domain.ModerationResult, error) {
+	var hosts []string
+	seen := make(map[string]struct{})
+	for _, raw := range urlPattern.FindAllString(art.Content, -1) {
+		u, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+		host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+		if _, ok := seen[host]; ok || !c.blocked(host) {
+			continue
+		}
+		seen[host] = struct{}{}
+		hosts = append(hosts, host)
+	}
+	if len(hosts) == 0 {
+		return domain.ModerationResult{Verdict: domain.ModerationPass}, nil
+	}
+	return domain.ModerationResult{
+		Verdict: c.verdict,
+		Reasons: []string{fmt.Sprintf("包含被屏蔽的链接：%s", strings.Join(hosts, "、"))},
+	}, nil
+}
+
+// blocked 从 a.b.example.com 开始，依次检查 b.example.com、example.com、com
+func (c *Checker) blocked(host string) bool {
+	for host != "" {
+		if _, ok := c.domains[host]; ok {
+			return true
+		}
+		idx := strings.IndexByte(host, '.')
+		if idx < 0 {
+			return false
+		}
+		host = host[idx+1:]
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package links
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/internal/domain"
+)
+
+func TestChecker_Check(t *testing.T) {
+	c := NewChecker(domain.ModerationBlock, []string{"bad.com", " Evil.org. "})
+	testCases := []struct {
+		name    string
+		content string
+		want    domain.ModerationResult
+	}{
+		{
+			name:    "没有链接",
+			content: "正常的内容",
+			want:    domain.ModerationResult{Verdict: domain.ModerationPass},
+		},
+		{
+			name:    "正常的链接",
+			content: "[官网](https://example.com/bad.com) 以及 https://notbad.com",
+			want:    domain.ModerationResult{Verdict: domain.ModerationPass},
+		},
+		{
+			name:    "子域名、图片和端口",
+			content: "![图](http://img.BAD.com:8080/a.png) https://bad.com/x https://evil.org",
+			want: domain.ModerationResult{
+				Verdict: domain.ModerationBlock,
+				Reasons: []string{"包含被屏蔽的链接：img.bad.com、bad.com、evil.org"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := c.Check(context.Background(), domain.Article{Content: tc.content})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"webookpro/internal/domain"
+)
+
+// Checker 第三方审核服务的替身，本地开发和测试用
+// 默认全部通过，可以用 SetResult 指定返回的结果
+type Checker struct {
+	mu  sync.RWMutex
+	res domain.ModerationResult
+	err error
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		res: domain.ModerationResult{Verdict: domain.ModerationPass},
+	}
+}
+
+func (c *Checker) SetResult(res domain.ModerationResult, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.res, c.err = res, err
+}
+
+func (c *Checker) Check(ctx context.Context, art domain.Article) (domain.ModerationResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.res, c.err
+}
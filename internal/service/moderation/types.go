@@ -0,0 +1,12 @@
+package moderation
+
+import (
+	"context"
+	"webookpro/internal/domain"
+)
+
+// Checker 发表之前的内容审核，敏感词、链接黑名单、第三方的审核服务都实现这个接口
+// 返回 error 的时候调用者不能当成通过
+type Checker interface {
+	Check(ctx context.Context, art domain.Article) (domain.ModerationResult, error)
+}
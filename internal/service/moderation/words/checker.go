@@ -0,0 +1,34 @@
+package words
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"webookpro/internal/domain"
+	"webookpro/internal/service/moderation"
+)
+
+// Checker 标题和内容里面出现了敏感词，就给出 verdict
+// 一般拦截和转人工各用一个 Checker，用不同的词库
+type Checker struct {
+	trie    *Trie
+	verdict domain.ModerationVerdict
+}
+
+func NewChecker(verdict domain.ModerationVerdict, words []string) moderation.Checker {
+	return &Checker{
+		trie:    NewTrie(words...),
+		verdict: verdict,
+	}
+}
+
+func (c *Checker) Check(ctx context.Context, art domain.Article) (domain.ModerationResult, error) {
+	found := c.trie.FindAll(art.Title + "\n" + art.Content)
+	if len(found) == 0 {
+		return domain.ModerationResult{Verdict: domain.ModerationPass}, nil
+	}
+	return domain.ModerationResult{
+		Verdict: c.verdict,
+		Reasons: []string{fmt.Sprintf("包含敏感词：%s", strings.Join(found, "、"))},
+	}, nil
+}
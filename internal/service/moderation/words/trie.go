@@ -0,0 +1,68 @@
+package words
+
+import "unicode"
+
+// Trie 敏感词前缀树，匹配的时候不区分大小写
+type Trie struct {
+	root *node
+}
+
+type node struct {
+	children map[rune]*node
+	// word 非空说明从根到这里是一个完整的敏感词
+	word string
+}
+
+func NewTrie(words ...string) *Trie {
+	t := &Trie{root: &node{}}
+	for _, w := range words {
+		t.Add(w)
+	}
+	return t
+}
+
+// Add 空字符串会被忽略
+func (t *Trie) Add(word string) {
+	if word == "" {
+		return
+	}
+	cur := t.root
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		if cur.children == nil {
+			cur.children = make(map[rune]*node)
+		}
+		next, ok := cur.children[r]
+		if !ok {
+			next = &node{}
+			cur.children[r] = next
+		}
+		cur = next
+	}
+	cur.word = word
+}
+
+// FindAll 返回 text 里面出现的敏感词，按照第一次出现的顺序，不重复
+func (t *Trie) FindAll(text string) []string {
+	runes := []rune(text)
+	var res []string
+	seen := make(map[string]struct{})
+	for i := range runes {
+		cur := t.root
+		for j := i; j < len(runes); j++ {
+			next, ok := cur.children[unicode.ToLower(runes[j])]
+			if !ok {
+				break
+			}
+			cur = next
+			if cur.word == "" {
+				continue
+			}
+			if _, ok := seen[cur.word]; !ok {
+				seen[cur.word] = struct{}{}
+				res = append(res, cur.word)
+			}
+		}
+	}
+	return res
+}
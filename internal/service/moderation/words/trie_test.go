@@ -0,0 +1,52 @@
+package words
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/internal/domain"
+)
+
+func TestTrie_FindAll(t *testing.T) {
+	trie := NewTrie("赌博", "赌", "Spam", "")
+	testCases := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "没有敏感词",
+			text: "正常的内容",
+		},
+		{
+			name: "短的和长的都要",
+			text: "网上赌博",
+			want: []string{"赌", "赌博"},
+		},
+		{
+			name: "不区分大小写，不重复",
+			text: "SPAM spam",
+			want: []string{"Spam"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, trie.FindAll(tc.text))
+		})
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	c := NewChecker(domain.ModerationBlock, []string{"赌博", "代开发票"})
+	res, err := c.Check(context.Background(), domain.Article{Title: "代开发票", Content: "正常的内容"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModerationResult{
+		Verdict: domain.ModerationBlock,
+		Reasons: []string{"包含敏感词：代开发票"},
+	}, res)
+
+	res, err = c.Check(context.Background(), domain.Article{Title: "标题", Content: "正常的内容"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModerationPass, res.Verdict)
+}
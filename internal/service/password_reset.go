@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"math/rand"
+	"webookpro/internal/repository"
+	"webookpro/internal/service/email"
+	"webookpro/pkg/logger"
+)
+
+// resetPasswordBiz 和登录的验证码共用一套 Lua 脚本，biz 不同 key 就不同
+const resetPasswordBiz = "reset_password"
+
+var (
+	ErrCodeSendTooMany        = repository.ErrCodeSendTooMany
+	ErrCodeVerifyTooManyTimes = repository.ErrCodeVerifyTooManyTimes
+	// ErrInvalidResetCode 验证码不对
+	ErrInvalidResetCode = errors.New("验证码不对")
+	// ErrInvalidResetToken 重置密码的凭证过期了或者已经用过了，要重新走一遍验证码
+	ErrInvalidResetToken = errors.New("重置密码的凭证无效")
+)
+
+//go:generate mockgen -source=password_reset.go -package=svcmocks -destination=mock/password_reset.mock.go PasswordResetService
+type PasswordResetService interface {
+	// SendCode 往邮箱发验证码，邮箱没有注册过也返回成功，免得被用来探测哪些邮箱注册过
+	SendCode(ctx context.Context, email string) error
+	// VerifyCode 验证码对了就返回一个一次性的凭证，十分钟内有效
+	VerifyCode(ctx context.Context, email, code string) (string, error)
+	// Reset 用凭证设置新密码，返回用户 id
+	Reset(ctx context.Context, token, password string) (int64, error)
+}
+
+type passwordResetService struct {
+	userRepo  repository.UserRepository
+	codeRepo  repository.CodeRepository
+	resetRepo repository.PasswordResetRepository
+	email     email.Service
+	l         logger.Logger
+}
+
+func NewPasswordResetService(userRepo repository.UserRepository,
+	codeRepo repository.CodeRepository,
+	resetRepo repository.PasswordResetRepository,
+	email email.Service,
+	l logger.Logger) PasswordResetService {
+	return &passwordResetService{
+		userRepo:  userRepo,
+		codeRepo:  codeRepo,
+		resetRepo: resetRepo,
+		email:     email,
+		l:         l,
+	}
+}
+
+func (s *passwordResetService) SendCode(ctx context.Context, email string) error {
+	_, err := s.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.l.Info("重置密码的邮箱没有注册过", logger.String("email", email))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	code := s.generateCode()
+	err = s.codeRepo.Store(ctx, resetPasswordBiz, email, code)
+	if err != nil {
+		return err
+	}
+	return s.email.Send(ctx, email, "webook 重置密码",
+		fmt.Sprintf("你的验证码是 %s，10 分钟内有效。\n如果不是你本人操作，请忽略这封邮件。", code))
+}
+
+func (s *passwordResetService) VerifyCode(ctx context.Context, email, code string) (string, error) {
+	ok, err := s.codeRepo.Verfiy(ctx, resetPasswordBiz, email, code)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrInvalidResetCode
+	}
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	token := uuid.New().String()
+	err = s.resetRepo.StoreToken(ctx, token, user.Id)
+	return token, err
+}
+
+func (s *passwordResetService) Reset(ctx context.Context, token, password string) (int64, error) {
+	uid, err := s.resetRepo.ConsumeToken(ctx, token)
+	if errors.Is(err, repository.ErrResetTokenNotFound) {
+		return 0, ErrInvalidResetToken
+	}
+	if err != nil {
+		return 0, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, err
+	}
+	return uid, s.userRepo.UpdatePassword(ctx, uid, string(hash))
+}
+
+func (s *passwordResetService) generateCode() string {
+	return fmt.Sprintf("%06d", rand.Intn(1000000))
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/internal/service/email/memory"
+	"webookpro/pkg/logger"
+)
+
+func TestPasswordResetService_SendCode(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository)
+
+		wantSent int
+		wantErr  error
+	}{
+		{
+			name: "发送成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{Id: 1, Email: "123@qq.com"}, nil)
+				codeRepo.EXPECT().Store(gomock.Any(), resetPasswordBiz, "123@qq.com", gomock.Any()).Return(nil)
+				return userRepo, codeRepo
+			},
+			wantSent: 1,
+		},
+		{
+			name: "邮箱没有注册过，假装发送成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{}, gorm.ErrRecordNotFound)
+				return userRepo, codeRepo
+			},
+		},
+		{
+			name: "发送太频繁",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.CodeRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{Id: 1, Email: "123@qq.com"}, nil)
+				codeRepo.EXPECT().Store(gomock.Any(), resetPasswordBiz, "123@qq.com", gomock.Any()).
+					Return(ErrCodeSendTooMany)
+				return userRepo, codeRepo
+			},
+			wantErr: ErrCodeSendTooMany,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo, codeRepo := tc.mock(ctrl)
+			emailSvc := memory.NewService(&logger.NopLogger{})
+			svc := NewPasswordResetService(userRepo, codeRepo,
+				repomocks.NewMockPasswordResetRepository(ctrl), emailSvc, &logger.NopLogger{})
+			err := svc.SendCode(context.Background(), "123@qq.com")
+			assert.Equal(t, tc.wantErr, err)
+			sent := emailSvc.Sent()
+			require.Len(t, sent, tc.wantSent)
+			for _, msg := range sent {
+				assert.Equal(t, "123@qq.com", msg.To)
+			}
+		})
+	}
+}
+
+func TestPasswordResetService_VerifyCode(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository,
+			repository.CodeRepository, repository.PasswordResetRepository)
+
+		wantToken bool
+		wantErr   error
+	}{
+		{
+			name: "验证成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository,
+				repository.CodeRepository, repository.PasswordResetRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				resetRepo := repomocks.NewMockPasswordResetRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), resetPasswordBiz, "123@qq.com", "123456").Return(true, nil)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{Id: 1, Email: "123@qq.com"}, nil)
+				resetRepo.EXPECT().StoreToken(gomock.Any(), gomock.Any(), int64(1)).Return(nil)
+				return userRepo, codeRepo, resetRepo
+			},
+			wantToken: true,
+		},
+		{
+			name: "验证码不对",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository,
+				repository.CodeRepository, repository.PasswordResetRepository) {
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), resetPasswordBiz, "123@qq.com", "123456").Return(false, nil)
+				return repomocks.NewMockUserRepository(ctrl), codeRepo, repomocks.NewMockPasswordResetRepository(ctrl)
+			},
+			wantErr: ErrInvalidResetCode,
+		},
+		{
+			name: "验证次数太多",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository,
+				repository.CodeRepository, repository.PasswordResetRepository) {
+				codeRepo := repomocks.NewMockCodeRepository(ctrl)
+				codeRepo.EXPECT().Verfiy(gomock.Any(), resetPasswordBiz, "123@qq.com", "123456").
+					Return(false, ErrCodeVerifyTooManyTimes)
+				return repomocks.NewMockUserRepository(ctrl), codeRepo, repomocks.NewMockPasswordResetRepository(ctrl)
+			},
+			wantErr: ErrCodeVerifyTooManyTimes,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo, codeRepo, resetRepo := tc.mock(ctrl)
+			svc := NewPasswordResetService(userRepo, codeRepo, resetRepo,
+				memory.NewService(&logger.NopLogger{}), &logger.NopLogger{})
+			token, err := svc.VerifyCode(context.Background(), "123@qq.com", "123456")
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantToken, token != "")
+		})
+	}
+}
+
+func TestPasswordResetService_Reset(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, repository.PasswordResetRepository)
+
+		wantUid int64
+		wantErr error
+	}{
+		{
+			name: "重置成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.PasswordResetRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				resetRepo := repomocks.NewMockPasswordResetRepository(ctrl)
+				resetRepo.EXPECT().ConsumeToken(gomock.Any(), "token").Return(int64(1), nil)
+				userRepo.EXPECT().UpdatePassword(gomock.Any(), int64(1), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id int64, password string) error {
+						// 存的是加密之后的密码
+						assert.True(t, strings.HasPrefix(password, "$2a$"))
+						return bcrypt.CompareHashAndPassword([]byte(password), []byte("hello#world123"))
+					})
+				return userRepo, resetRepo
+			},
+			wantUid: 1,
+		},
+		{
+			name: "凭证过期或者用过了",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.PasswordResetRepository) {
+				resetRepo := repomocks.NewMockPasswordResetRepository(ctrl)
+				resetRepo.EXPECT().ConsumeToken(gomock.Any(), "token").
+					Return(int64(0), repository.ErrResetTokenNotFound)
+				return repomocks.NewMockUserRepository(ctrl), resetRepo
+			},
+			wantErr: ErrInvalidResetToken,
+		},
+		{
+			name: "更新密码失败",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.PasswordResetRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				resetRepo := repomocks.NewMockPasswordResetRepository(ctrl)
+				resetRepo.EXPECT().ConsumeToken(gomock.Any(), "token").Return(int64(1), nil)
+				userRepo.EXPECT().UpdatePassword(gomock.Any(), int64(1), gomock.Any()).
+					Return(errors.New("数据库错误"))
+				return userRepo, resetRepo
+			},
+			wantUid: 1,
+			wantErr: errors.New("数据库错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo, resetRepo := tc.mock(ctrl)
+			svc := NewPasswordResetService(userRepo, repomocks.NewMockCodeRepository(ctrl), resetRepo,
+				memory.NewService(&logger.NopLogger{}), &logger.NopLogger{})
+			uid, err := svc.Reset(context.Background(), "token", "hello#world123")
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantUid, uid)
+		})
+	}
+}
@@ -73,10 +73,11 @@ func (svc *BatchRankingService) topN(ctx context.Context) ([]domain.Article, err
 	// 在这里开始分批往这个队列中放数据
 	// 我只取7天内的数据
 	now := time.Now()
-	offset := 0
+	// 用游标翻页，计算的过程中有帖子更新也不会重复或者漏掉
+	cursor := domain.ArticleCursor{Utime: now}
 	for {
 		// 先拿一批文章
-		arts, err := svc.artSvc.ListPub(ctx, now, offset, svc.batchSize)
+		arts, err := svc.artSvc.ListPubByCursor(ctx, cursor, svc.batchSize)
 		if err != nil {
 			return nil, err
 		}
@@ -126,8 +127,8 @@ func (svc *BatchRankingService) topN(ctx context.Context) ([]domain.Article, err
 			// 或者我都取到7天之前的数据了，肯定也不用再取了
 			break
 		}
-		// 更新offset
-		offset = offset + svc.batchSize
+		// 下一批从这一批的最后一篇开始
+		cursor = arts[len(arts)-1].Cursor()
 	}
 	// 得出结果
 	res := make([]domain.Article, svc.n)
@@ -26,13 +26,14 @@ func TestRankingTopN(t *testing.T) {
 			name: "计算成功",
 			mock: func(ctrl *gomock.Controller) (ArticleService, intrv1.InteractiveServiceClient) {
 				artSvc := svcmocks.NewMockArticleService(ctrl)
-				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, 3).
+				artSvc.EXPECT().ListPubByCursor(gomock.Any(), gomock.Any(), 3).
 					Return([]domain.Article{
 						{Id: 1, Utime: now, Ctime: now},
 						{Id: 2, Utime: now, Ctime: now},
 						{Id: 3, Utime: now, Ctime: now},
 					}, nil)
-				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 3, 3).
+				artSvc.EXPECT().ListPubByCursor(gomock.Any(),
+					domain.ArticleCursor{Utime: now, Id: 3}, 3).
 					Return([]domain.Article{}, nil)
 				intrSvc := svcmocks.NewMockInteractiveService(ctrl)
 				intrSvc.EXPECT().GetByIds(gomock.Any(), "article",
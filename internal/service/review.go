@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"webookpro/internal/domain"
+	events "webookpro/internal/events/article"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+)
+
+var (
+	ErrReviewNotFound   = article.ErrReviewNotFound
+	ErrReviewNotPending = article.ErrReviewNotPending
+	// ErrReviewOutdated 提交审核之后作者又修改了帖子，这条审核记录作废了
+	ErrReviewOutdated = errors.New("审核记录已经过期")
+	// ErrReviewAuthorInactive 作者停用或者注销了账号，恢复之前不能审核通过
+	ErrReviewAuthorInactive = errors.New("作者已经停用或者注销了账号")
+)
+
+// articleReviewLimit 作者查看一篇帖子的审核记录，最多返回这么多条
+const articleReviewLimit = 20
+
+//go:generate mockgen -source=review.go -package=svcmocks -destination=mock/review.mock.go ReviewService
+type ReviewService interface {
+	// ListPending 管理员查看待审核的帖子，最早提交的在前面
+	ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error)
+	// Approve 审核通过，帖子同步到线上库
+	Approve(ctx context.Context, reviewer, id int64) error
+	// Reject 驳回，帖子变回草稿，作者能看到 reason
+	Reject(ctx context.Context, reviewer, id int64, reason string) error
+	// ListByArticle 作者查看自己帖子的审核记录，最新的在前面
+	ListByArticle(ctx context.Context, uid, artId int64) ([]domain.ArticleReview, error)
+}
+
+type reviewService struct {
+	repo     article.ReviewRepository
+	artRepo  article.ArticleRepository
+	userRepo repository.UserRepository
+	producer events.Producer
+	l        logger.Logger
+}
+
+func NewReviewService(repo article.ReviewRepository,
+	artRepo article.ArticleRepository,
+	userRepo repository.UserRepository,
+	producer events.Producer,
+	l logger.Logger) ReviewService {
+	return &reviewService{
+		repo:     repo,
+		artRepo:  artRepo,
+		userRepo: userRepo,
+		producer: producer,
+		l:        l,
+	}
+}
+
+func (s *reviewService) ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error) {
+	return s.repo.ListPending(ctx, offset, limit)
+}
+
+func (s *reviewService) ListByArticle(ctx context.Context, uid, artId int64) ([]domain.ArticleReview, error) {
+	return s.repo.ListByArticle(ctx, artId, uid, articleReviewLimit)
+}
+
+// Approve 发表的是制作库里面现在的内容，审核期间作者改过的话审核记录就过期了
+func (s *reviewService) Approve(ctx context.Context, reviewer, id int64) error {
+	rev, art, err := s.pending(ctx, id)
+	if err != nil {
+		return err
+	}
+	// 发表出去读者也看不到，作者恢复账号之后再审核
+	author, err := s.userRepo.FindById(ctx, art.Author.Id)
+	if err != nil {
+		return err
+	}
+	if author.Inactive() {
+		return ErrReviewAuthorInactive
+	}
+	art.Status = domain.ArticleStatusPublished
+	_, err = s.artRepo.Sync(ctx, render(art))
+	if errors.Is(err, ErrVersionConflict) {
+		return s.outdate(ctx, rev)
+	}
+	if err != nil {
+		return err
+	}
+	rev.Status = domain.ArticleReviewStatusApproved
+	rev.Reviewer = reviewer
+	err = s.repo.Finish(ctx, rev)
+	if err != nil {
+		// 帖子已经发表了，只是审核记录没有更新，不影响作者
+		s.l.Error("更新审核记录失败",
+			logger.Int64("review_id", id),
+			logger.Error(err))
+	}
+	er := s.producer.ProducePublishEvent(ctx, events.PublishEvent{
+		Aid: art.Id,
+		Uid: art.Author.Id,
+	})
+	if er != nil {
+		s.l.Error("发送帖子发表事件失败",
+			logger.Int64("art_id", art.Id),
+			logger.Error(er))
+	}
+	return nil
+}
+
+// Reject 线上库不动，之前发表过的版本读者还能看到
+func (s *reviewService) Reject(ctx context.Context, reviewer, id int64, reason string) error {
+	rev, art, err := s.pending(ctx, id)
+	if err != nil {
+		return err
+	}
+	art.Status = domain.ArticleStatusUnpublished
+	err = s.artRepo.Update(ctx, art)
+	if errors.Is(err, ErrVersionConflict) {
+		return s.outdate(ctx, rev)
+	}
+	if err != nil {
+		return err
+	}
+	rev.Status = domain.ArticleReviewStatusRejected
+	rev.Reviewer = reviewer
+	rev.Reason = reason
+	return s.repo.Finish(ctx, rev)
+}
+
+// pending 找到待审核的记录和制作库里面的帖子，帖子已经不是审核中的话就作废这条记录
+func (s *reviewService) pending(ctx context.Context, id int64) (domain.ArticleReview, domain.Article, error) {
+	rev, err := s.repo.GetById(ctx, id)
+	if err != nil {
+		return domain.ArticleReview{}, domain.Article{}, err
+	}
+	if rev.Status != domain.ArticleReviewStatusPending {
+		return domain.ArticleReview{}, domain.Article{}, ErrReviewNotPending
+	}
+	art, err := s.artRepo.GetById(ctx, rev.ArticleId)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && art.Status != domain.ArticleStatusReviewing) {
+		return domain.ArticleReview{}, domain.Article{}, s.outdate(ctx, rev)
+	}
+	return rev, art, err
+}
+
+func (s *reviewService) outdate(ctx context.Context, rev domain.ArticleReview) error {
+	rev.Status = domain.ArticleReviewStatusOutdated
+	err := s.repo.Finish(ctx, rev)
+	if err != nil && !errors.Is(err, ErrReviewNotPending) {
+		return err
+	}
+	return ErrReviewOutdated
+}
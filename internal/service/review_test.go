@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+	"webookpro/internal/domain"
+	events "webookpro/internal/events/article"
+	evtmocks "webookpro/internal/events/article/mocks"
+	"webookpro/internal/repository"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+)
+
+func TestReviewService_Approve(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer)
+		// user 不传的话作者是正常的账号
+		user    func(ctrl *gomock.Controller) repository.UserRepository
+		wantErr error
+	}{
+		{
+			name: "审核通过，发表制作库里面的内容",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Content: "内容", Author: domain.Author{Id: 123},
+						Status: domain.ArticleStatusReviewing, Version: 4}, nil)
+				artRepo.EXPECT().Sync(gomock.Any(), domain.Article{
+					Id:      1,
+					Content: "内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPublished,
+					Version: 4,
+					HTML:    "<p>内容</p>\n",
+				}).Return(int64(1), nil)
+				repo.EXPECT().Finish(gomock.Any(), domain.ArticleReview{
+					Id:        10,
+					ArticleId: 1,
+					Status:    domain.ArticleReviewStatusApproved,
+					Reviewer:  99,
+				}).Return(nil)
+				producer.EXPECT().ProducePublishEvent(gomock.Any(), events.PublishEvent{Aid: 1, Uid: 123}).Return(nil)
+				return repo, artRepo, producer
+			},
+		},
+		{
+			name: "已经审核过了",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusRejected}, nil)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl), evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrReviewNotPending,
+		},
+		{
+			name: "作者停用了账号",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 123},
+						Status: domain.ArticleStatusReviewing, Version: 4}, nil)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			user: func(ctrl *gomock.Controller) repository.UserRepository {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Status: domain.UserStatusDeactivated}, nil)
+				return userRepo
+			},
+			wantErr: ErrReviewAuthorInactive,
+		},
+		{
+			name: "作者又改成了草稿",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusUnpublished}, nil)
+				repo.EXPECT().Finish(gomock.Any(), domain.ArticleReview{
+					Id:        10,
+					ArticleId: 1,
+					Status:    domain.ArticleReviewStatusOutdated,
+				}).Return(nil)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrReviewOutdated,
+		},
+		{
+			name: "帖子已经彻底删除了",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().Finish(gomock.Any(), gomock.Any()).Return(nil)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrReviewOutdated,
+		},
+		{
+			name: "审核的时候作者刚好改了",
+			mock: func(ctrl *gomock.Controller) (article.ReviewRepository, article.ArticleRepository, events.Producer) {
+				repo := artrepomocks.NewMockReviewRepository(ctrl)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(10)).
+					Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Status: domain.ArticleStatusReviewing, Version: 4}, nil)
+				artRepo.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(int64(0), ErrVersionConflict)
+				repo.EXPECT().Finish(gomock.Any(), gomock.Any()).Return(nil)
+				return repo, artRepo, evtmocks.NewMockProducer(ctrl)
+			},
+			wantErr: ErrReviewOutdated,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo, producer := tc.mock(ctrl)
+			var userRepo repository.UserRepository
+			if tc.user != nil {
+				userRepo = tc.user(ctrl)
+			} else {
+				mockRepo := repomocks.NewMockUserRepository(ctrl)
+				mockRepo.EXPECT().FindById(gomock.Any(), gomock.Any()).
+					Return(domain.User{Status: domain.UserStatusActive}, nil).AnyTimes()
+				userRepo = mockRepo
+			}
+			svc := NewReviewService(repo, artRepo, userRepo, producer, &logger.NopLogger{})
+			err := svc.Approve(context.Background(), 99, 10)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestReviewService_Reject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockReviewRepository(ctrl)
+	artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), int64(10)).
+		Return(domain.ArticleReview{Id: 10, ArticleId: 1, Status: domain.ArticleReviewStatusPending}, nil)
+	artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+		Return(domain.Article{Id: 1, Status: domain.ArticleStatusReviewing, Version: 4}, nil)
+	// 只改制作库，线上库之前发表的版本不动
+	artRepo.EXPECT().Update(gomock.Any(), domain.Article{
+		Id:      1,
+		Status:  domain.ArticleStatusUnpublished,
+		Version: 4,
+	}).Return(nil)
+	repo.EXPECT().Finish(gomock.Any(), domain.ArticleReview{
+		Id:        10,
+		ArticleId: 1,
+		Status:    domain.ArticleReviewStatusRejected,
+		Reviewer:  99,
+		Reason:    "广告太多",
+	}).Return(nil)
+	svc := NewReviewService(repo, artRepo, nil, nil, &logger.NopLogger{})
+	err := svc.Reject(context.Background(), 99, 10, "广告太多")
+	assert.NoError(t, err)
+}
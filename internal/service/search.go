@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/searchx"
+)
+
+//go:generate mockgen -source=search.go -package=svcmocks -destination=mock/search.mock.go SearchService
+type SearchService interface {
+	// SearchArticles 搜索已发表的帖子，按照相关度排序
+	SearchArticles(ctx context.Context, query string, offset, limit int) (domain.ArticleSearchResult, error)
+	// IndexArticle 用线上库最新的数据更新索引，已经不是发表状态的帖子会从索引里面删掉
+	IndexArticle(ctx context.Context, artId int64) error
+	RemoveArticle(ctx context.Context, artId int64) error
+	// Rebuild 遍历线上库重建索引，进程内的索引重启之后要调用
+	Rebuild(ctx context.Context) error
+}
+
+type articleSearchService struct {
+	idx  searchx.Index
+	repo article.ArticleRepository
+	l    logger.Logger
+}
+
+func NewArticleSearchService(idx searchx.Index, repo article.ArticleRepository, l logger.Logger) SearchService {
+	return &articleSearchService{
+		idx:  idx,
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (s *articleSearchService) SearchArticles(ctx context.Context, query string, offset, limit int) (domain.ArticleSearchResult, error) {
+	hits, total, err := s.idx.Search(ctx, query, offset, limit)
+	if err != nil {
+		return domain.ArticleSearchResult{}, err
+	}
+	res := domain.ArticleSearchResult{
+		Total: total,
+		Hits:  make([]domain.ArticleSearchHit, 0, len(hits)),
+	}
+	for _, hit := range hits {
+		res.Hits = append(res.Hits, domain.ArticleSearchHit{
+			Id:      hit.Id,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Score:   hit.Score,
+		})
+	}
+	return res, nil
+}
+
+func (s *articleSearchService) IndexArticle(ctx context.Context, artId int64) error {
+	art, err := s.repo.GetPublishedById(ctx, artId)
+	if err != nil {
+		return err
+	}
+	if art.Status != domain.ArticleStatusPublished {
+		return s.idx.Delete(ctx, artId)
+	}
+	return s.idx.Upsert(ctx, s.toDocument(art))
+}
+
+func (s *articleSearchService) RemoveArticle(ctx context.Context, artId int64) error {
+	return s.idx.Delete(ctx, artId)
+}
+
+func (s *articleSearchService) Rebuild(ctx context.Context) error {
+	const batchSize = 100
+	cursor := domain.ArticleCursor{Utime: time.Now()}
+	cnt := 0
+	for {
+		arts, err := s.repo.ListPubByCursor(ctx, cursor, batchSize)
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			if art.Status != domain.ArticleStatusPublished {
+				continue
+			}
+			err = s.idx.Upsert(ctx, s.toDocument(art))
+			if err != nil {
+				return err
+			}
+			cnt++
+		}
+		if len(arts) < batchSize {
+			break
+		}
+		cursor = arts[len(arts)-1].Cursor()
+	}
+	s.l.Info("重建搜索索引完成", logger.Int64("cnt", int64(cnt)))
+	return nil
+}
+
+func (s *articleSearchService) toDocument(art domain.Article) searchx.Document {
+	return searchx.Document{
+		Id:      art.Id,
+		Title:   art.Title,
+		Content: art.Content,
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+)
+
+var (
+	ErrSeriesNotFound      = article.ErrSeriesNotFound
+	ErrArticleInSeries     = article.ErrArticleInSeries
+	ErrSeriesOrderMismatch = article.ErrSeriesOrderMismatch
+	// ErrSeriesArticleNotPublished 只能把自己已经发表了的帖子加到系列里面
+	ErrSeriesArticleNotPublished = errors.New("帖子不是自己已经发表的")
+)
+
+//go:generate mockgen -source=series.go -package=svcmocks -destination=mock/series.mock.go SeriesService
+type SeriesService interface {
+	Create(ctx context.Context, s domain.Series) (int64, error)
+	// Update 修改标题和简介
+	Update(ctx context.Context, s domain.Series) error
+	Delete(ctx context.Context, uid, id int64) error
+	ListByAuthor(ctx context.Context, uid int64, offset, limit int) ([]domain.Series, error)
+	// GetById 创作者看自己的系列能看到撤回了的帖子，其他人只能看到已发表的
+	GetById(ctx context.Context, uid, id int64) (domain.Series, error)
+	// GetByArticle 帖子所在的系列，只带已发表的帖子，读者看帖子的时候用来做导航
+	GetByArticle(ctx context.Context, artId int64) (domain.Series, error)
+	// AddArticle 把自己已经发表的帖子加到系列的最后面
+	AddArticle(ctx context.Context, uid, id, artId int64) error
+	RemoveArticle(ctx context.Context, uid, id, artId int64) error
+	// Reorder artIds 是系列里面全部的帖子，按照新的顺序排好
+	Reorder(ctx context.Context, uid, id int64, artIds []int64) error
+}
+
+type seriesService struct {
+	repo    article.SeriesRepository
+	artRepo article.ArticleRepository
+}
+
+func NewSeriesService(repo article.SeriesRepository, artRepo article.ArticleRepository) SeriesService {
+	return &seriesService{
+		repo:    repo,
+		artRepo: artRepo,
+	}
+}
+
+func (s *seriesService) Create(ctx context.Context, series domain.Series) (int64, error) {
+	return s.repo.Create(ctx, series)
+}
+
+func (s *seriesService) Update(ctx context.Context, series domain.Series) error {
+	return s.repo.Update(ctx, series)
+}
+
+func (s *seriesService) Delete(ctx context.Context, uid, id int64) error {
+	return s.repo.Delete(ctx, id, uid)
+}
+
+func (s *seriesService) ListByAuthor(ctx context.Context, uid int64, offset, limit int) ([]domain.Series, error) {
+	return s.repo.ListByAuthor(ctx, uid, offset, limit)
+}
+
+func (s *seriesService) GetById(ctx context.Context, uid, id int64) (domain.Series, error) {
+	series, err := s.repo.GetById(ctx, id)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	if series.Author.Id != uid {
+		series = series.Published()
+	}
+	return series, nil
+}
+
+func (s *seriesService) GetByArticle(ctx context.Context, artId int64) (domain.Series, error) {
+	series, err := s.repo.GetByArticle(ctx, artId)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return series.Published(), nil
+}
+
+func (s *seriesService) AddArticle(ctx context.Context, uid, id, artId int64) error {
+	// 系列是不是自己的由 DAO 在事务里面判断，这里只判断帖子
+	// 要看线上库，发表之后再编辑，制作库的状态会变回未发表
+	art, err := s.artRepo.GetPublishedById(ctx, artId)
+	if errors.Is(err, article.ErrRecordNotFound) {
+		return ErrSeriesArticleNotPublished
+	}
+	if err != nil {
+		return err
+	}
+	if art.Author.Id != uid || art.Status != domain.ArticleStatusPublished {
+		return ErrSeriesArticleNotPublished
+	}
+	return s.repo.AddArticle(ctx, id, uid, artId)
+}
+
+func (s *seriesService) RemoveArticle(ctx context.Context, uid, id, artId int64) error {
+	return s.repo.RemoveArticle(ctx, id, uid, artId)
+}
+
+func (s *seriesService) Reorder(ctx context.Context, uid, id int64, artIds []int64) error {
+	return s.repo.Reorder(ctx, id, uid, artIds)
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+)
+
+func TestSeriesService_AddArticle(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository)
+		wantErr error
+	}{
+		{
+			name: "添加成功",
+			mock: func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished,
+				}, nil)
+				repo := artrepomocks.NewMockSeriesRepository(ctrl)
+				repo.EXPECT().AddArticle(gomock.Any(), int64(1), int64(123), int64(2)).Return(nil)
+				return repo, artRepo
+			},
+		},
+		{
+			name: "别人的帖子",
+			mock: func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 234},
+					Status: domain.ArticleStatusPublished,
+				}, nil)
+				return artrepomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			wantErr: ErrSeriesArticleNotPublished,
+		},
+		{
+			name: "还没有发表的帖子",
+			mock: func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).
+					Return(domain.Article{}, article.ErrRecordNotFound)
+				return artrepomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			wantErr: ErrSeriesArticleNotPublished,
+		},
+		{
+			name: "撤回了的帖子",
+			mock: func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPrivate,
+				}, nil)
+				return artrepomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			wantErr: ErrSeriesArticleNotPublished,
+		},
+		{
+			name: "已经在别的系列里面了",
+			mock: func(ctrl *gomock.Controller) (article.SeriesRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetPublishedById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished,
+				}, nil)
+				repo := artrepomocks.NewMockSeriesRepository(ctrl)
+				repo.EXPECT().AddArticle(gomock.Any(), int64(1), int64(123), int64(2)).
+					Return(ErrArticleInSeries)
+				return repo, artRepo
+			},
+			wantErr: ErrArticleInSeries,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewSeriesService(tc.mock(ctrl))
+			err := svc.AddArticle(context.Background(), 123, 1, 2)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestSeriesService_GetByArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := artrepomocks.NewMockSeriesRepository(ctrl)
+	repo.EXPECT().GetByArticle(gomock.Any(), int64(3)).Return(domain.Series{
+		Id:     1,
+		Author: domain.Author{Id: 123},
+		Title:  "系列",
+		Articles: []domain.SeriesArticle{
+			{Id: 1, Title: "第一篇", Status: domain.ArticleStatusPublished},
+			{Id: 2, Title: "撤回了的", Status: domain.ArticleStatusPrivate},
+			{Id: 3, Title: "第三篇", Status: domain.ArticleStatusPublished},
+			{Id: 4, Title: "第四篇", Status: domain.ArticleStatusPublished},
+		},
+	}, nil)
+	svc := NewSeriesService(repo, nil)
+	s, err := svc.GetByArticle(context.Background(), 3)
+	assert.NoError(t, err)
+	// 撤回了的帖子读者看不到，上一篇直接跳过它
+	assert.Equal(t, []domain.SeriesArticle{
+		{Id: 1, Title: "第一篇", Status: domain.ArticleStatusPublished},
+		{Id: 3, Title: "第三篇", Status: domain.ArticleStatusPublished},
+		{Id: 4, Title: "第四篇", Status: domain.ArticleStatusPublished},
+	}, s.Articles)
+	prev, next := s.Neighbors(3)
+	assert.Equal(t, int64(1), prev.Id)
+	assert.Equal(t, int64(4), next.Id)
+	prev, next = s.Neighbors(1)
+	assert.Nil(t, prev)
+	assert.Equal(t, int64(3), next.Id)
+}
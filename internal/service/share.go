@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+)
+
+var (
+	ErrShareNotFound    = article.ErrShareNotFound
+	ErrShareUnavailable = article.ErrShareUnavailable
+	// ErrArticleNotShareable 只能分享自己还没有公开的帖子
+	ErrArticleNotShareable = errors.New("帖子不能分享")
+)
+
+const (
+	// DefaultShareTTL 没有指定有效期的时候，分享链接七天之后失效
+	DefaultShareTTL = time.Hour * 24 * 7
+	MaxShareTTL     = time.Hour * 24 * 30
+)
+
+//go:generate mockgen -source=share.go -package=svcmocks -destination=mock/share.mock.go ShareService
+type ShareService interface {
+	// Create ttl 不大于 0 的时候用 DefaultShareTTL，超过 MaxShareTTL 的按照 MaxShareTTL 算
+	// maxViews 为 0 代表不限制次数
+	Create(ctx context.Context, uid, artId int64, ttl time.Duration, maxViews int64) (domain.ArticleShare, error)
+	// List 帖子的全部分享链接，包括已经失效了的
+	List(ctx context.Context, uid, artId int64) ([]domain.ArticleShare, error)
+	Revoke(ctx context.Context, uid, id int64) error
+	// View 通过分享链接看帖子，看的是制作库里面的最新内容，每看一次计一次数
+	View(ctx context.Context, id int64) (domain.Article, error)
+}
+
+type shareService struct {
+	repo    article.ShareRepository
+	artRepo article.ArticleRepository
+}
+
+func NewShareService(repo article.ShareRepository, artRepo article.ArticleRepository) ShareService {
+	return &shareService{
+		repo:    repo,
+		artRepo: artRepo,
+	}
+}
+
+func (s *shareService) Create(ctx context.Context, uid, artId int64,
+	ttl time.Duration, maxViews int64) (domain.ArticleShare, error) {
+	art, err := s.artRepo.GetById(ctx, artId)
+	if err != nil {
+		return domain.ArticleShare{}, err
+	}
+	if art.Author.Id != uid || !art.Status.Shareable() {
+		return domain.ArticleShare{}, ErrArticleNotShareable
+	}
+	if ttl <= 0 {
+		ttl = DefaultShareTTL
+	}
+	if ttl > MaxShareTTL {
+		ttl = MaxShareTTL
+	}
+	share := domain.ArticleShare{
+		ArticleId: artId,
+		Author:    domain.Author{Id: uid},
+		MaxViews:  maxViews,
+		Expire:    time.Now().Add(ttl),
+	}
+	share.Id, err = s.repo.Create(ctx, share)
+	return share, err
+}
+
+func (s *shareService) List(ctx context.Context, uid, artId int64) ([]domain.ArticleShare, error) {
+	return s.repo.ListByArticle(ctx, artId, uid)
+}
+
+func (s *shareService) Revoke(ctx context.Context, uid, id int64) error {
+	return s.repo.Revoke(ctx, id, uid)
+}
+
+func (s *shareService) View(ctx context.Context, id int64) (domain.Article, error) {
+	share, err := s.repo.GetById(ctx, id)
+	if errors.Is(err, ErrShareNotFound) {
+		return domain.Article{}, ErrShareUnavailable
+	}
+	if err != nil {
+		return domain.Article{}, err
+	}
+	// 先过滤掉明显失效的，省得再去查帖子
+	if !share.Available(time.Now()) {
+		return domain.Article{}, ErrShareUnavailable
+	}
+	art, err := s.artRepo.GetById(ctx, share.ArticleId)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	// 帖子进了回收站，分享链接也跟着失效
+	if art.Author.Id != share.Author.Id || art.Status == domain.ArticleStatusDeleted {
+		return domain.Article{}, ErrShareUnavailable
+	}
+	// 次数限制以这里为准，并发访问的时候前面的判断不一定准
+	err = s.repo.IncrViews(ctx, id)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	// 制作库里面没有渲染好的 HTML，分享出去的要现场渲染
+	return render(art), nil
+}
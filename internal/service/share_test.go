@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
+)
+
+func TestShareService_Create(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository)
+		ttl     time.Duration
+		wantTTL time.Duration
+		wantErr error
+	}{
+		{
+			name: "分享私密的帖子，有效期超过上限",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPrivate,
+				}, nil)
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				return repo, artRepo
+			},
+			ttl:     time.Hour * 24 * 365,
+			wantTTL: MaxShareTTL,
+		},
+		{
+			name: "分享草稿，默认有效期",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusUnpublished,
+				}, nil)
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				return repo, artRepo
+			},
+			wantTTL: DefaultShareTTL,
+		},
+		{
+			name: "已经发表的帖子不需要分享链接",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished,
+				}, nil)
+				return artrepomocks.NewMockShareRepository(ctrl), artRepo
+			},
+			wantErr: ErrArticleNotShareable,
+		},
+		{
+			name: "别人的帖子",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 234},
+					Status: domain.ArticleStatusUnpublished,
+				}, nil)
+				return artrepomocks.NewMockShareRepository(ctrl), artRepo
+			},
+			wantErr: ErrArticleNotShareable,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewShareService(tc.mock(ctrl))
+			share, err := svc.Create(context.Background(), 123, 2, tc.ttl, 10)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, int64(1), share.Id)
+			assert.Equal(t, int64(10), share.MaxViews)
+			assert.WithinDuration(t, time.Now().Add(tc.wantTTL), share.Expire, time.Second)
+		})
+	}
+}
+
+func TestShareService_View(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository)
+		wantArt domain.Article
+		wantErr error
+	}{
+		{
+			name: "看草稿，现场渲染",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.ArticleShare{
+					Id:        1,
+					ArticleId: 2,
+					Author:    domain.Author{Id: 123},
+					Expire:    time.Now().Add(time.Hour),
+				}, nil)
+				repo.EXPECT().IncrViews(gomock.Any(), int64(1)).Return(nil)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:      2,
+					Content: "草稿",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+				}, nil)
+				return repo, artRepo
+			},
+			wantArt: domain.Article{
+				Id:      2,
+				Content: "草稿",
+				Author:  domain.Author{Id: 123},
+				Status:  domain.ArticleStatusUnpublished,
+				HTML:    "<p>草稿</p>\n",
+			},
+		},
+		{
+			name: "次数用完了",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.ArticleShare{
+					Id:        1,
+					ArticleId: 2,
+					Author:    domain.Author{Id: 123},
+					MaxViews:  3,
+					Views:     3,
+					Expire:    time.Now().Add(time.Hour),
+				}, nil)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			wantErr: ErrShareUnavailable,
+		},
+		{
+			name: "已经撤销了",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.ArticleShare{
+					Id:        1,
+					ArticleId: 2,
+					Author:    domain.Author{Id: 123},
+					Expire:    time.Now().Add(time.Hour),
+					Revoked:   true,
+				}, nil)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			wantErr: ErrShareUnavailable,
+		},
+		{
+			name: "帖子进了回收站",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.ArticleShare{
+					Id:        1,
+					ArticleId: 2,
+					Author:    domain.Author{Id: 123},
+					Expire:    time.Now().Add(time.Hour),
+				}, nil)
+				artRepo := artrepomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusDeleted,
+				}, nil)
+				return repo, artRepo
+			},
+			wantErr: ErrShareUnavailable,
+		},
+		{
+			name: "链接不存在",
+			mock: func(ctrl *gomock.Controller) (article.ShareRepository, article.ArticleRepository) {
+				repo := artrepomocks.NewMockShareRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.ArticleShare{}, ErrShareNotFound)
+				return repo, artrepomocks.NewMockArticleRepository(ctrl)
+			},
+			wantErr: ErrShareUnavailable,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewShareService(tc.mock(ctrl))
+			art, err := svc.View(context.Background(), 1)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantArt, art)
+		})
+	}
+}
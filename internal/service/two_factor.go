@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/totpx"
+)
+
+const (
+	twoFactorIssuer    = "webook"
+	recoveryCodeCnt    = 10
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrTwoFactorEnabled = repository.ErrTwoFactorEnabled
+	// ErrTwoFactorNotEnrolled 还没有生成过密钥，或者密钥已经删掉了
+	ErrTwoFactorNotEnrolled = errors.New("没有绑定认证器")
+	// ErrTwoFactorNotEnabled 关闭的时候发现本来就没开
+	ErrTwoFactorNotEnabled = errors.New("没有开启两步验证")
+	// ErrInvalidTwoFactorCode 验证码和恢复码都不对
+	ErrInvalidTwoFactorCode = errors.New("验证码不对")
+	// ErrInvalidChallenge 挑战过期了、用过了或者输错太多次了，要重新输密码
+	ErrInvalidChallenge = errors.New("两步验证的挑战无效")
+	// ErrTwoFactorLocked 这个用户最近输错太多次了，重新输密码也没用，要等一会儿
+	ErrTwoFactorLocked = errors.New("两步验证输错太多次")
+)
+
+// TwoFactorService 基于 TOTP 的两步验证，只对密码登录生效
+// 密码验证通过之后先发一个挑战，带着挑战和认证器上的验证码才能换到登录态
+//
+//go:generate mockgen -source=two_factor.go -package=svcmocks -destination=mock/two_factor.mock.go TwoFactorService
+type TwoFactorService interface {
+	// Enroll 生成密钥和恢复码，确认之前两步验证不生效，重复调用会覆盖掉之前的
+	Enroll(ctx context.Context, uid int64) (domain.TwoFactorEnrollment, error)
+	// Confirm 用认证器上的验证码确认绑定成功，之后密码登录就要两步验证了
+	Confirm(ctx context.Context, uid int64, code string) error
+	// Disable 关闭两步验证，验证码或者恢复码都可以
+	Disable(ctx context.Context, uid int64, code string) error
+	Enabled(ctx context.Context, uid int64) (bool, error)
+	// Challenge 密码验证通过之后调用，返回的挑战五分钟内有效
+	Challenge(ctx context.Context, uid int64) (string, error)
+	// VerifyChallenge 验证码对了就返回用户 id，挑战只能用一次
+	// 账号停用或者注销中的话返回 ErrUserInactive，同时带上用户 id，用来发恢复凭证
+	VerifyChallenge(ctx context.Context, challenge, code string) (int64, error)
+}
+
+type totpService struct {
+	repo     repository.TwoFactorRepository
+	userRepo repository.UserRepository
+	l        logger.Logger
+}
+
+func NewTOTPService(repo repository.TwoFactorRepository,
+	userRepo repository.UserRepository,
+	l logger.Logger) TwoFactorService {
+	return &totpService{
+		repo:     repo,
+		userRepo: userRepo,
+		l:        l,
+	}
+}
+
+func (s *totpService) Enroll(ctx context.Context, uid int64) (domain.TwoFactorEnrollment, error) {
+	u, err := s.userRepo.FindById(ctx, uid)
+	if err != nil {
+		return domain.TwoFactorEnrollment{}, err
+	}
+	secret, err := totpx.GenerateSecret()
+	if err != nil {
+		return domain.TwoFactorEnrollment{}, err
+	}
+	codes := make([]string, 0, recoveryCodeCnt)
+	hashes := make([]string, 0, recoveryCodeCnt)
+	for i := 0; i < recoveryCodeCnt; i++ {
+		code, er := s.generateRecoveryCode()
+		if er != nil {
+			return domain.TwoFactorEnrollment{}, er
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, s.hashRecoveryCode(code))
+	}
+	err = s.repo.Save(ctx, uid, secret, hashes)
+	if err != nil {
+		return domain.TwoFactorEnrollment{}, err
+	}
+	return domain.TwoFactorEnrollment{
+		URI:           totpx.URI(twoFactorIssuer, s.account(u), secret),
+		Secret:        secret,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *totpService) Confirm(ctx context.Context, uid int64, code string) error {
+	secret, enabled, err := s.repo.FindSecret(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if secret == "" {
+		return ErrTwoFactorNotEnrolled
+	}
+	if enabled {
+		return ErrTwoFactorEnabled
+	}
+	// 确认的时候只认认证器上的验证码，证明确实扫过码了
+	ok, err := s.useTOTP(ctx, uid, secret, strings.TrimSpace(code))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+	return s.repo.Enable(ctx, uid)
+}
+
+func (s *totpService) Disable(ctx context.Context, uid int64, code string) error {
+	secret, enabled, err := s.repo.FindSecret(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return ErrTwoFactorNotEnabled
+	}
+	ok, err := s.verify(ctx, uid, secret, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+	return s.repo.Delete(ctx, uid)
+}
+
+func (s *totpService) Enabled(ctx context.Context, uid int64) (bool, error) {
+	_, enabled, err := s.repo.FindSecret(ctx, uid)
+	return enabled, err
+}
+
+func (s *totpService) Challenge(ctx context.Context, uid int64) (string, error) {
+	challenge := uuid.New().String()
+	err := s.repo.StoreChallenge(ctx, challenge, uid)
+	return challenge, err
+}
+
+func (s *totpService) VerifyChallenge(ctx context.Context, challenge, code string) (int64, error) {
+	uid, err := s.repo.FindChallenge(ctx, challenge)
+	if errors.Is(err, repository.ErrChallengeNotFound) {
+		return 0, ErrInvalidChallenge
+	}
+	if err != nil {
+		return 0, err
+	}
+	secret, enabled, err := s.repo.FindSecret(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+	if !enabled {
+		// 发挑战之后又关掉了，重新输密码就行
+		return 0, ErrInvalidChallenge
+	}
+	ok, err := s.verify(ctx, uid, secret, code)
+	if err != nil {
+		// 被锁住了挑战也留着，等锁过期了还能接着用
+		return 0, err
+	}
+	if !ok {
+		err = s.repo.FailChallenge(ctx, challenge)
+		if err != nil {
+			s.l.Error("记录两步验证失败次数失败",
+				logger.Int64("uid", uid),
+				logger.Error(err))
+		}
+		return 0, ErrInvalidTwoFactorCode
+	}
+	err = s.repo.DelChallenge(ctx, challenge)
+	if err != nil {
+		// 挑战五分钟之后也会过期，不影响登录
+		s.l.Error("删除两步验证的挑战失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	u, err := s.userRepo.FindById(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+	if u.Inactive() {
+		// 恢复凭证要等两步验证通过了才能发，不然密码泄露了就能直接恢复并登录
+		return uid, ErrUserInactive
+	}
+	return uid, nil
+}
+
+// verify 按照用户记录输错的次数，输错太多次先锁住，换一个挑战也绕不过去
+func (s *totpService) verify(ctx context.Context, uid int64, secret, code string) (bool, error) {
+	locked, err := s.repo.Locked(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	if locked {
+		return false, ErrTwoFactorLocked
+	}
+	ok, err := s.check(ctx, uid, secret, code)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		err = s.repo.FailVerify(ctx, uid)
+		if err != nil {
+			s.l.Error("记录两步验证输错的次数失败",
+				logger.Int64("uid", uid),
+				logger.Error(err))
+		}
+		return false, nil
+	}
+	err = s.repo.ResetFailures(ctx, uid)
+	if err != nil {
+		// 十五分钟之后也会过期，只是这段时间里面能输错的次数少一点
+		s.l.Error("清空两步验证输错的次数失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	return true, nil
+}
+
+// check 六位数字的当成认证器上的验证码，别的当成恢复码
+func (s *totpService) check(ctx context.Context, uid int64, secret, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == totpx.Digits {
+		return s.useTOTP(ctx, uid, secret, code)
+	}
+	err := s.repo.UseRecoveryCode(ctx, uid, s.hashRecoveryCode(code))
+	if errors.Is(err, repository.ErrRecoveryCodeInvalid) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// useTOTP 前后各容忍一个时间窗口，所以验证码一分半钟之内都是对的
+// 记下用过的窗口，被人看到了也不能拿去再用一次
+func (s *totpService) useTOTP(ctx context.Context, uid int64, secret, code string) (bool, error) {
+	counter, ok := totpx.Match(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	err := s.repo.UseCounter(ctx, uid, int64(counter))
+	if errors.Is(err, repository.ErrTOTPCounterUsed) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// generateRecoveryCode 形如 abcd-efgh-ijkl-mnop，80 位的随机数
+func (s *totpService) generateRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+	return code[:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:], nil
+}
+
+// hashRecoveryCode 输入的时候大小写和连字符都无所谓
+func (s *totpService) hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// account 认证器 App 里面显示的账号名
+func (s *totpService) account(u domain.User) string {
+	switch {
+	case u.Email != "":
+		return u.Email
+	case u.Phone != "":
+		return u.Phone
+	default:
+		return strconv.FormatInt(u.Id, 10)
+	}
+}
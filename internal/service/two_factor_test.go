@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"strings"
+	"testing"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/repository"
+	repomocks "webookpro/internal/repository/mock"
+	"webookpro/pkg/logger"
+	"webookpro/pkg/totpx"
+)
+
+func TestTOTPService_VerifyChallenge(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code, err := totpx.Code(secret, time.Now())
+	require.NoError(t, err)
+	svc := &totpService{}
+	testCases := []struct {
+		name string
+		code string
+		mock func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository)
+
+		wantUid int64
+		wantErr error
+	}{
+		{
+			name: "验证码正确",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().UseCounter(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				repo.EXPECT().ResetFailures(gomock.Any(), int64(1)).Return(nil)
+				repo.EXPECT().DelChallenge(gomock.Any(), "challenge").Return(nil)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Status: domain.UserStatusActive}, nil)
+				return repo, userRepo
+			},
+			wantUid: 1,
+		},
+		{
+			name: "验证码已经用过了",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().UseCounter(gomock.Any(), int64(1), gomock.Any()).
+					Return(repository.ErrTOTPCounterUsed)
+				repo.EXPECT().FailVerify(gomock.Any(), int64(1)).Return(nil)
+				repo.EXPECT().FailChallenge(gomock.Any(), "challenge").Return(nil)
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "恢复码正确",
+			code: "ABCD-EFGH-IJKL-MNOP",
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().UseRecoveryCode(gomock.Any(), int64(1),
+					svc.hashRecoveryCode("abcdefghijklmnop")).Return(nil)
+				repo.EXPECT().ResetFailures(gomock.Any(), int64(1)).Return(nil)
+				repo.EXPECT().DelChallenge(gomock.Any(), "challenge").Return(nil)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Status: domain.UserStatusActive}, nil)
+				return repo, userRepo
+			},
+			wantUid: 1,
+		},
+		{
+			name: "恢复码已经用过了",
+			code: "abcd-efgh-ijkl-mnop",
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().UseRecoveryCode(gomock.Any(), int64(1), gomock.Any()).
+					Return(repository.ErrRecoveryCodeInvalid)
+				repo.EXPECT().FailVerify(gomock.Any(), int64(1)).Return(nil)
+				repo.EXPECT().FailChallenge(gomock.Any(), "challenge").Return(nil)
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "验证码不对",
+			code: "000000",
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().FailVerify(gomock.Any(), int64(1)).Return(errors.New("redis 错误"))
+				repo.EXPECT().FailChallenge(gomock.Any(), "challenge").Return(errors.New("redis 错误"))
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "输错太多次被锁住了，换挑战也没用",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(true, nil)
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrTwoFactorLocked,
+		},
+		{
+			name: "账号停用了，带上用户 id 去发恢复凭证",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				repo.EXPECT().Locked(gomock.Any(), int64(1)).Return(false, nil)
+				repo.EXPECT().UseCounter(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				repo.EXPECT().ResetFailures(gomock.Any(), int64(1)).Return(nil)
+				repo.EXPECT().DelChallenge(gomock.Any(), "challenge").Return(nil)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(1)).
+					Return(domain.User{Id: 1, Status: domain.UserStatusDeactivated}, nil)
+				return repo, userRepo
+			},
+			wantUid: 1,
+			wantErr: ErrUserInactive,
+		},
+		{
+			name: "挑战过期了",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").
+					Return(int64(0), repository.ErrChallengeNotFound)
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrInvalidChallenge,
+		},
+		{
+			name: "发挑战之后关掉了两步验证",
+			code: code,
+			mock: func(ctrl *gomock.Controller) (repository.TwoFactorRepository, repository.UserRepository) {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindChallenge(gomock.Any(), "challenge").Return(int64(1), nil)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return("", false, nil)
+				return repo, repomocks.NewMockUserRepository(ctrl)
+			},
+			wantErr: ErrInvalidChallenge,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, userRepo := tc.mock(ctrl)
+			svc := NewTOTPService(repo, userRepo, &logger.NopLogger{})
+			uid, err := svc.VerifyChallenge(context.Background(), "challenge", tc.code)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantUid, uid)
+		})
+	}
+}
+
+func TestTOTPService_Confirm(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code, err := totpx.Code(secret, time.Now())
+	require.NoError(t, err)
+	testCases := []struct {
+		name string
+		code string
+		mock func(ctrl *gomock.Controller) repository.TwoFactorRepository
+
+		wantErr error
+	}{
+		{
+			name: "确认成功",
+			code: code,
+			mock: func(ctrl *gomock.Controller) repository.TwoFactorRepository {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, false, nil)
+				repo.EXPECT().UseCounter(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				repo.EXPECT().Enable(gomock.Any(), int64(1)).Return(nil)
+				return repo
+			},
+		},
+		{
+			name: "确认的时候不能用恢复码",
+			code: "abcd-efgh-ijkl-mnop",
+			mock: func(ctrl *gomock.Controller) repository.TwoFactorRepository {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, false, nil)
+				return repo
+			},
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "没有绑定过",
+			code: code,
+			mock: func(ctrl *gomock.Controller) repository.TwoFactorRepository {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return("", false, nil)
+				return repo
+			},
+			wantErr: ErrTwoFactorNotEnrolled,
+		},
+		{
+			name: "已经开启了",
+			code: code,
+			mock: func(ctrl *gomock.Controller) repository.TwoFactorRepository {
+				repo := repomocks.NewMockTwoFactorRepository(ctrl)
+				repo.EXPECT().FindSecret(gomock.Any(), int64(1)).Return(secret, true, nil)
+				return repo
+			},
+			wantErr: ErrTwoFactorEnabled,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewTOTPService(tc.mock(ctrl), nil, &logger.NopLogger{})
+			err := svc.Confirm(context.Background(), 1, tc.code)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestTOTPService_generateRecoveryCode(t *testing.T) {
+	code, err := (&totpService{}).generateRecoveryCode()
+	require.NoError(t, err)
+	parts := strings.Split(code, "-")
+	assert.Len(t, parts, 4)
+	// 16 个 base32 字符，一个字符 5 位，一共 80 位
+	assert.Len(t, strings.Join(parts, ""), 16)
+}
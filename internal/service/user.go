@@ -13,13 +13,18 @@ import (
 
 var (
 	ErrUserDuplicateEmail    error = repository.ErrUserDuplicateEmail
+	ErrUserDuplicateNickname error = repository.ErrUserDuplicateNickname
 	ErrInvalidUserOrPassword error = errors.New("用户名或密码不对")
+	// ErrUserInactive 账号停用了或者在注销冷静期内，返回的时候也会带上用户，用来发恢复凭证
+	ErrUserInactive = errors.New("账号已停用或者正在注销")
 )
 
 type UserService interface {
 	Login(ctx context.Context, email, password string) (domain.User, error)
 	SignUp(ctx context.Context, user domain.User) error
 	Profile(ctx context.Context, id int64) (domain.User, error)
+	// UpdateProfile 修改昵称、简介、生日和头像，格式在调用之前已经校验过了
+	UpdateProfile(ctx context.Context, user domain.User) error
 	FindOrCreate(ctx *gin.Context, phone string) (domain.User, error)
 	FindOrCreateByWechat(ctx context.Context, wechatInfo domain.WeChatInfo) (domain.User, error)
 }
@@ -51,7 +56,7 @@ func (u *userService) Login(ctx context.Context, email, password string) (domain
 	if err != nil {
 		return domain.User{}, ErrInvalidUserOrPassword
 	}
-	return user, err
+	return user, u.checkActive(user)
 }
 
 // SignUp
@@ -75,12 +80,16 @@ func (u *userService) Profile(ctx context.Context, id int64) (domain.User, error
 	return user, err
 }
 
+func (u *userService) UpdateProfile(ctx context.Context, user domain.User) error {
+	return u.repo.UpdateProfile(ctx, user)
+}
+
 // FindOrCreate 通过phone查找用户，找不到就新建一个
 func (u *userService) FindOrCreate(ctx *gin.Context, phone string) (domain.User, error) {
 	user, err := u.repo.FindByPhone(ctx, phone)
 	if err == nil {
 		// 说明查找到了，直接返回
-		return user, nil
+		return user, u.checkActive(user)
 	}
 	// 没找到，新建一个并返回
 	err = u.repo.Create(ctx, domain.User{
@@ -97,7 +106,7 @@ func (u *userService) FindOrCreateByWechat(ctx context.Context, wechatInfo domai
 	user, err := u.repo.FindByWechat(ctx, wechatInfo.OpenID)
 	if err == nil {
 		// 说明查找到了，直接返回
-		return user, nil
+		return user, u.checkActive(user)
 	}
 	// 没找到，新建一个并返回
 	err = u.repo.Create(ctx, domain.User{
@@ -108,3 +117,11 @@ func (u *userService) FindOrCreateByWechat(ctx context.Context, wechatInfo domai
 	}
 	return u.repo.FindByWechat(ctx, wechatInfo.OpenID)
 }
+
+// checkActive 停用和注销中的账号不能登录
+func (u *userService) checkActive(user domain.User) error {
+	if user.Inactive() {
+		return ErrUserInactive
+	}
+	return nil
+}
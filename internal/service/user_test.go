@@ -41,6 +41,26 @@ func TestUserService_Login(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "账号已停用",
+			mock: func(controller *gomock.Controller) repository.UserRepository {
+				userRepo := repomocks.NewMockUserRepository(controller)
+				userRepo.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").Return(domain.User{
+					Id:       1,
+					Password: "$2a$10$RV9/taBHXln82KX6hlS9ie1JXK1va9vSKItLzrVVPO5dlRCNOEPMG",
+					Status:   domain.UserStatusDeactivated,
+				}, nil)
+				return userRepo
+			},
+			email:    "123@qq.com",
+			password: "$pl3nd1D",
+			wantUser: domain.User{
+				Id:       1,
+				Password: "$2a$10$RV9/taBHXln82KX6hlS9ie1JXK1va9vSKItLzrVVPO5dlRCNOEPMG",
+				Status:   domain.UserStatusDeactivated,
+			},
+			wantErr: ErrUserInactive,
+		},
 		{
 			name: "用户不存在",
 			mock: func(controller *gomock.Controller) repository.UserRepository {
@@ -0,0 +1,245 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*AccountHandler)(nil)
+
+// AccountHandler 停用、注销、恢复账号和导出个人数据
+type AccountHandler struct {
+	svc    service.AccountService
+	jwtHdl ijwt.JwtHandler
+	l      logger.Logger
+}
+
+func NewAccountHandler(svc service.AccountService, jwtHdl ijwt.JwtHandler, l logger.Logger) *AccountHandler {
+	return &AccountHandler{
+		svc:    svc,
+		jwtHdl: jwtHdl,
+		l:      l,
+	}
+}
+
+func (h *AccountHandler) RegisterRoutes(server *gin.Engine) {
+	ag := server.Group("/users/account")
+	ag.POST("/deactivate", h.Deactivate)
+	ag.POST("/delete", h.Delete)
+	// 停用和注销中的账号登录不了，拿着登录时返回的凭证恢复
+	ag.POST("/restore", h.Restore)
+	ag.GET("/export", h.Export)
+}
+
+// Deactivate 停用之后所有设备都退出登录
+func (h *AccountHandler) Deactivate(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.Deactivate(ctx, uc.Uid)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrUserStatusConflict):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "账号不是正常状态",
+		})
+		return
+	default:
+		h.l.Error("停用账号失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	h.clearSessions(ctx, uc.Uid)
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "账号已停用",
+	})
+}
+
+// Delete 返回冷静期结束的时间，毫秒数
+func (h *AccountHandler) Delete(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	deleteAt, err := h.svc.Delete(ctx, uc.Uid)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrUserStatusConflict):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "账号已经在注销了",
+		})
+		return
+	default:
+		h.l.Error("注销账号失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	h.clearSessions(ctx, uc.Uid)
+	ctx.JSON(http.StatusOK, Result{
+		Msg:  "已申请注销，冷静期内登录可以恢复",
+		Data: deleteAt.UnixMilli(),
+	})
+}
+
+// Restore 恢复成功直接登录
+func (h *AccountHandler) Restore(ctx *gin.Context) {
+	type Req struct {
+		Ticket string `json:"ticket"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uid, err := h.svc.Restore(ctx, req.Ticket)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrInvalidRestoreTicket),
+		errors.Is(err, service.ErrUserStatusConflict):
+		// 冷静期过了已经被抹掉的账号也是这个错误
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "凭证无效，请重新登录",
+		})
+		return
+	default:
+		h.l.Error("恢复账号失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	err = h.jwtHdl.SetLoginToken(ctx, uid)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "账号已恢复",
+	})
+}
+
+// Export 直接下载 JSON 文件
+func (h *AccountHandler) Export(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	data, err := h.svc.Export(ctx, uc.Uid)
+	if err != nil {
+		h.l.Error("导出个人数据失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.Header("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="webook-%d.json"`, uc.Uid))
+	ctx.JSON(http.StatusOK, toPersonalDataVO(data))
+}
+
+// clearSessions 状态已经改了，旧的登录态清不掉也只能等它过期
+func (h *AccountHandler) clearSessions(ctx context.Context, uid int64) {
+	err := h.jwtHdl.ClearSessions(ctx, uid)
+	if err != nil {
+		h.l.Error("清除登录态失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+}
+
+// inactiveResult 停用或者注销中的账号登录，发一个恢复凭证给前端，用户确认之后再恢复
+func inactiveResult(ctx context.Context, svc service.AccountService, uid int64) Result {
+	ticket, err := svc.RestoreTicket(ctx, uid)
+	if err != nil {
+		return Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}
+	}
+	return Result{
+		Code: 4,
+		Msg:  "账号已停用或者正在注销，确认之后可以恢复",
+		Data: ticket,
+	}
+}
+
+type PersonalDataVO struct {
+	Profile    ProfileVO           `json:"profile"`
+	Articles   []PersonalArticleVO `json:"articles"`
+	Likes      []InteractionVO     `json:"likes"`
+	Collects   []InteractionVO     `json:"collects"`
+	History    []HistoryRecordVO   `json:"history"`
+	ExportedAt string              `json:"exported_at"`
+}
+
+type PersonalArticleVO struct {
+	Id       int64    `json:"id"`
+	Title    string   `json:"title"`
+	Content  string   `json:"content"`
+	Status   string   `json:"status"`
+	Category string   `json:"category"`
+	Tags     []string `json:"tags"`
+	Ctime    string   `json:"ctime"`
+	Utime    string   `json:"utime"`
+}
+
+type InteractionVO struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"biz_id"`
+	// Cid 收藏夹，点赞记录没有
+	Cid   int64  `json:"cid,omitempty"`
+	Ctime string `json:"ctime"`
+}
+
+func toPersonalDataVO(data domain.PersonalData) PersonalDataVO {
+	return PersonalDataVO{
+		Profile: toProfileVO(data.User),
+		Articles: slice.Map(data.Articles, func(idx int, src domain.Article) PersonalArticleVO {
+			return PersonalArticleVO{
+				Id:       src.Id,
+				Title:    src.Title,
+				Content:  src.Content,
+				Status:   src.Status.String(),
+				Category: src.Category,
+				Tags:     src.Tags,
+				Ctime:    src.Ctime.Format(time.DateTime),
+				Utime:    src.Utime.Format(time.DateTime),
+			}
+		}),
+		Likes:      slice.Map(data.Likes, toInteractionVO),
+		Collects:   slice.Map(data.Collects, toInteractionVO),
+		History:    slice.Map(data.History, toHistoryRecordVO),
+		ExportedAt: data.ExportedAt.Format(time.DateTime),
+	}
+}
+
+func toInteractionVO(idx int, src domain.InteractionRecord) InteractionVO {
+	return InteractionVO{
+		Biz:   src.Biz,
+		BizId: src.BizId,
+		Cid:   src.Cid,
+		Ctime: src.Ctime.Format(time.DateTime),
+	}
+}
@@ -0,0 +1,111 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*AccountMergeHandler)(nil)
+
+// AccountMergeHandler 合并账号，先登录要被合并的账号拿凭证，再登录主账号合并
+type AccountMergeHandler struct {
+	svc    service.AccountMergeService
+	jwtHdl ijwt.JwtHandler
+	l      logger.Logger
+}
+
+func NewAccountMergeHandler(svc service.AccountMergeService, jwtHdl ijwt.JwtHandler, l logger.Logger) *AccountMergeHandler {
+	return &AccountMergeHandler{
+		svc:    svc,
+		jwtHdl: jwtHdl,
+		l:      l,
+	}
+}
+
+func (h *AccountMergeHandler) RegisterRoutes(server *gin.Engine) {
+	mg := server.Group("/users/merge")
+	mg.POST("/ticket", h.Ticket)
+	mg.POST("", h.Merge)
+}
+
+// Ticket 当前登录的是要被合并的账号
+func (h *AccountMergeHandler) Ticket(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	ticket, err := h.svc.Ticket(ctx, uc.Uid)
+	if err != nil {
+		h.l.Error("生成合并账号的凭证失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Data: ticket,
+	})
+}
+
+// Merge 当前登录的是主账号，被合并的账号的登录态全部清掉
+func (h *AccountMergeHandler) Merge(ctx *gin.Context) {
+	type Req struct {
+		Ticket string `json:"ticket"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	secondary, err := h.svc.Merge(ctx, uc.Uid, req.Ticket)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrMergeTicketInvalid):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "凭证无效，请重新登录要合并的账号",
+		})
+		return
+	case errors.Is(err, service.ErrMergeSelf):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "不能合并自己",
+		})
+		return
+	case errors.Is(err, service.ErrUserMerged):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "账号已经合并过了",
+		})
+		return
+	case errors.Is(err, service.ErrMergeTwoFactor):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "请先关闭要合并的账号的两步验证",
+		})
+		return
+	default:
+		h.l.Error("合并账号失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	err = h.jwtHdl.ClearSessions(ctx, secondary)
+	if err != nil {
+		// 被合并的账号已经没有登录方式了，只是旧的登录态还要等过期
+		h.l.Error("合并账号之后清除登录态失败",
+			logger.Int64("uid", secondary),
+			logger.Error(err))
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "合并成功",
+	})
+}
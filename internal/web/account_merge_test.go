@@ -0,0 +1,102 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+func TestAccountMergeHandler_Merge(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler)
+
+		wantRes Result
+	}{
+		{
+			name: "合并成功",
+			mock: func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountMergeService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Merge(gomock.Any(), int64(123), "ticket").Return(int64(456), nil)
+				jwtHdl.EXPECT().ClearSessions(gomock.Any(), int64(456)).Return(nil)
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "合并成功"},
+		},
+		{
+			name: "清除登录态失败也算合并成功",
+			mock: func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountMergeService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Merge(gomock.Any(), int64(123), "ticket").Return(int64(456), nil)
+				jwtHdl.EXPECT().ClearSessions(gomock.Any(), int64(456)).Return(errors.New("redis 错误"))
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "合并成功"},
+		},
+		{
+			name: "凭证无效",
+			mock: func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountMergeService(ctrl)
+				svc.EXPECT().Merge(gomock.Any(), int64(123), "ticket").
+					Return(int64(0), service.ErrMergeTicketInvalid)
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "凭证无效，请重新登录要合并的账号"},
+		},
+		{
+			name: "要合并的账号开启了两步验证",
+			mock: func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountMergeService(ctrl)
+				svc.EXPECT().Merge(gomock.Any(), int64(123), "ticket").
+					Return(int64(0), service.ErrMergeTwoFactor)
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "请先关闭要合并的账号的两步验证"},
+		},
+		{
+			name: "系统错误",
+			mock: func(ctrl *gomock.Controller) (service.AccountMergeService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountMergeService(ctrl)
+				svc.EXPECT().Merge(gomock.Any(), int64(123), "ticket").
+					Return(int64(0), errors.New("数据库错误"))
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 5, Msg: "系统错误"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc, jwtHdl := tc.mock(ctrl)
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", ijwt.UserClaims{Uid: 123})
+			})
+			NewAccountMergeHandler(svc, jwtHdl, &logger.NopLogger{}).RegisterRoutes(server)
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/merge",
+				bytes.NewBuffer([]byte(`{"ticket":"ticket"}`)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			server.ServeHTTP(recorder, req)
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+func TestAccountHandler_Restore(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler)
+
+		wantRes Result
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Restore(gomock.Any(), "ticket").Return(int64(123), nil)
+				jwtHdl.EXPECT().SetLoginToken(gomock.Any(), int64(123)).Return(nil)
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "账号已恢复"},
+		},
+		{
+			name: "凭证无效",
+			mock: func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountService(ctrl)
+				svc.EXPECT().Restore(gomock.Any(), "ticket").
+					Return(int64(0), service.ErrInvalidRestoreTicket)
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "凭证无效，请重新登录"},
+		},
+		{
+			name: "系统错误",
+			mock: func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountService(ctrl)
+				svc.EXPECT().Restore(gomock.Any(), "ticket").
+					Return(int64(0), errors.New("数据库错误"))
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 5, Msg: "系统错误"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc, jwtHdl := tc.mock(ctrl)
+			server := gin.Default()
+			NewAccountHandler(svc, jwtHdl, &logger.NopLogger{}).RegisterRoutes(server)
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/account/restore",
+				bytes.NewBuffer([]byte(`{"ticket":"ticket"}`)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			server.ServeHTTP(recorder, req)
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestAccountHandler_Deactivate(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler)
+
+		wantRes Result
+	}{
+		{
+			name: "停用成功",
+			mock: func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Deactivate(gomock.Any(), int64(123)).Return(nil)
+				jwtHdl.EXPECT().ClearSessions(gomock.Any(), int64(123)).Return(nil)
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "账号已停用"},
+		},
+		{
+			name: "状态不对",
+			mock: func(ctrl *gomock.Controller) (service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockAccountService(ctrl)
+				svc.EXPECT().Deactivate(gomock.Any(), int64(123)).Return(service.ErrUserStatusConflict)
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "账号不是正常状态"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc, jwtHdl := tc.mock(ctrl)
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", ijwt.UserClaims{Uid: 123})
+			})
+			NewAccountHandler(svc, jwtHdl, &logger.NopLogger{}).RegisterRoutes(server)
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/account/deactivate", nil)
+			require.NoError(t, err)
+			server.ServeHTTP(recorder, req)
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
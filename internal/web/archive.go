@@ -0,0 +1,195 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*ArchiveHandler)(nil)
+
+// ArchiveHandler 导出和导入自己的全部帖子，用来备份或者搬家
+type ArchiveHandler struct {
+	svc service.ArchiveService
+	l   logger.Logger
+}
+
+func NewArchiveHandler(svc service.ArchiveService, l logger.Logger) *ArchiveHandler {
+	return &ArchiveHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ArchiveHandler) RegisterRoutes(server *gin.Engine) {
+	eg := server.Group("/articles/export")
+	eg.POST("", ginx.WrapToken[ijwt.UserClaims](h.Export))
+	eg.GET("/list", ginx.WrapToken[ijwt.UserClaims](h.ListExports))
+	eg.GET("/download/:id", h.Download)
+
+	server.POST("/articles/import", ginx.WrapToken[ijwt.UserClaims](h.Import))
+}
+
+// Export 发起导出，打包好了之后在 /articles/export/list 里面能看到
+func (h *ArchiveHandler) Export(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	e, err := h.svc.Export(ctx, uc.Uid)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: toExportVO(e),
+		}, nil
+	case errors.Is(err, service.ErrExportInProgress):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "上一次导出还没有完成，请稍后再试",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+func (h *ArchiveHandler) ListExports(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	res, err := h.svc.ListExports(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(res, func(idx int, src domain.ArticleExport) ExportVO {
+			return toExportVO(src)
+		}),
+	}, nil
+}
+
+// Download 下载打包好的 zip
+func (h *ArchiveHandler) Download(ctx *gin.Context) {
+	// 登录校验的中间件放进去的是值，不是指针
+	claims, ok := ctx.MustGet("claims").(ijwt.UserClaims)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.Status(http.StatusBadRequest)
+		return
+	}
+	data, err := h.svc.Download(ctx, claims.Uid, id)
+	switch {
+	case err == nil:
+		ctx.Header("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="webook-articles-%d.zip"`, id))
+		ctx.Data(http.StatusOK, "application/zip", data)
+	case errors.Is(err, service.ErrExportNotFound),
+		errors.Is(err, service.ErrExportNotReady):
+		ctx.Status(http.StatusNotFound)
+	default:
+		h.l.Error("下载导出的帖子失败",
+			logger.Int64("id", id),
+			logger.Error(err))
+		ctx.Status(http.StatusInternalServerError)
+	}
+}
+
+// Import 导入导出的 zip，表单字段是 file，全部导入成草稿
+func (h *ArchiveHandler) Import(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	if fh.Size > service.MaxArchiveSize {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "文件不能超过 50M",
+		}, nil
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, service.MaxArchiveSize))
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	res, err := h.svc.Import(ctx, uc.Uid, data)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: slice.Map(res, func(idx int, src domain.ArticleImportResult) ImportResultVO {
+				return ImportResultVO{
+					File:      src.File,
+					Title:     src.Title,
+					Status:    src.Status.String(),
+					ArticleId: src.ArticleId,
+					Reason:    src.Reason,
+				}
+			}),
+		}, nil
+	case errors.Is(err, service.ErrInvalidArchive):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "只支持导入导出的 zip 文件",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type ExportVO struct {
+	Id int64 `json:"id"`
+	// Status 1 等待打包，2 打包中，3 完成，4 失败
+	Status uint8  `json:"status"`
+	Cnt    int    `json:"cnt"`
+	Size   int64  `json:"size"`
+	Ctime  string `json:"ctime"`
+	Utime  string `json:"utime"`
+}
+
+type ImportResultVO struct {
+	File  string `json:"file"`
+	Title string `json:"title"`
+	// Status created、duplicate 或者 failed
+	Status string `json:"status"`
+	// ArticleId 新建的草稿，重复的时候是已有的那篇
+	ArticleId int64  `json:"article_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+func toExportVO(e domain.ArticleExport) ExportVO {
+	return ExportVO{
+		Id:     e.Id,
+		Status: e.Status.ToUint8(),
+		Cnt:    e.Cnt,
+		Size:   e.Size,
+		Ctime:  e.Ctime.Format(time.DateTime),
+		Utime:  e.Utime.Format(time.DateTime),
+	}
+}
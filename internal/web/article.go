@@ -1,17 +1,20 @@
 package web
 
 import (
+	"errors"
 	"fmt"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 	intrv1 "webookpro/api/proto/gen/intr/v1"
 	"webookpro/internal/domain"
 	"webookpro/internal/service"
 	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/diffx"
 	"webookpro/pkg/ginx"
 	"webookpro/pkg/logger"
 )
@@ -23,18 +26,26 @@ type handler interface {
 var _ handler = (*ArticleHandler)(nil)
 
 type ArticleHandler struct {
-	svc     service.ArticleService
-	intrSvc intrv1.InteractiveServiceClient
-	l       logger.Logger
-	biz     string
+	svc       service.ArticleService
+	attachSvc service.AttachmentService
+	seriesSvc service.SeriesService
+	collabSvc service.CollaboratorService
+	intrSvc   intrv1.InteractiveServiceClient
+	l         logger.Logger
+	biz       string
 }
 
-func NewArticleHandler(svc service.ArticleService, intrSvc intrv1.InteractiveServiceClient, l logger.Logger) *ArticleHandler {
+func NewArticleHandler(svc service.ArticleService, attachSvc service.AttachmentService,
+	seriesSvc service.SeriesService, collabSvc service.CollaboratorService,
+	intrSvc intrv1.InteractiveServiceClient, l logger.Logger) *ArticleHandler {
 	return &ArticleHandler{
-		svc:     svc,
-		intrSvc: intrSvc,
-		l:       l,
-		biz:     "article",
+		svc:       svc,
+		attachSvc: attachSvc,
+		seriesSvc: seriesSvc,
+		collabSvc: collabSvc,
+		intrSvc:   intrSvc,
+		l:         l,
+		biz:       "article",
 	}
 }
 
@@ -45,13 +56,330 @@ func (u *ArticleHandler) RegisterRoutes(server *gin.Engine) {
 	ug.POST("/withdraw", u.Withdraw)
 	ug.POST("/list", ginx.WrapBodyAndToken[ListReq, ijwt.UserClaims](u.List))
 	ug.GET("/detail/:id", ginx.WrapToken[ijwt.UserClaims](u.Detail))
+	ug.POST("/delete", ginx.WrapBodyAndToken[ArticleIdReq, ijwt.UserClaims](u.Delete))
+
+	// 回收站
+	rb := ug.Group("/recycle_bin")
+	rb.GET("", ginx.WrapToken[ijwt.UserClaims](u.ListDeleted))
+	rb.POST("/restore", ginx.WrapBodyAndToken[ArticleIdReq, ijwt.UserClaims](u.Restore))
+
+	// 定时发表
+	sch := ug.Group("/scheduled")
+	sch.GET("", ginx.WrapToken[ijwt.UserClaims](u.ListScheduled))
+	sch.POST("/reschedule", ginx.WrapBodyAndToken[ScheduleReq, ijwt.UserClaims](u.Reschedule))
+	sch.POST("/cancel", ginx.WrapBodyAndToken[ScheduleReq, ijwt.UserClaims](u.CancelSchedule))
+
+	// 历史版本
+	rev := ug.Group("/revisions")
+	rev.GET("/:id", ginx.WrapToken[ijwt.UserClaims](u.ListRevisions))
+	rev.GET("/:id/diff", ginx.WrapToken[ijwt.UserClaims](u.DiffRevisions))
+	rev.POST("/restore", ginx.WrapBodyAndToken[RestoreRevisionReq, ijwt.UserClaims](u.RestoreRevision))
 
 	pub := ug.Group("/pub")
 	pub.GET("/:id", u.PubDetail)
+	// 按照标签和分类浏览，不需要登录
+	pub.GET("/tag", ginx.Wrap(u.ListPubByTag))
+	pub.GET("/category", ginx.Wrap(u.ListPubByCategory))
+	pub.GET("/tags", ginx.Wrap(u.ListTags))
 	pub.POST("/like", ginx.WrapBodyAndToken[LikeReq,
 		ijwt.UserClaims](u.Like))
 }
 
+// Delete 把帖子放进回收站，保留期限之内还可以恢复
+func (u *ArticleHandler) Delete(ctx *gin.Context, req ArticleIdReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := u.svc.Delete(ctx, uc.Uid, req.Id)
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrArticleNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "帖子不存在",
+		}, err
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// ListDeleted 回收站，最近删除的在前面
+func (u *ArticleHandler) ListDeleted(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	offset, limit := pageQuery(ctx)
+	arts, err := u.svc.ListDeleted(ctx, uc.Uid, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(arts, func(idx int, src domain.Article) ArticleVO {
+			return ArticleVO{
+				Id:       src.Id,
+				Title:    src.Title,
+				Abstract: src.Abstract(),
+				Status:   src.Status.ToUint8(),
+				Dtime:    src.Dtime.Format(time.DateTime),
+				PurgeAt:  src.Dtime.Add(service.DeletedArticleRetention).Format(time.DateTime),
+				Ctime:    src.Ctime.Format(time.DateTime),
+				Utime:    src.Utime.Format(time.DateTime),
+			}
+		}),
+	}, nil
+}
+
+// Restore 从回收站恢复，恢复之后是未发表的状态，要重新发表
+func (u *ArticleHandler) Restore(ctx *gin.Context, req ArticleIdReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := u.svc.Restore(ctx, uc.Uid, req.Id)
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrArticleNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "帖子不存在或者已经超过了恢复期限",
+		}, err
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// ListScheduled 创作者查看自己还没到点的定时发表
+func (u *ArticleHandler) ListScheduled(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	offset, limit := pageQuery(ctx)
+	arts, err := u.svc.ListScheduled(ctx, uc.Uid, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(arts, func(idx int, src domain.Article) ArticleVO {
+			return ArticleVO{
+				Id:        src.Id,
+				Title:     src.Title,
+				Abstract:  src.Abstract(),
+				Status:    src.Status.ToUint8(),
+				PublishAt: src.PublishAt.Format(time.DateTime),
+				Ctime:     src.Ctime.Format(time.DateTime),
+				Utime:     src.Utime.Format(time.DateTime),
+			}
+		}),
+	}, nil
+}
+
+// Reschedule 修改定时发表的时间
+func (u *ArticleHandler) Reschedule(ctx *gin.Context, req ScheduleReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := u.svc.Reschedule(ctx, uc.Uid, req.Id, time.UnixMilli(req.PublishAt))
+	switch err {
+	case nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case service.ErrInvalidPublishTime, service.ErrScheduleNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "输入有误",
+		}, err
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// CancelSchedule 取消定时发表，帖子会回到未发表的状态
+func (u *ArticleHandler) CancelSchedule(ctx *gin.Context, req ScheduleReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := u.svc.CancelSchedule(ctx, uc.Uid, req.Id)
+	switch err {
+	case nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case service.ErrScheduleNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "输入有误",
+		}, err
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// ListRevisions 创作者查看帖子的历史版本列表
+func (u *ArticleHandler) ListRevisions(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	artId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	offset, limit := pageQuery(ctx)
+	revs, err := u.svc.ListRevisions(ctx, artId, uc.Uid, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(revs, func(idx int, src domain.ArticleRevision) ArticleRevisionVO {
+			return toArticleRevisionVO(src)
+		}),
+	}, nil
+}
+
+// DiffRevisions 比较两个历史版本，from 和 to 都是历史版本的 ID
+func (u *ArticleHandler) DiffRevisions(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	artId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	from, err := strconv.ParseInt(ctx.Query("from"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	to, err := strconv.ParseInt(ctx.Query("to"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	diff, err := u.svc.DiffRevisions(ctx, uc.Uid, from, to)
+	if err == service.ErrRevisionNotMatch || (err == nil && diff.From.ArticleId != artId) {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "输入有误",
+		}, fmt.Errorf("非法访问历史版本 uid %d, art_id %d", uc.Uid, artId)
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: ArticleRevisionDiffVO{
+			From: toArticleRevisionVO(diff.From),
+			To:   toArticleRevisionVO(diff.To),
+			Lines: slice.Map(diff.Lines, func(idx int, src diffx.Line) DiffLineVO {
+				return DiffLineVO{
+					Op:    src.Op.String(),
+					Text:  src.Text,
+					OldNo: src.OldNo,
+					NewNo: src.NewNo,
+				}
+			}),
+		},
+	}, nil
+}
+
+// RestoreRevision 把某个历史版本恢复成当前草稿
+func (u *ArticleHandler) RestoreRevision(ctx *gin.Context, req RestoreRevisionReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	id, err := u.svc.RestoreRevision(ctx, uc.Uid, req.RevisionId)
+	if err == service.ErrRevisionNotMatch {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "输入有误",
+		}, err
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg:  "OK",
+		Data: id,
+	}, nil
+}
+
+// ListPubByTag 某个标签下已发表的帖子
+func (u *ArticleHandler) ListPubByTag(ctx *gin.Context) (ginx.Result, error) {
+	tag := strings.TrimSpace(ctx.Query("tag"))
+	if tag == "" {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	offset, limit := pageQuery(ctx)
+	arts, err := u.svc.ListPubByTag(ctx, tag, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(arts, func(idx int, src domain.Article) ArticleVO {
+			return toPubListVO(src)
+		}),
+	}, nil
+}
+
+// ListPubByCategory 某个分类下已发表的帖子
+func (u *ArticleHandler) ListPubByCategory(ctx *gin.Context) (ginx.Result, error) {
+	category := strings.TrimSpace(ctx.Query("category"))
+	if category == "" {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	offset, limit := pageQuery(ctx)
+	arts, err := u.svc.ListPubByCategory(ctx, category, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(arts, func(idx int, src domain.Article) ArticleVO {
+			return toPubListVO(src)
+		}),
+	}, nil
+}
+
+// ListTags 标签以及标签下的帖子数量
+func (u *ArticleHandler) ListTags(ctx *gin.Context) (ginx.Result, error) {
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "50"))
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	tags, err := u.svc.ListTags(ctx, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(tags, func(idx int, src domain.TagCount) TagCountVO {
+			return TagCountVO{
+				Tag: src.Tag,
+				Cnt: src.Cnt,
+			}
+		}),
+	}, nil
+}
+
 // Like 点赞或取消点赞
 func (u *ArticleHandler) Like(ctx *gin.Context, req LikeReq, uc ijwt.UserClaims) (ginx.Result, error) {
 	var err error
@@ -104,8 +432,27 @@ func (u *ArticleHandler) PubDetail(ctx *gin.Context) {
 		})
 		return err
 	})
+	var series domain.Series
+	eg.Go(func() error {
+		// 系列导航查不到也不影响看帖子
+		var er error
+		series, er = u.seriesSvc.GetByArticle(ctx, id)
+		if er != nil && !errors.Is(er, service.ErrSeriesNotFound) {
+			u.l.Error("查询帖子所在的系列失败",
+				logger.Int64("artId", id),
+				logger.Error(er))
+		}
+		return nil
+	})
 	// 在这儿等，要保证前面两个
 	err = eg.Wait()
+	if errors.Is(err, service.ErrArticleNotFound) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "帖子不存在",
+		})
+		return
+	}
 	if err != nil {
 		// 代表查询出错了
 		ctx.JSON(http.StatusOK, Result{
@@ -133,7 +480,12 @@ func (u *ArticleHandler) PubDetail(ctx *gin.Context) {
 			Title:      art.Title,
 			Status:     art.Status.ToUint8(),
 			Content:    art.Content,
+			Html:       art.HTML,
+			Toc:        toTocVO(art.TOC),
 			Author:     art.Author.Name, // 要把作者信息带出去
+			Category:   art.Category,
+			Tags:       art.Tags,
+			Series:     toArticleSeriesVO(series, art.Id),
 			Ctime:      art.Ctime.Format(time.DateTime),
 			Utime:      art.Utime.Format(time.DateTime),
 			Liked:      intr.Liked,
@@ -141,6 +493,7 @@ func (u *ArticleHandler) PubDetail(ctx *gin.Context) {
 			LikeCnt:    intr.LikeCnt,
 			ReadCnt:    intr.ReadCnt,
 			CollectCnt: intr.CollectCnt,
+			CommentCnt: intr.CommentCnt,
 		},
 	})
 }
@@ -155,20 +508,27 @@ func (u *ArticleHandler) Detail(ctx *gin.Context, claims ijwt.UserClaims) (ginx.
 			Msg:  "系统错误",
 		}, err
 	}
-	art, err := u.svc.GetById(ctx, artId)
+	// 创作者和协作者都能看，查看者也可以
+	art, err := u.collabSvc.Authorize(ctx, claims.Uid, artId, domain.ArticleRoleViewer)
+	if errors.Is(err, service.ErrArticleForbidden) {
+		return ginx.Result{
+			Code: 4,
+			// 也不需要告诉前端究竟发生了什么
+			Msg: "输入有误",
+		}, fmt.Errorf("非法访问文章，没有权限 %d", claims.Uid)
+	}
 	if err != nil {
 		return ginx.Result{
 			Code: 5,
 			Msg:  "系统错误",
 		}, err
 	}
-	// 判定登录用户是否时这篇帖子的创作者
-	if claims.Uid != art.Author.Id {
+	role, err := u.collabSvc.Role(ctx, claims.Uid, art)
+	if err != nil {
 		return ginx.Result{
-			Code: 4,
-			// 也不需要告诉前端究竟发生了什么
-			Msg: "输入有误",
-		}, fmt.Errorf("非法访问文章，创作者 ID 不匹配 %d", claims.Uid)
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
 	}
 	return ginx.Result{
 		Data: ArticleVO{
@@ -180,14 +540,21 @@ func (u *ArticleHandler) Detail(ctx *gin.Context, claims ijwt.UserClaims) (ginx.
 			Content: art.Content,
 			// 这个是创作者看自己的文章列表，也不需要这个字段
 			//Author: art.Author
-			Ctime: art.Ctime.Format(time.DateTime),
-			Utime: art.Utime.Format(time.DateTime),
+			Category: art.Category,
+			Tags:     art.Tags,
+			Role:     role.String(),
+			Version:  art.Version,
+			Ctime:    art.Ctime.Format(time.DateTime),
+			Utime:    art.Utime.Format(time.DateTime),
 		},
 	}, nil
 }
 
 // List 创作者文章列表
 func (u *ArticleHandler) List(ctx *gin.Context, req ListReq, claims ijwt.UserClaims) (ginx.Result, error) {
+	if req.Cursor != nil {
+		return u.listByCursor(ctx, req, claims)
+	}
 	var res []domain.Article
 	res, err := u.svc.List(ctx, claims.Uid, req.Offset, req.Limit)
 	if err != nil {
@@ -200,19 +567,44 @@ func (u *ArticleHandler) List(ctx *gin.Context, req ListReq, claims ijwt.UserCla
 	return ginx.Result{
 		Data: slice.Map[domain.Article, ArticleVO](res,
 			func(idx int, src domain.Article) ArticleVO {
-				return ArticleVO{
-					Id:       src.Id,
-					Title:    src.Title,
-					Abstract: src.Abstract(),
-					Status:   src.Status.ToUint8(),
-					// 这个列表请求，不需要返回内容
-					//Content: src.Content,
-					// 这个是创作者看自己的文章列表，也不需要这个字段
-					//Author: src.Author
-					Ctime: src.Ctime.Format(time.DateTime),
-					Utime: src.Utime.Format(time.DateTime),
-				}
+				return toAuthorListVO(src, claims.Uid)
+			}),
+	}, nil
+}
+
+// listByCursor 带上了 cursor 字段就是游标翻页，第一页传空字符串
+func (u *ArticleHandler) listByCursor(ctx *gin.Context, req ListReq, claims ijwt.UserClaims) (ginx.Result, error) {
+	cursor, err := decodeArticleCursor(*req.Cursor)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	res, err := u.svc.ListByCursor(ctx, claims.Uid, cursor, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	vo := ArticleListVO{
+		Articles: slice.Map[domain.Article, ArticleVO](res,
+			func(idx int, src domain.Article) ArticleVO {
+				return toAuthorListVO(src, claims.Uid)
 			}),
+		// 查出来的数量刚好等于 limit，就认为还有下一页
+		HasMore: len(res) == limit,
+	}
+	if len(res) > 0 {
+		vo.NextCursor = encodeArticleCursor(res[len(res)-1].Cursor())
+	}
+	return ginx.Result{
+		Data: vo,
 	}, nil
 }
 
@@ -226,22 +618,46 @@ func (u *ArticleHandler) Edit(ctx *gin.Context) {
 		ctx.String(http.StatusBadRequest, "参数有误")
 		return
 	}
-	// 取jwt claims
-	c := ctx.MustGet("claims")
-	claims, ok := c.(*ijwt.UserClaims)
+	// 取jwt claims，登录校验的中间件放进去的是值，不是指针
+	claims, ok := ctx.MustGet("claims").(ijwt.UserClaims)
 	if !ok {
 		u.l.Error("handler中未拿到 user claims")
 		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	if !req.validTaxonomy() {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "分类或标签不合法",
+		})
+		return
+	}
+	if !req.validVersion() {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "缺少版本号，请刷新之后再编辑",
+		})
+		return
+	}
+	owner, ok := u.owner(ctx, claims.Uid, req.Id)
+	if !ok {
+		return
+	}
+	// 业务处理，协作者编辑的时候帖子还是创作者的
+	id, err := u.svc.Store(ctx, req.toDomain(owner))
+	if errors.Is(err, service.ErrVersionConflict) {
+		u.conflict(ctx, req.Id)
+		return
 	}
-	// 业务处理
-	id, err := u.svc.Store(ctx, req.toDomain(claims.Uid))
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
 			Msg:  "系统错误",
 		})
-
+		return
 	}
+	u.bindAttachments(ctx, claims.Uid, id, req.Content, false)
+	u.articleChanged(ctx, req.Id)
 	ctx.JSON(http.StatusOK, Result{
 		Code: 2,
 		Msg:  "OK",
@@ -259,15 +675,45 @@ func (u *ArticleHandler) Publish(ctx *gin.Context) {
 		ctx.String(http.StatusBadRequest, "参数有误")
 		return
 	}
-	// 取jwt claims
-	c := ctx.MustGet("claims")
-	claims, ok := c.(*ijwt.UserClaims)
+	// 取jwt claims，登录校验的中间件放进去的是值，不是指针
+	claims, ok := ctx.MustGet("claims").(ijwt.UserClaims)
 	if !ok {
 		u.l.Error("handler中未拿到 user claims")
 		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	if !req.validTaxonomy() {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "分类或标签不合法",
+		})
+		return
+	}
+	if !req.validVersion() {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "缺少版本号，请刷新之后再编辑",
+		})
+		return
+	}
+	owner, ok := u.owner(ctx, claims.Uid, req.Id)
+	if !ok {
+		return
 	}
 	// 业务处理
-	id, err := u.svc.Publish(ctx, req.toDomain(claims.Uid))
+	if req.PublishAt > 0 {
+		u.schedule(ctx, req, claims.Uid, owner)
+		return
+	}
+	id, err := u.svc.Publish(ctx, req.toDomain(owner))
+	if errors.Is(err, service.ErrVersionConflict) {
+		u.conflict(ctx, req.Id)
+		return
+	}
+	if errors.Is(err, service.ErrArticleUnderReview) || errors.Is(err, service.ErrArticleBlocked) {
+		u.held(ctx, req, claims.Uid, id, err)
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -277,6 +723,8 @@ func (u *ArticleHandler) Publish(ctx *gin.Context) {
 		//u.l.Error("保存帖子失败", logger.Error(err))
 		return
 	}
+	u.bindAttachments(ctx, claims.Uid, id, req.Content, true)
+	u.articleChanged(ctx, req.Id)
 	ctx.JSON(http.StatusOK, Result{
 		Code: 2,
 		Msg:  "OK",
@@ -284,6 +732,145 @@ func (u *ArticleHandler) Publish(ctx *gin.Context) {
 	})
 }
 
+// held 没有通过自动审核，帖子已经保存下来了，只是没有发表
+// 要人工审核的用 7 和发表成功区分开，原因在 /articles/reviews/:id 里面
+func (u *ArticleHandler) held(ctx *gin.Context, req ArticleReq, uid, id int64, err error) {
+	u.bindAttachments(ctx, uid, id, req.Content, false)
+	u.articleChanged(ctx, req.Id)
+	if errors.Is(err, service.ErrArticleBlocked) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "帖子包含违规内容，已经保存为草稿",
+			Data: id,
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 7,
+		Msg:  "帖子需要人工审核，审核通过之后才会发表",
+		Data: id,
+	})
+}
+
+// schedule 带了 publish_at 的发表请求，先存起来，到点了再发表
+func (u *ArticleHandler) schedule(ctx *gin.Context, req ArticleReq, uid, owner int64) {
+	id, err := u.svc.Schedule(ctx, req.toDomain(owner), time.UnixMilli(req.PublishAt))
+	if err == service.ErrInvalidPublishTime {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "定时发表的时间必须在将来",
+		})
+		return
+	}
+	if errors.Is(err, service.ErrVersionConflict) {
+		u.conflict(ctx, req.Id)
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		u.l.Error("定时发表帖子失败", logger.Error(err))
+		return
+	}
+	u.bindAttachments(ctx, uid, id, req.Content, false)
+	u.articleChanged(ctx, req.Id)
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "OK",
+		Data: id,
+	})
+}
+
+// conflict 版本冲突，用 6 和其它错误区分开，Data 里面是服务端最新的帖子
+// 前端合并之后带上最新的版本号再提交
+func (u *ArticleHandler) conflict(ctx *gin.Context, artId int64) {
+	art, err := u.svc.GetById(ctx, artId)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		u.l.Error("查询版本冲突的帖子失败",
+			logger.Int64("art_id", artId),
+			logger.Error(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 6,
+		Msg:  "帖子已经被修改过了，请合并之后再保存",
+		Data: ArticleVO{
+			Id:       art.Id,
+			Title:    art.Title,
+			Status:   art.Status.ToUint8(),
+			Content:  art.Content,
+			Category: art.Category,
+			Tags:     art.Tags,
+			Version:  art.Version,
+			Ctime:    art.Ctime.Format(time.DateTime),
+			Utime:    art.Utime.Format(time.DateTime),
+		},
+	})
+}
+
+// owner 修改已有的帖子要有编辑的权限，返回帖子的创作者，新建的帖子创作者就是自己
+// 没有权限或者出错的时候已经写好了响应，返回 false
+func (u *ArticleHandler) owner(ctx *gin.Context, uid, artId int64) (int64, bool) {
+	if artId <= 0 {
+		return uid, true
+	}
+	art, err := u.collabSvc.Authorize(ctx, uid, artId, domain.ArticleRoleEditor)
+	switch {
+	case err == nil:
+		return art.Author.Id, true
+	case errors.Is(err, service.ErrArticleForbidden):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "没有编辑这篇帖子的权限",
+		})
+		u.l.Warn("非法编辑帖子",
+			logger.Int64("uid", uid),
+			logger.Int64("art_id", artId))
+	default:
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		u.l.Error("查询帖子的协作者失败", logger.Error(err))
+	}
+	return 0, false
+}
+
+// articleChanged 修改已有的帖子之后，协作者的帖子列表缓存也要删掉，失败了只记录日志
+func (u *ArticleHandler) articleChanged(ctx *gin.Context, artId int64) {
+	if artId <= 0 {
+		return
+	}
+	err := u.collabSvc.ArticleChanged(ctx, artId)
+	if err != nil {
+		u.l.Error("删除协作者帖子列表缓存失败",
+			logger.Int64("art_id", artId),
+			logger.Error(err))
+	}
+}
+
+// bindAttachments 记录帖子引用了哪些图片，失败了只会影响图片的回收，不影响保存帖子
+// 只有发表的时候才会解除不再引用的图片，草稿里面删掉的图片线上可能还在用
+func (u *ArticleHandler) bindAttachments(ctx *gin.Context, uid, artId int64, content string, published bool) {
+	var err error
+	if published {
+		err = u.attachSvc.SyncArticle(ctx, uid, artId, content)
+	} else {
+		err = u.attachSvc.BindArticle(ctx, uid, artId, content)
+	}
+	if err != nil {
+		u.l.Error("记录帖子引用的图片失败",
+			logger.Int64("art_id", artId),
+			logger.Error(err))
+	}
+}
+
 func (u *ArticleHandler) Withdraw(ctx *gin.Context) {
 	// 参数接收 & 校验
 	type Req struct {
@@ -296,18 +883,22 @@ func (u *ArticleHandler) Withdraw(ctx *gin.Context) {
 		ctx.String(http.StatusBadRequest, "参数有误")
 		return
 	}
-	// 取jwt claims
-	c := ctx.MustGet("claims")
-	claims, ok := c.(*ijwt.UserClaims)
+	// 取jwt claims，登录校验的中间件放进去的是值，不是指针
+	claims, ok := ctx.MustGet("claims").(ijwt.UserClaims)
 	if !ok {
 		u.l.Error("handler中未拿到 user claims")
 		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	owner, ok := u.owner(ctx, claims.Uid, req.Id)
+	if !ok {
+		return
 	}
 	// 业务处理
 	err = u.svc.Withdraw(ctx, domain.Article{
 		Id: req.Id,
 		Author: domain.Author{
-			Id: claims.Uid,
+			Id: owner,
 		},
 	})
 	if err != nil {
@@ -317,8 +908,22 @@ func (u *ArticleHandler) Withdraw(ctx *gin.Context) {
 		})
 		return
 	}
+	u.articleChanged(ctx, req.Id)
 	ctx.JSON(http.StatusOK, Result{
 		Code: 2,
 		Msg:  "OK",
 	})
 }
+
+// pageQuery 从查询参数里面拿分页参数，limit 不合法就用默认值
+func pageQuery(ctx *gin.Context) (int, int) {
+	offset, _ := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if offset < 0 {
+		offset = 0
+	}
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return offset, limit
+}
@@ -10,7 +10,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+	intrv1 "webookpro/api/proto/gen/intr/v1"
+	intrv1mocks "webookpro/api/proto/gen/intr/v1/mocks"
 	"webookpro/internal/domain"
+	"webookpro/internal/repository/article"
+	artrepomocks "webookpro/internal/repository/article/mocks"
 	"webookpro/internal/service"
 	svcmocks "webookpro/internal/service/mock"
 	"webookpro/internal/web/jwt"
@@ -21,6 +26,7 @@ func TestArticleHandler_Publish(t *testing.T) {
 	testcases := []struct {
 		name     string
 		mock     func(controller *gomock.Controller) service.ArticleService
+		collab   func(controller *gomock.Controller) service.CollaboratorService
 		reqBody  string
 		wantCode int
 		wantRes  Result
@@ -48,13 +54,204 @@ func TestArticleHandler_Publish(t *testing.T) {
 				Data: float64(1),
 			},
 		},
+		{
+			name: "带分类和标签发表，重复的标签会被去掉",
+			reqBody: `
+				{"title":"我的标题","content":"我的内容","category":" 后端 ","tags":["Go"," Go","","MySQL"]}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Publish(gomock.Any(), domain.Article{
+					Title:    "我的标题",
+					Content:  "我的内容",
+					Category: "后端",
+					Tags:     []string{"Go", "MySQL"},
+					Author: domain.Author{
+						Id: 123,
+					},
+				}).Return(int64(1), nil)
+				return artSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "OK",
+				Data: float64(1),
+			},
+		},
+		{
+			name: "编辑发表别人的帖子，帖子还是创作者的",
+			reqBody: `
+				{"id":2,"title":"我的标题","content":"我的内容","version":3}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Publish(gomock.Any(), domain.Article{
+					Id:      2,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author: domain.Author{
+						Id: 234,
+					},
+					Version: 3,
+				}).Return(int64(2), nil)
+				return artSvc
+			},
+			collab: func(ctrl *gomock.Controller) service.CollaboratorService {
+				collabSvc := svcmocks.NewMockCollaboratorService(ctrl)
+				collabSvc.EXPECT().Authorize(gomock.Any(), int64(123), int64(2), domain.ArticleRoleEditor).
+					Return(domain.Article{Id: 2, Author: domain.Author{Id: 234}}, nil)
+				collabSvc.EXPECT().ArticleChanged(gomock.Any(), int64(2)).Return(nil)
+				return collabSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "OK",
+				Data: float64(2),
+			},
+		},
+		{
+			name: "查看者不能发表",
+			reqBody: `
+				{"id":2,"title":"我的标题","content":"我的内容","version":3}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				return svcmocks.NewMockArticleService(ctrl)
+			},
+			collab: func(ctrl *gomock.Controller) service.CollaboratorService {
+				collabSvc := svcmocks.NewMockCollaboratorService(ctrl)
+				collabSvc.EXPECT().Authorize(gomock.Any(), int64(123), int64(2), domain.ArticleRoleEditor).
+					Return(domain.Article{}, service.ErrArticleForbidden)
+				return collabSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "没有编辑这篇帖子的权限",
+			},
+		},
+		{
+			name: "版本冲突，带上服务端最新的帖子",
+			reqBody: `
+				{"id":2,"title":"我的标题","content":"我的内容","version":3}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Publish(gomock.Any(), gomock.Any()).
+					Return(int64(0), service.ErrVersionConflict)
+				artSvc.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:      2,
+					Title:   "别人改的标题",
+					Content: "别人改的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+					Version: 4,
+					Ctime:   time.UnixMilli(1700000000000),
+					Utime:   time.UnixMilli(1700000000000),
+				}, nil)
+				return artSvc
+			},
+			collab: func(ctrl *gomock.Controller) service.CollaboratorService {
+				collabSvc := svcmocks.NewMockCollaboratorService(ctrl)
+				collabSvc.EXPECT().Authorize(gomock.Any(), int64(123), int64(2), domain.ArticleRoleEditor).
+					Return(domain.Article{Id: 2, Author: domain.Author{Id: 123}}, nil)
+				return collabSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 6,
+				Msg:  "帖子已经被修改过了，请合并之后再保存",
+				Data: map[string]any{
+					"id":          float64(2),
+					"title":       "别人改的标题",
+					"abstract":    "",
+					"content":     "别人改的内容",
+					"status":      float64(1),
+					"author":      "",
+					"read_cnt":    float64(0),
+					"like_cnt":    float64(0),
+					"collect_cnt": float64(0),
+					"comment_cnt": float64(0),
+					"liked":       false,
+					"collected":   false,
+					"version":     float64(4),
+					"ctime":       time.UnixMilli(1700000000000).Format(time.DateTime),
+					"utime":       time.UnixMilli(1700000000000).Format(time.DateTime),
+				},
+			},
+		},
+		{
+			name: "要人工审核",
+			reqBody: `
+				{"title":"我的标题","content":"我的内容"}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Publish(gomock.Any(), gomock.Any()).
+					Return(int64(1), service.ErrArticleUnderReview)
+				return artSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 7,
+				Msg:  "帖子需要人工审核，审核通过之后才会发表",
+				Data: float64(1),
+			},
+		},
+		{
+			name: "包含违规内容",
+			reqBody: `
+				{"title":"我的标题","content":"我的内容"}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				artSvc.EXPECT().Publish(gomock.Any(), gomock.Any()).
+					Return(int64(1), service.ErrArticleBlocked)
+				return artSvc
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "帖子包含违规内容，已经保存为草稿",
+				Data: float64(1),
+			},
+		},
+		{
+			name: "编辑已有的帖子没有带版本号",
+			reqBody: `
+				{"id":2,"title":"我的标题","content":"我的内容"}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				return svcmocks.NewMockArticleService(ctrl)
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "缺少版本号，请刷新之后再编辑",
+			},
+		},
+		{
+			name: "标签太多",
+			reqBody: `
+				{"title":"我的标题","content":"我的内容","tags":["a","b","c","d","e","f"]}
+				`,
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				return svcmocks.NewMockArticleService(ctrl)
+			},
+			wantCode: 200,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "分类或标签不合法",
+			},
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			server := gin.Default()
 			server.Use(func(context *gin.Context) {
-				context.Set("claims", &jwt.UserClaims{
+				context.Set("claims", jwt.UserClaims{
 					Uid: 123,
 				})
 			})
@@ -62,7 +259,16 @@ func TestArticleHandler_Publish(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			artSvc := tc.mock(ctrl)
-			artHdl := NewArticleHandler(artSvc, nil, &logger.NopLogger{})
+			attachSvc := svcmocks.NewMockAttachmentService(ctrl)
+			attachSvc.EXPECT().SyncArticle(gomock.Any(), int64(123), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+			attachSvc.EXPECT().BindArticle(gomock.Any(), int64(123), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+			var collabSvc service.CollaboratorService = svcmocks.NewMockCollaboratorService(ctrl)
+			if tc.collab != nil {
+				collabSvc = tc.collab(ctrl)
+			}
+			artHdl := NewArticleHandler(artSvc, attachSvc, nil, collabSvc, nil, &logger.NopLogger{})
 			artHdl.RegisterRoutes(server)
 
 			recorder := httptest.NewRecorder()
@@ -79,3 +285,92 @@ func TestArticleHandler_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestArticleHandler_PubDetail(t *testing.T) {
+	testcases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) article.ArticleRepository
+
+		wantCode int
+		wantRes  Result
+	}{
+		{
+			name: "仅自己可见的帖子",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:      1,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Status:  domain.ArticleStatusPrivate,
+				}, nil)
+				return repo
+			},
+			wantCode: http.StatusOK,
+			wantRes:  Result{Code: 4, Msg: "帖子不存在"},
+		},
+		{
+			name: "删除了的帖子",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := artrepomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetPublishedById(gomock.Any(), int64(1)).
+					Return(domain.Article{}, article.ErrRecordNotFound)
+				return repo
+			},
+			wantCode: http.StatusOK,
+			wantRes:  Result{Code: 4, Msg: "帖子不存在"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := gin.Default()
+			server.Use(func(context *gin.Context) {
+				context.Set("claims", jwt.UserClaims{
+					Uid: 123,
+				})
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			artSvc := service.NewArticleService(tc.mock(ctrl), nil, nil, nil, nil, &logger.NopLogger{})
+			intrSvc := intrv1mocks.NewMockInteractiveServiceClient(ctrl)
+			intrSvc.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(&intrv1.GetResponse{Intr: &intrv1.Interactive{}}, nil)
+			seriesSvc := svcmocks.NewMockSeriesService(ctrl)
+			seriesSvc.EXPECT().GetByArticle(gomock.Any(), int64(1)).
+				Return(domain.Series{}, service.ErrSeriesNotFound)
+			artHdl := NewArticleHandler(artSvc, nil, seriesSvc, nil, intrSvc, &logger.NopLogger{})
+			artHdl.RegisterRoutes(server)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "/articles/pub/1", nil)
+			require.NoError(t, err)
+			server.ServeHTTP(recorder, req)
+
+			var res Result
+			err = json.NewDecoder(recorder.Body).Decode(&res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCode, recorder.Code)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestArticleCursor(t *testing.T) {
+	utime := time.UnixMilli(1700000000123)
+	token := encodeArticleCursor(domain.ArticleCursor{Utime: utime, Id: 12})
+	cursor, err := decodeArticleCursor(token)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ArticleCursor{Utime: utime, Id: 12}, cursor)
+
+	// 空字符串就是第一页
+	cursor, err = decodeArticleCursor("")
+	require.NoError(t, err)
+	assert.True(t, cursor.IsZero())
+
+	for _, token := range []string{"abc", "MTIz", "MTJfYQ", "MF8w"} {
+		_, err = decodeArticleCursor(token)
+		assert.Error(t, err, token)
+	}
+}
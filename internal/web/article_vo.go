@@ -1,6 +1,16 @@
 package web
 
-import "webookpro/internal/domain"
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/ecodeclub/ekit/slice"
+	"strconv"
+	"strings"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/pkg/markdownx"
+)
 
 // VO view object，就是对标前端的
 
@@ -11,6 +21,9 @@ type ArticleVO struct {
 	Abstract string `json:"abstract"`
 	// 内容
 	Content string `json:"content"`
+	// Html 渲染好了的内容，已经是安全的了，前端直接展示就可以，只有线上库的详情和分享链接才有
+	Html string  `json:"html,omitempty"`
+	Toc  []TocVO `json:"toc,omitempty"`
 	// 注意一点，状态这个东西，可以是前端来处理，也可以是后端处理
 	// 0 -> unknown -> 未知状态
 	// 1 -> 未发表，手机 APP 这种涉及到发版的问题，那么后端来处理
@@ -22,15 +35,53 @@ type ArticleVO struct {
 	ReadCnt    int64 `json:"read_cnt"`
 	LikeCnt    int64 `json:"like_cnt"`
 	CollectCnt int64 `json:"collect_cnt"`
+	CommentCnt int64 `json:"comment_cnt"`
 
 	// 我个人有没有收藏，有没有点赞
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
 
+	// 定时发表的时间，只有定时发表的帖子才有
+	PublishAt string `json:"publish_at,omitempty"`
+
+	// 回收站里面的帖子才有，Dtime 是删除的时间，过了 PurgeAt 就不能恢复了
+	Dtime   string `json:"dtime,omitempty"`
+	PurgeAt string `json:"purge_at,omitempty"`
+
+	Category string   `json:"category,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+
+	// 帖子所在的系列，只有线上库的详情才有
+	Series *ArticleSeriesVO `json:"series,omitempty"`
+
+	// Collaborating 创作者的帖子列表里面，别人的帖子自己只是协作者
+	Collaborating bool `json:"collaborating,omitempty"`
+	// Role 创作者详情里面，自己在这篇帖子上的角色，owner、editor 或者 viewer
+	Role string `json:"role,omitempty"`
+	// Version 制作库的版本号，只有创作者详情才有，编辑的时候要原样带回来
+	Version int64 `json:"version,omitempty"`
+
 	Ctime string `json:"ctime"`
 	Utime string `json:"utime"`
 }
 
+// TocVO 目录里面的一个标题，Id 就是 Html 里面对应标题的锚点
+type TocVO struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func toTocVO(toc []markdownx.Heading) []TocVO {
+	return slice.Map(toc, func(idx int, src markdownx.Heading) TocVO {
+		return TocVO{
+			Level: src.Level,
+			Id:    src.Id,
+			Title: src.Title,
+		}
+	})
+}
+
 type LikeReq struct {
 	Id int64 `json:"id"`
 	// 点赞和取消点赞，我都准备复用这个
@@ -40,21 +91,172 @@ type LikeReq struct {
 type ListReq struct {
 	Offset int `json:"offset"`
 	Limit  int `json:"limit"`
+	// Cursor 带上这个字段就是游标翻页，会忽略 Offset，第一页传空字符串
+	// 不带就还是老的 offset 翻页
+	Cursor *string `json:"cursor"`
+}
+
+// ArticleListVO 游标翻页的返回值
+type ArticleListVO struct {
+	Articles []ArticleVO `json:"articles"`
+	// NextCursor 下一页要带上的 cursor，前端不需要关心里面是什么
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// encodeArticleCursor 游标对前端是不透明的，里面是 utime 毫秒数和 id
+func encodeArticleCursor(c domain.ArticleCursor) string {
+	raw := fmt.Sprintf("%d_%d", c.Utime.UnixMilli(), c.Id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeArticleCursor(token string) (domain.ArticleCursor, error) {
+	if token == "" {
+		return domain.ArticleCursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return domain.ArticleCursor{}, err
+	}
+	utimeStr, idStr, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return domain.ArticleCursor{}, errors.New("非法的游标")
+	}
+	utime, err := strconv.ParseInt(utimeStr, 10, 64)
+	if err != nil {
+		return domain.ArticleCursor{}, err
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return domain.ArticleCursor{}, err
+	}
+	if utime <= 0 || id <= 0 {
+		return domain.ArticleCursor{}, errors.New("非法的游标")
+	}
+	return domain.ArticleCursor{
+		Utime: time.UnixMilli(utime),
+		Id:    id,
+	}, nil
 }
 
 type ArticleReq struct {
 	Id      int64  `json:"id"`
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	// PublishAt 定时发表的时间，毫秒数，不传就是立刻发表
+	PublishAt int64    `json:"publish_at"`
+	Category  string   `json:"category"`
+	Tags      []string `json:"tags"`
+	// Version 编辑已有的帖子必须带上详情里面拿到的版本号，保存成功之后版本号加一
+	Version int64 `json:"version"`
+}
+
+// validVersion 新建的帖子不需要版本号
+func (req ArticleReq) validVersion() bool {
+	return req.Id <= 0 || req.Version > 0
+}
+
+// validTaxonomy 校验分类和标签，重复的标签不算数
+func (req ArticleReq) validTaxonomy() bool {
+	return req.toDomain(0).ValidTaxonomy()
 }
 
 func (req ArticleReq) toDomain(uid int64) domain.Article {
 	return domain.Article{
-		Id:      req.Id,
-		Title:   req.Title,
-		Content: req.Content,
+		Id:       req.Id,
+		Title:    req.Title,
+		Content:  req.Content,
+		Category: strings.TrimSpace(req.Category),
+		Tags:     domain.NormalizeTags(req.Tags),
 		Author: domain.Author{
 			Id: uid,
 		},
+		Version: req.Version,
+	}
+}
+
+// toAuthorListVO 创作者自己的列表页
+func toAuthorListVO(art domain.Article, uid int64) ArticleVO {
+	return ArticleVO{
+		Id:       art.Id,
+		Title:    art.Title,
+		Abstract: art.Abstract(),
+		Status:   art.Status.ToUint8(),
+		// 这个列表请求，不需要返回内容
+		//Content: src.Content,
+		// 这个是创作者看自己的文章列表，也不需要这个字段
+		//Author: src.Author
+		Collaborating: art.Author.Id != uid,
+		Ctime:         art.Ctime.Format(time.DateTime),
+		Utime:         art.Utime.Format(time.DateTime),
+	}
+}
+
+// toPubListVO 线上库列表页，不需要返回内容
+func toPubListVO(art domain.Article) ArticleVO {
+	return ArticleVO{
+		Id:       art.Id,
+		Title:    art.Title,
+		Abstract: art.Abstract(),
+		Status:   art.Status.ToUint8(),
+		Category: art.Category,
+		Tags:     art.Tags,
+		Ctime:    art.Ctime.Format(time.DateTime),
+		Utime:    art.Utime.Format(time.DateTime),
+	}
+}
+
+type TagCountVO struct {
+	Tag string `json:"tag"`
+	Cnt int64  `json:"cnt"`
+}
+
+// ArticleIdReq 只需要帖子 ID 的请求，比如删除和从回收站恢复
+type ArticleIdReq struct {
+	Id int64 `json:"id"`
+}
+
+type ScheduleReq struct {
+	Id int64 `json:"id"`
+	// PublishAt 毫秒数
+	PublishAt int64 `json:"publish_at"`
+}
+
+type ArticleRevisionVO struct {
+	Id        int64  `json:"id"`
+	ArticleId int64  `json:"article_id"`
+	Title     string `json:"title"`
+	// 列表页只返回摘要，diff 的时候才需要完整的内容
+	Abstract string `json:"abstract"`
+	Status   uint8  `json:"status"`
+	Utime    string `json:"utime"`
+}
+
+type DiffLineVO struct {
+	// " " 代表没变，"+" 代表新增，"-" 代表删除
+	Op    string `json:"op"`
+	Text  string `json:"text"`
+	OldNo int    `json:"old_no"`
+	NewNo int    `json:"new_no"`
+}
+
+type ArticleRevisionDiffVO struct {
+	From  ArticleRevisionVO `json:"from"`
+	To    ArticleRevisionVO `json:"to"`
+	Lines []DiffLineVO      `json:"lines"`
+}
+
+type RestoreRevisionReq struct {
+	RevisionId int64 `json:"revision_id"`
+}
+
+func toArticleRevisionVO(rev domain.ArticleRevision) ArticleRevisionVO {
+	return ArticleRevisionVO{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Title:     rev.Title,
+		Abstract:  domain.Article{Content: rev.Content}.Abstract(),
+		Status:    rev.Status.ToUint8(),
+		Utime:     rev.Utime.Format(time.DateTime),
 	}
 }
@@ -0,0 +1,128 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strings"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*AttachmentHandler)(nil)
+
+type AttachmentHandler struct {
+	svc service.AttachmentService
+	l   logger.Logger
+}
+
+func NewAttachmentHandler(svc service.AttachmentService, l logger.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *AttachmentHandler) RegisterRoutes(server *gin.Engine) {
+	ag := server.Group("/attachments")
+	ag.POST("/upload", ginx.WrapToken[ijwt.UserClaims](h.Upload))
+	// 帖子里面的图片，不需要登录
+	ag.GET("/files/*key", h.File)
+}
+
+// Upload 上传图片，表单字段是 file
+// 返回的地址直接写到帖子内容里面，保存帖子的时候会记下来帖子引用了哪些图片
+func (h *AttachmentHandler) Upload(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	if fh.Size > service.MaxAttachmentSize {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "图片不能超过 5M",
+		}, nil
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	defer f.Close()
+	// 多读一个字节，超过大小限制的交给 service 判断
+	data, err := io.ReadAll(io.LimitReader(f, service.MaxAttachmentSize+1))
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	att, err := h.svc.Upload(ctx, uc.Uid, data)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: AttachmentVO{
+				Id:       att.Id,
+				Url:      att.URL(),
+				ThumbUrl: att.ThumbURL(),
+				MimeType: att.MimeType,
+				Size:     att.Size,
+				Width:    att.Width,
+				Height:   att.Height,
+			},
+		}, nil
+	case errors.Is(err, service.ErrUnsupportedAttachment):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "只支持 JPEG、PNG 和 GIF 图片",
+		}, nil
+	case errors.Is(err, service.ErrAttachmentTooLarge):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "图片太大",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// File 读取附件，key 是随机生成的，内容也不会变，所以可以让浏览器和 CDN 一直缓存
+func (h *AttachmentHandler) File(ctx *gin.Context) {
+	key := strings.TrimPrefix(ctx.Param("key"), "/")
+	data, contentType, err := h.svc.Get(ctx, key)
+	switch {
+	case err == nil:
+		ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+		ctx.Header("X-Content-Type-Options", "nosniff")
+		ctx.Data(http.StatusOK, contentType, data)
+	case errors.Is(err, service.ErrAttachmentNotFound):
+		ctx.Status(http.StatusNotFound)
+	default:
+		h.l.Error("读取附件失败",
+			logger.String("key", key),
+			logger.Error(err))
+		ctx.Status(http.StatusInternalServerError)
+	}
+}
+
+type AttachmentVO struct {
+	Id int64 `json:"id"`
+	// Url 以 domain.AttachmentURLPrefix 开头的地址，帖子里面引用图片要用这个地址
+	Url      string `json:"url"`
+	ThumbUrl string `json:"thumb_url"`
+	MimeType string `json:"mime_type"`
+	Size     int64  `json:"size"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+}
@@ -0,0 +1,194 @@
+package web
+
+import (
+	"errors"
+	regexp "github.com/dlclark/regexp2"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*BindingHandler)(nil)
+
+// BindingHandler 登录之后绑定、解绑邮箱和手机号，绑定微信在 OAuth2WechatHandler 里面
+type BindingHandler struct {
+	emailExp *regexp.Regexp
+	phoneExp *regexp.Regexp
+	svc      service.BindingService
+	l        logger.Logger
+}
+
+func NewBindingHandler(svc service.BindingService, l logger.Logger) *BindingHandler {
+	return &BindingHandler{
+		emailExp: regexp.MustCompile(emailRegexPattern, regexp.None),
+		phoneExp: regexp.MustCompile(phoneRegexPattern, regexp.None),
+		svc:      svc,
+		l:        l,
+	}
+}
+
+func (h *BindingHandler) RegisterRoutes(server *gin.Engine) {
+	bg := server.Group("/users/bindings")
+	bg.POST("/email/code/send", h.SendEmailCode)
+	bg.POST("/email", h.BindEmail)
+	bg.POST("/phone/code/send", h.SendPhoneCode)
+	bg.POST("/phone", h.BindPhone)
+	bg.POST("/unbind", h.Unbind)
+}
+
+type BindReq struct {
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+}
+
+func (h *BindingHandler) SendEmailCode(ctx *gin.Context) {
+	var req BindReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if !h.match(ctx, h.emailExp, req.Email, "邮箱格式不对") {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.SendEmailCode(ctx, uc.Uid, req.Email)
+	h.result(ctx, uc.Uid, err, "发送成功")
+}
+
+func (h *BindingHandler) BindEmail(ctx *gin.Context) {
+	var req BindReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if !h.match(ctx, h.emailExp, req.Email, "邮箱格式不对") {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.BindEmail(ctx, uc.Uid, req.Email, req.Code)
+	h.result(ctx, uc.Uid, err, "绑定成功")
+}
+
+func (h *BindingHandler) SendPhoneCode(ctx *gin.Context) {
+	var req BindReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if !h.match(ctx, h.phoneExp, req.Phone, "手机号格式不对") {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.SendPhoneCode(ctx, uc.Uid, req.Phone)
+	h.result(ctx, uc.Uid, err, "发送成功")
+}
+
+func (h *BindingHandler) BindPhone(ctx *gin.Context) {
+	var req BindReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if !h.match(ctx, h.phoneExp, req.Phone, "手机号格式不对") {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.BindPhone(ctx, uc.Uid, req.Phone, req.Code)
+	h.result(ctx, uc.Uid, err, "绑定成功")
+}
+
+// Unbind method 是 email、phone 或者 wechat
+func (h *BindingHandler) Unbind(ctx *gin.Context) {
+	type Req struct {
+		Method string `json:"method"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	var method domain.LoginMethod
+	switch req.Method {
+	case domain.LoginMethodEmail.String():
+		method = domain.LoginMethodEmail
+	case domain.LoginMethodPhone.String():
+		method = domain.LoginMethodPhone
+	case domain.LoginMethodWechat.String():
+		method = domain.LoginMethodWechat
+	default:
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "不支持的登录方式",
+		})
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.Unbind(ctx, uc.Uid, method)
+	h.result(ctx, uc.Uid, err, "解绑成功")
+}
+
+func (h *BindingHandler) match(ctx *gin.Context, exp *regexp.Regexp, val, msg string) bool {
+	ok, err := exp.MatchString(val)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return false
+	}
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  msg,
+		})
+	}
+	return ok
+}
+
+func (h *BindingHandler) result(ctx *gin.Context, uid int64, err error, msg string) {
+	if err == nil {
+		ctx.JSON(http.StatusOK, Result{
+			Msg: msg,
+		})
+		return
+	}
+	if res, ok := bindingErrResult(err); ok {
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+	h.l.Error("绑定或者解绑失败",
+		logger.Int64("uid", uid),
+		logger.Error(err))
+	ctx.JSON(http.StatusOK, Result{
+		Code: 5,
+		Msg:  "系统错误",
+	})
+}
+
+// bindingErrResult 绑定和解绑的业务错误，微信绑定也用这个
+func bindingErrResult(err error) (Result, bool) {
+	var msg string
+	switch {
+	case errors.Is(err, service.ErrUserDuplicateEmail):
+		msg = "这个邮箱已经绑定了别的账号"
+	case errors.Is(err, service.ErrUserDuplicatePhone):
+		msg = "这个手机号已经绑定了别的账号"
+	case errors.Is(err, service.ErrUserDuplicateWechat):
+		msg = "这个微信已经绑定了别的账号"
+	case errors.Is(err, service.ErrInvalidBindingCode):
+		msg = "验证码有误"
+	case errors.Is(err, service.ErrCodeSendTooMany):
+		msg = "发送太频繁，请稍后再试"
+	case errors.Is(err, service.ErrCodeVerifyTooManyTimes):
+		msg = "验证次数太多，请重新发送验证码"
+	case errors.Is(err, service.ErrLoginMethodNotBound):
+		msg = "还没有绑定"
+	case errors.Is(err, service.ErrUserLastLoginMethod):
+		msg = "至少要保留一种登录方式"
+	default:
+		return Result{}, false
+	}
+	return Result{
+		Code: 4,
+		Msg:  msg,
+	}, true
+}
@@ -0,0 +1,130 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+func TestBindingHandler_Unbind(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reqBody string
+		mock    func(ctrl *gomock.Controller) service.BindingService
+		wantRes Result
+	}{
+		{
+			name:    "解绑成功",
+			reqBody: `{"method":"phone"}`,
+			mock: func(ctrl *gomock.Controller) service.BindingService {
+				svc := svcmocks.NewMockBindingService(ctrl)
+				svc.EXPECT().Unbind(gomock.Any(), int64(123), domain.LoginMethodPhone).Return(nil)
+				return svc
+			},
+			wantRes: Result{Msg: "解绑成功"},
+		},
+		{
+			name:    "最后一种登录方式",
+			reqBody: `{"method":"wechat"}`,
+			mock: func(ctrl *gomock.Controller) service.BindingService {
+				svc := svcmocks.NewMockBindingService(ctrl)
+				svc.EXPECT().Unbind(gomock.Any(), int64(123), domain.LoginMethodWechat).
+					Return(service.ErrUserLastLoginMethod)
+				return svc
+			},
+			wantRes: Result{Code: 4, Msg: "至少要保留一种登录方式"},
+		},
+		{
+			name:    "不支持的登录方式",
+			reqBody: `{"method":"qq"}`,
+			wantRes: Result{Code: 4, Msg: "不支持的登录方式"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var svc service.BindingService = svcmocks.NewMockBindingService(ctrl)
+			if tc.mock != nil {
+				svc = tc.mock(ctrl)
+			}
+			res := doBindingReq(t, svc, "/users/bindings/unbind", tc.reqBody)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestBindingHandler_BindPhone(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reqBody string
+		mock    func(ctrl *gomock.Controller) service.BindingService
+		wantRes Result
+	}{
+		{
+			name:    "绑定成功",
+			reqBody: `{"phone":"13800000000","code":"123456"}`,
+			mock: func(ctrl *gomock.Controller) service.BindingService {
+				svc := svcmocks.NewMockBindingService(ctrl)
+				svc.EXPECT().BindPhone(gomock.Any(), int64(123), "13800000000", "123456").Return(nil)
+				return svc
+			},
+			wantRes: Result{Msg: "绑定成功"},
+		},
+		{
+			name:    "手机号被别人用了",
+			reqBody: `{"phone":"13800000000","code":"123456"}`,
+			mock: func(ctrl *gomock.Controller) service.BindingService {
+				svc := svcmocks.NewMockBindingService(ctrl)
+				svc.EXPECT().BindPhone(gomock.Any(), int64(123), "13800000000", "123456").
+					Return(service.ErrUserDuplicatePhone)
+				return svc
+			},
+			wantRes: Result{Code: 4, Msg: "这个手机号已经绑定了别的账号"},
+		},
+		{
+			name:    "手机号格式不对",
+			reqBody: `{"phone":"123","code":"123456"}`,
+			wantRes: Result{Code: 4, Msg: "手机号格式不对"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var svc service.BindingService = svcmocks.NewMockBindingService(ctrl)
+			if tc.mock != nil {
+				svc = tc.mock(ctrl)
+			}
+			res := doBindingReq(t, svc, "/users/bindings/phone", tc.reqBody)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func doBindingReq(t *testing.T, svc service.BindingService, path, body string) Result {
+	server := gin.Default()
+	server.Use(func(ctx *gin.Context) {
+		ctx.Set("claims", ijwt.UserClaims{Uid: 123})
+	})
+	NewBindingHandler(svc, &logger.NopLogger{}).RegisterRoutes(server)
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer([]byte(body)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	server.ServeHTTP(recorder, req)
+	var res Result
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	return res
+}
@@ -74,6 +74,22 @@ func (g *GreyScaleInteractiveServiceClient) GetByIds(ctx context.Context, in *in
 	return g.client().GetByIds(ctx, in, opts...)
 }
 
+func (g *GreyScaleInteractiveServiceClient) IncrCommentCnt(ctx context.Context, in *intrv1.IncrCommentCntRequest, opts ...grpc.CallOption) (*intrv1.IncrCommentCntResponse, error) {
+	return g.client().IncrCommentCnt(ctx, in, opts...)
+}
+
+func (g *GreyScaleInteractiveServiceClient) Delete(ctx context.Context, in *intrv1.DeleteRequest, opts ...grpc.CallOption) (*intrv1.DeleteResponse, error) {
+	return g.client().Delete(ctx, in, opts...)
+}
+
+func (g *GreyScaleInteractiveServiceClient) MergeUser(ctx context.Context, in *intrv1.MergeUserRequest, opts ...grpc.CallOption) (*intrv1.MergeUserResponse, error) {
+	return g.client().MergeUser(ctx, in, opts...)
+}
+
+func (g *GreyScaleInteractiveServiceClient) ListUserRecords(ctx context.Context, in *intrv1.ListUserRecordsRequest, opts ...grpc.CallOption) (*intrv1.ListUserRecordsResponse, error) {
+	return g.client().ListUserRecords(ctx, in, opts...)
+}
+
 func (g *GreyScaleInteractiveServiceClient) UpdateThreshold(newThreshold int32) {
 	g.threshold.Store(newThreshold)
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc"
 	intrv1 "webookpro/api/proto/gen/intr/v1"
 	domain2 "webookpro/interactive/domain"
@@ -61,6 +62,32 @@ func (i *InteractiveServiceAdapter) GetByIds(ctx context.Context, in *intrv1.Get
 	}, nil
 }
 
+func (i *InteractiveServiceAdapter) IncrCommentCnt(ctx context.Context, in *intrv1.IncrCommentCntRequest, opts ...grpc.CallOption) (*intrv1.IncrCommentCntResponse, error) {
+	err := i.svc.IncrCommentCnt(ctx, in.GetBiz(), in.GetBizId(), in.GetDelta())
+	return &intrv1.IncrCommentCntResponse{}, err
+}
+
+func (i *InteractiveServiceAdapter) Delete(ctx context.Context, in *intrv1.DeleteRequest, opts ...grpc.CallOption) (*intrv1.DeleteResponse, error) {
+	err := i.svc.Delete(ctx, in.GetBiz(), in.GetBizId())
+	return &intrv1.DeleteResponse{}, err
+}
+
+func (i *InteractiveServiceAdapter) MergeUser(ctx context.Context, in *intrv1.MergeUserRequest, opts ...grpc.CallOption) (*intrv1.MergeUserResponse, error) {
+	err := i.svc.MergeUser(ctx, in.GetSrcUid(), in.GetDstUid())
+	return &intrv1.MergeUserResponse{}, err
+}
+
+func (i *InteractiveServiceAdapter) ListUserRecords(ctx context.Context, in *intrv1.ListUserRecordsRequest, opts ...grpc.CallOption) (*intrv1.ListUserRecordsResponse, error) {
+	likes, collects, err := i.svc.ListUserRecords(ctx, in.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListUserRecordsResponse{
+		Likes:    slice.Map(likes, i.toRecordDTO),
+		Collects: slice.Map(collects, i.toRecordDTO),
+	}, nil
+}
+
 // DTO data transfer object
 func (i *InteractiveServiceAdapter) toDTO(intr domain2.Interactive) *intrv1.Interactive {
 	return &intrv1.Interactive{
@@ -71,5 +98,15 @@ func (i *InteractiveServiceAdapter) toDTO(intr domain2.Interactive) *intrv1.Inte
 		LikeCnt:    intr.LikeCnt,
 		Liked:      intr.Liked,
 		ReadCnt:    intr.ReadCnt,
+		CommentCnt: intr.CommentCnt,
+	}
+}
+
+func (i *InteractiveServiceAdapter) toRecordDTO(idx int, r domain2.UserRecord) *intrv1.UserRecord {
+	return &intrv1.UserRecord{
+		Biz:   r.Biz,
+		BizId: r.BizId,
+		Cid:   r.Cid,
+		Ctime: r.Ctime.UnixMilli(),
 	}
 }
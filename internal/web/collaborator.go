@@ -0,0 +1,131 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*CollaboratorHandler)(nil)
+
+// CollaboratorHandler 帖子的协作者，只有创作者能邀请和移除
+type CollaboratorHandler struct {
+	svc service.CollaboratorService
+	l   logger.Logger
+}
+
+func NewCollaboratorHandler(svc service.CollaboratorService, l logger.Logger) *CollaboratorHandler {
+	return &CollaboratorHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *CollaboratorHandler) RegisterRoutes(server *gin.Engine) {
+	cg := server.Group("/articles/collaborators")
+	cg.GET("/list/:id", ginx.WrapToken[ijwt.UserClaims](h.List))
+	cg.POST("/invite", ginx.WrapBodyAndToken[CollaboratorReq, ijwt.UserClaims](h.Invite))
+	cg.POST("/remove", ginx.WrapBodyAndToken[CollaboratorReq, ijwt.UserClaims](h.Remove))
+}
+
+// List 帖子的协作者，创作者和协作者都能看
+func (h *CollaboratorHandler) List(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	artId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	res, err := h.svc.List(ctx, uc.Uid, artId)
+	if err != nil {
+		return h.result(err)
+	}
+	return ginx.Result{
+		Data: slice.Map(res, func(idx int, src domain.ArticleCollaborator) CollaboratorVO {
+			return CollaboratorVO{
+				Uid:   src.Uid,
+				Role:  src.Role.String(),
+				Ctime: src.Ctime.Format(time.DateTime),
+			}
+		}),
+	}, nil
+}
+
+// Invite 邀请别人成为编辑或者查看者，已经是协作者的话就是修改角色
+func (h *CollaboratorHandler) Invite(ctx *gin.Context, req CollaboratorReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	role, ok := roleOf(req.Role)
+	if !ok {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	err := h.svc.Invite(ctx, uc.Uid, req.ArticleId, req.Uid, role)
+	return h.result(err)
+}
+
+// Remove 创作者移除协作者，uid 传自己就是退出协作
+func (h *CollaboratorHandler) Remove(ctx *gin.Context, req CollaboratorReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Remove(ctx, uc.Uid, req.ArticleId, req.Uid)
+	return h.result(err)
+}
+
+func (h *CollaboratorHandler) result(err error) (ginx.Result, error) {
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrArticleForbidden):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "没有权限",
+		}, err
+	case errors.Is(err, service.ErrInvalidCollaborator):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "不能邀请自己，角色只能是 editor 或者 viewer",
+		}, nil
+	case errors.Is(err, service.ErrCollaboratorNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "协作者不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// roleOf 只接受能邀请的角色
+func roleOf(role string) (domain.ArticleRole, bool) {
+	switch role {
+	case domain.ArticleRoleEditor.String():
+		return domain.ArticleRoleEditor, true
+	case domain.ArticleRoleViewer.String():
+		return domain.ArticleRoleViewer, true
+	default:
+		return domain.ArticleRoleNone, false
+	}
+}
+
+type CollaboratorReq struct {
+	ArticleId int64 `json:"article_id"`
+	Uid       int64 `json:"uid"`
+	// Role editor 或者 viewer，移除的时候不用传
+	Role string `json:"role"`
+}
+
+type CollaboratorVO struct {
+	Uid   int64  `json:"uid"`
+	Role  string `json:"role"`
+	Ctime string `json:"ctime"`
+}
@@ -0,0 +1,231 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+// 一条评论最多这么多个字符
+const maxCommentLen = 1000
+
+var _ handler = (*CommentHandler)(nil)
+
+type CommentHandler struct {
+	svc service.CommentService
+	l   logger.Logger
+}
+
+func NewCommentHandler(svc service.CommentService, l logger.Logger) *CommentHandler {
+	return &CommentHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *CommentHandler) RegisterRoutes(server *gin.Engine) {
+	cg := server.Group("/comments")
+	cg.POST("/add", ginx.WrapBodyAndToken[AddCommentReq, ijwt.UserClaims](h.AddComment))
+	cg.POST("/delete", ginx.WrapBodyAndToken[DeleteCommentReq, ijwt.UserClaims](h.DeleteComment))
+	// 看评论不需要登录
+	cg.GET("/list", ginx.Wrap(h.ListComments))
+	cg.GET("/replies", ginx.Wrap(h.ListReplies))
+}
+
+// AddComment 发表评论或者回复
+func (h *CommentHandler) AddComment(ctx *gin.Context, req AddCommentReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	content := strings.TrimSpace(req.Content)
+	if req.Biz == "" || req.BizId <= 0 || req.ParentId < 0 ||
+		content == "" || utf8.RuneCountInString(content) > maxCommentLen {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	id, err := h.svc.AddComment(ctx, domain.Comment{
+		Biz:   req.Biz,
+		BizId: req.BizId,
+		Commentator: domain.Commentator{
+			Id: uc.Uid,
+		},
+		Content:  content,
+		ParentId: req.ParentId,
+	})
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: id,
+		}, nil
+	case errors.Is(err, service.ErrInvalidParent):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "回复的评论不存在",
+		}, nil
+	case errors.Is(err, service.ErrInvalidTarget):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "帖子不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// DeleteComment 删除自己的评论
+func (h *CommentHandler) DeleteComment(ctx *gin.Context, req DeleteCommentReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.DeleteComment(ctx, req.Id, uc.Uid)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case errors.Is(err, service.ErrCommentNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "评论不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// ListComments 根评论列表，按照时间倒序，每条根评论带上前几条回复
+func (h *CommentHandler) ListComments(ctx *gin.Context) (ginx.Result, error) {
+	biz := ctx.Query("biz")
+	bizId, err := strconv.ParseInt(ctx.Query("biz_id"), 10, 64)
+	if biz == "" || err != nil || bizId <= 0 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	cursor, limit := cursorQuery(ctx)
+	cs, err := h.svc.ListComments(ctx, biz, bizId, cursor, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: toCommentListVO(cs, limit),
+	}, nil
+}
+
+// ListReplies 某条根评论下面的回复，按照时间正序
+func (h *CommentHandler) ListReplies(ctx *gin.Context) (ginx.Result, error) {
+	rootId, err := strconv.ParseInt(ctx.Query("root_id"), 10, 64)
+	if err != nil || rootId <= 0 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	cursor, limit := cursorQuery(ctx)
+	cs, err := h.svc.ListReplies(ctx, rootId, cursor, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: toCommentListVO(cs, limit),
+	}, nil
+}
+
+// cursorQuery 游标分页，cursor 是上一页最后一条数据的 ID，第一页不传
+func cursorQuery(ctx *gin.Context) (int64, int) {
+	cursor, _ := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if cursor < 0 {
+		cursor = 0
+	}
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return cursor, limit
+}
+
+type AddCommentReq struct {
+	Biz     string `json:"biz"`
+	BizId   int64  `json:"biz_id"`
+	Content string `json:"content"`
+	// ParentId 回复的评论，不传就是根评论
+	ParentId int64 `json:"parent_id"`
+}
+
+type DeleteCommentReq struct {
+	Id int64 `json:"id"`
+}
+
+type CommentVO struct {
+	Id            int64  `json:"id"`
+	CommentatorId int64  `json:"commentator_id"`
+	Content       string `json:"content"`
+	RootId        int64  `json:"root_id"`
+	ParentId      int64  `json:"parent_id"`
+	// 根评论才有下面这两个字段
+	ReplyCnt int64       `json:"reply_cnt"`
+	Replies  []CommentVO `json:"replies,omitempty"`
+	// 删除了的根评论，只要下面还有回复，就会留着一个空壳
+	Deleted bool   `json:"deleted"`
+	Ctime   string `json:"ctime"`
+}
+
+type CommentListVO struct {
+	Comments []CommentVO `json:"comments"`
+	// NextCursor 下一页要带上的 cursor
+	NextCursor int64 `json:"next_cursor"`
+	HasMore    bool  `json:"has_more"`
+}
+
+func toCommentListVO(cs []domain.Comment, limit int) CommentListVO {
+	res := CommentListVO{
+		Comments: slice.Map(cs, func(idx int, src domain.Comment) CommentVO {
+			return toCommentVO(src)
+		}),
+		// 查出来的数量刚好等于 limit，就认为还有下一页
+		HasMore: len(cs) == limit,
+	}
+	if len(cs) > 0 {
+		res.NextCursor = cs[len(cs)-1].Id
+	}
+	return res
+}
+
+func toCommentVO(c domain.Comment) CommentVO {
+	vo := CommentVO{
+		Id:            c.Id,
+		CommentatorId: c.Commentator.Id,
+		Content:       c.Content,
+		RootId:        c.RootId,
+		ParentId:      c.ParentId,
+		ReplyCnt:      c.ReplyCnt,
+		Deleted:       c.Deleted,
+		Ctime:         c.Ctime.Format(time.DateTime),
+		Replies: slice.Map(c.Replies, func(idx int, src domain.Comment) CommentVO {
+			return toCommentVO(src)
+		}),
+	}
+	if c.Deleted {
+		vo.Content = ""
+		vo.CommentatorId = 0
+	}
+	return vo
+}
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"webookpro/internal/service"
+	"webookpro/pkg/logger"
+)
+
+const (
+	rssContentType  = "application/rss+xml; charset=utf-8"
+	atomContentType = "application/atom+xml; charset=utf-8"
+	xmlContentType  = "application/xml; charset=utf-8"
+)
+
+var _ handler = (*FeedHandler)(nil)
+
+// FeedHandler RSS、Atom 和 sitemap，给阅读器和搜索引擎用的，都不需要登录
+type FeedHandler struct {
+	svc service.FeedService
+	l   logger.Logger
+}
+
+func NewFeedHandler(svc service.FeedService, l logger.Logger) *FeedHandler {
+	return &FeedHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *FeedHandler) RegisterRoutes(server *gin.Engine) {
+	fg := server.Group("/feeds")
+	fg.GET("/rss", h.RSS)
+	fg.GET("/atom", h.Atom)
+	fg.GET("/authors/:id/rss", h.AuthorRSS)
+	fg.GET("/authors/:id/atom", h.AuthorAtom)
+	server.GET("/sitemap.xml", h.Sitemap)
+}
+
+// RSS 全站最新发表的帖子
+func (h *FeedHandler) RSS(ctx *gin.Context) {
+	data, err := h.svc.RSS(ctx, 0)
+	h.write(ctx, rssContentType, data, err)
+}
+
+func (h *FeedHandler) Atom(ctx *gin.Context) {
+	data, err := h.svc.Atom(ctx, 0)
+	h.write(ctx, atomContentType, data, err)
+}
+
+// AuthorRSS 某个作者最新发表的帖子
+func (h *FeedHandler) AuthorRSS(ctx *gin.Context) {
+	author, ok := h.author(ctx)
+	if !ok {
+		return
+	}
+	data, err := h.svc.RSS(ctx, author)
+	h.write(ctx, rssContentType, data, err)
+}
+
+func (h *FeedHandler) AuthorAtom(ctx *gin.Context) {
+	author, ok := h.author(ctx)
+	if !ok {
+		return
+	}
+	data, err := h.svc.Atom(ctx, author)
+	h.write(ctx, atomContentType, data, err)
+}
+
+func (h *FeedHandler) Sitemap(ctx *gin.Context) {
+	data, err := h.svc.Sitemap(ctx)
+	h.write(ctx, xmlContentType, data, err)
+}
+
+func (h *FeedHandler) author(ctx *gin.Context) (int64, bool) {
+	author, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil || author <= 0 {
+		ctx.Status(http.StatusBadRequest)
+		return 0, false
+	}
+	return author, true
+}
+
+// write 内容在服务端缓存了，浏览器和 CDN 只缓存一小会儿，免得发表之后很久才看到
+func (h *FeedHandler) write(ctx *gin.Context, contentType string, data []byte, err error) {
+	if err != nil {
+		h.l.Error("生成订阅源失败",
+			logger.String("path", ctx.Request.URL.Path),
+			logger.Error(err))
+		ctx.Status(http.StatusInternalServerError)
+		return
+	}
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.Data(http.StatusOK, contentType, data)
+}
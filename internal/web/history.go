@@ -0,0 +1,78 @@
+package web
+
+import (
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*HistoryHandler)(nil)
+
+type HistoryHandler struct {
+	svc service.HistoryService
+	l   logger.Logger
+}
+
+func NewHistoryHandler(svc service.HistoryService, l logger.Logger) *HistoryHandler {
+	return &HistoryHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *HistoryHandler) RegisterRoutes(server *gin.Engine) {
+	hg := server.Group("/history")
+	hg.GET("/list", ginx.WrapToken[ijwt.UserClaims](h.List))
+	hg.POST("/clear", ginx.WrapToken[ijwt.UserClaims](h.Clear))
+}
+
+// List 分页查看自己的阅读记录，最近读的在前面
+func (h *HistoryHandler) List(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	offset, limit := pageQuery(ctx)
+	rs, err := h.svc.ListRecords(ctx, uc.Uid, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(rs, toHistoryRecordVO),
+	}, nil
+}
+
+// Clear 清空自己的阅读记录
+func (h *HistoryHandler) Clear(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.ClearRecords(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+type HistoryRecordVO struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"biz_id"`
+	// 帖子撤回了之后就没有标题了
+	Title    string `json:"title"`
+	ReadTime string `json:"read_time"`
+}
+
+func toHistoryRecordVO(idx int, src domain.HistoryRecord) HistoryRecordVO {
+	return HistoryRecordVO{
+		Biz:      src.Biz,
+		BizId:    src.BizId,
+		Title:    src.Title,
+		ReadTime: src.ReadTime.Format(time.DateTime),
+	}
+}
@@ -1,12 +1,14 @@
 package jwt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -16,13 +18,22 @@ var (
 	RtKey = []byte("95osj3fUD7fo0mlYdDbncXz4VD2igvfx")
 )
 
+// ErrSessionNotFound 要踢掉的登录态不存在，或者不是这个用户的
+var ErrSessionNotFound = errors.New("登录态不存在")
+
+// refreshTokenExpiration 长 token 的有效期，过了之后登录态就彻底没用了
+const refreshTokenExpiration = time.Hour * 24 * 7
+
 type RedisJWTHandler struct {
 	cmd redis.Cmdable
+	// maxSessions 一个用户最多同时有几个登录态，超过了踢掉最早登录的，0 代表不限制
+	maxSessions int
 }
 
-func NewRedisJWTHandler(cmd redis.Cmdable) JwtHandler {
+func NewRedisJWTHandler(cmd redis.Cmdable, maxSessions int) JwtHandler {
 	return &RedisJWTHandler{
-		cmd: cmd,
+		cmd:         cmd,
+		maxSessions: maxSessions,
 	}
 }
 
@@ -33,14 +44,147 @@ func (h *RedisJWTHandler) SetLoginToken(ctx *gin.Context, uid int64) error {
 		return err
 	}
 	err = h.setRefreshToken(ctx, uid, ssid)
+	if err != nil {
+		return err
+	}
+	return h.addSession(ctx, uid, ssid)
+}
+
+// addSession 记下这个登录态的设备信息，按照登录时间放进用户的有序集合里面
+// ClearSessions 的时候要全部作废，超过上限的时候从最早的开始踢
+func (h *RedisJWTHandler) addSession(ctx *gin.Context, uid int64, ssid string) error {
+	now := time.Now()
+	key := h.sessionsKey(uid)
+	ua := ctx.Request.UserAgent()
+	pipe := h.cmd.Pipeline()
+	pipe.HSet(ctx, h.sessionKey(ssid), map[string]any{
+		"uid":        uid,
+		"device":     deviceOf(ua),
+		"user_agent": ua,
+		"ip":         ctx.ClientIP(),
+		"ctime":      now.UnixMilli(),
+		"rtime":      now.UnixMilli(),
+	})
+	pipe.Expire(ctx, h.sessionKey(ssid), refreshTokenExpiration)
+	// 长 token 已经过期了的顺手清掉
+	pipe.ZRemRangeByScore(ctx, key, "-inf", fmt.Sprint(now.Add(-refreshTokenExpiration).UnixMilli()))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: ssid})
+	// 最后一次登录之后七天整个集合就没用了
+	pipe.Expire(ctx, key, refreshTokenExpiration)
+	_, err := pipe.Exec(ctx)
+	if err != nil || h.maxSessions <= 0 {
+		return err
+	}
+	// 并发登录的时候可能多踢一两个，问题不大
+	cnt, err := h.cmd.ZCard(ctx, key).Result()
+	if err != nil || cnt <= int64(h.maxSessions) {
+		return err
+	}
+	oldest, err := h.cmd.ZRange(ctx, key, 0, cnt-int64(h.maxSessions)-1).Result()
+	if err != nil {
+		return err
+	}
+	return h.revoke(ctx, uid, oldest...)
+}
+
+func (h *RedisJWTHandler) ListSessions(ctx context.Context, uid int64) ([]Session, error) {
+	ssids, err := h.cmd.ZRevRange(ctx, h.sessionsKey(uid), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	pipe := h.cmd.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(ssids))
+	for _, ssid := range ssids {
+		cmds = append(cmds, pipe.HGetAll(ctx, h.sessionKey(ssid)))
+	}
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Session, 0, len(ssids))
+	for i, cmd := range cmds {
+		vals := cmd.Val()
+		if len(vals) == 0 {
+			// 已经过期了
+			continue
+		}
+		ctime, _ := strconv.ParseInt(vals["ctime"], 10, 64)
+		rtime, _ := strconv.ParseInt(vals["rtime"], 10, 64)
+		res = append(res, Session{
+			Ssid:      ssids[i],
+			Device:    vals["device"],
+			UserAgent: vals["user_agent"],
+			IP:        vals["ip"],
+			Ctime:     time.UnixMilli(ctime),
+			Rtime:     time.UnixMilli(rtime),
+		})
+	}
+	return res, nil
+}
+
+func (h *RedisJWTHandler) RevokeSession(ctx context.Context, uid int64, ssid string) error {
+	// 先确认是自己的，不然就能踢掉别人
+	_, err := h.cmd.ZScore(ctx, h.sessionsKey(uid), ssid).Result()
+	if err == redis.Nil {
+		return ErrSessionNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return h.revoke(ctx, uid, ssid)
+}
+
+func (h *RedisJWTHandler) ClearSessions(ctx context.Context, uid int64) error {
+	key := h.sessionsKey(uid)
+	ssids, err := h.cmd.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	pipe := h.cmd.Pipeline()
+	for _, ssid := range ssids {
+		pipe.Set(ctx, h.blacklistKey(ssid), "", refreshTokenExpiration)
+		pipe.Del(ctx, h.sessionKey(ssid))
+	}
+	pipe.Del(ctx, key)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// revoke 拉黑 ssid，从列表里面删掉
+func (h *RedisJWTHandler) revoke(ctx context.Context, uid int64, ssids ...string) error {
+	if len(ssids) == 0 {
+		return nil
+	}
+	pipe := h.cmd.Pipeline()
+	for _, ssid := range ssids {
+		pipe.Set(ctx, h.blacklistKey(ssid), "", refreshTokenExpiration)
+		pipe.Del(ctx, h.sessionKey(ssid))
+		pipe.ZRem(ctx, h.sessionsKey(uid), ssid)
+	}
+	_, err := pipe.Exec(ctx)
 	return err
 }
 
+// sessionsKey 用户所有的登录态，score 是登录的时间
+func (h *RedisJWTHandler) sessionsKey(uid int64) string {
+	return fmt.Sprintf("users:login_sessions:%d", uid)
+}
+
+// sessionKey 一个登录态的设备信息
+func (h *RedisJWTHandler) sessionKey(ssid string) string {
+	return fmt.Sprintf("users:session:%s", ssid)
+}
+
+// blacklistKey 退出登录或者被踢掉的 ssid
+func (h *RedisJWTHandler) blacklistKey(ssid string) string {
+	return fmt.Sprintf("users:ssid:%s", ssid)
+}
+
 func (h *RedisJWTHandler) setRefreshToken(ctx *gin.Context, uid int64, ssid string) error {
 	claims := RefreshClaims{
 		Ssid: ssid,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 7)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(refreshTokenExpiration)),
 		},
 		Uid: uid,
 	}
@@ -57,13 +201,13 @@ func (h *RedisJWTHandler) ClearToken(ctx *gin.Context) error {
 	ctx.Header("x-jwt-token", "")
 	ctx.Header("x-refresh-token", "")
 
-	claims := ctx.MustGet("claims").(*UserClaims)
-	return h.cmd.Set(ctx, fmt.Sprintf("users:ssid:%s", claims.Ssid),
-		"", time.Hour*24*7).Err()
+	// 登录校验的中间件放进去的是值，不是指针
+	claims := ctx.MustGet("claims").(UserClaims)
+	return h.revoke(ctx, claims.Uid, claims.Ssid)
 }
 
 func (h *RedisJWTHandler) CheckSession(ctx *gin.Context, ssid string) error {
-	val, err := h.cmd.Exists(ctx, fmt.Sprintf("users:ssid:%s", ssid)).Result()
+	val, err := h.cmd.Exists(ctx, h.blacklistKey(ssid)).Result()
 	switch err {
 	case redis.Nil:
 		return nil
@@ -102,5 +246,35 @@ func (h *RedisJWTHandler) SetJWTToken(ctx *gin.Context, uid int64, ssid string)
 		return err
 	}
 	ctx.Header("x-jwt-token", tokenStr)
-	return nil
+	// 刷新短 token 的时候也会走到这里，顺便记下最后一次刷新的时间
+	// 登录的时候这个 key 还不存在，什么都不做
+	return h.cmd.Eval(ctx, touchSessionLua, []string{h.sessionKey(ssid)}, time.Now().UnixMilli()).Err()
+}
+
+// touchSessionLua 已经过期了的登录态不能被 hset 重新创建出来
+const touchSessionLua = `
+if redis.call("exists", KEYS[1]) == 1 then
+    redis.call("hset", KEYS[1], "rtime", ARGV[1])
+end
+return 0
+`
+
+// deviceOf 从 User-Agent 里面粗略地认一下设备，列表里面展示用的
+func deviceOf(ua string) string {
+	switch {
+	case strings.Contains(ua, "iPhone"):
+		return "iPhone"
+	case strings.Contains(ua, "iPad"):
+		return "iPad"
+	case strings.Contains(ua, "Android"):
+		return "Android"
+	case strings.Contains(ua, "Windows"):
+		return "Windows"
+	case strings.Contains(ua, "Macintosh"):
+		return "Mac"
+	case strings.Contains(ua, "Linux"):
+		return "Linux"
+	default:
+		return "未知设备"
+	}
 }
@@ -0,0 +1,158 @@
+package jwt
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	redismocks "webookpro/internal/repository/cache/redismock"
+)
+
+func TestRedisJWTHandler_addSession(t *testing.T) {
+	testCases := []struct {
+		name        string
+		maxSessions int
+		mock        func(ctrl *gomock.Controller) redis.Cmdable
+	}{
+		{
+			name:        "超过上限，踢掉最早登录的",
+			maxSessions: 2,
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().Pipeline().Return(addSessionPipe(ctrl))
+				cnt := redis.NewIntCmd(context.Background())
+				cnt.SetVal(3)
+				cmd.EXPECT().ZCard(gomock.Any(), "users:login_sessions:123").Return(cnt)
+				oldest := redis.NewStringSliceCmd(context.Background())
+				oldest.SetVal([]string{"old"})
+				cmd.EXPECT().ZRange(gomock.Any(), "users:login_sessions:123", int64(0), int64(0)).
+					Return(oldest)
+				// 被踢掉的拉黑，从列表里面删掉
+				revokePipe := redismocks.NewMockPipeliner(ctrl)
+				revokePipe.EXPECT().Set(gomock.Any(), "users:ssid:old", "", refreshTokenExpiration)
+				revokePipe.EXPECT().Del(gomock.Any(), "users:session:old")
+				revokePipe.EXPECT().ZRem(gomock.Any(), "users:login_sessions:123", "old")
+				revokePipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				cmd.EXPECT().Pipeline().Return(revokePipe)
+				return cmd
+			},
+		},
+		{
+			name:        "没有超过上限",
+			maxSessions: 3,
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().Pipeline().Return(addSessionPipe(ctrl))
+				cnt := redis.NewIntCmd(context.Background())
+				cnt.SetVal(3)
+				cmd.EXPECT().ZCard(gomock.Any(), "users:login_sessions:123").Return(cnt)
+				return cmd
+			},
+		},
+		{
+			name: "不限制数量",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().Pipeline().Return(addSessionPipe(ctrl))
+				return cmd
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			h := NewRedisJWTHandler(tc.mock(ctrl), tc.maxSessions).(*RedisJWTHandler)
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/users/login", nil)
+			err := h.addSession(ctx, 123, "new")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRedisJWTHandler_RevokeSession(t *testing.T) {
+	testCases := []struct {
+		name string
+		ssid string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "踢掉自己的登录态",
+			ssid: "mine",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				score := redis.NewFloatCmd(context.Background())
+				score.SetVal(1)
+				cmd.EXPECT().ZScore(gomock.Any(), "users:login_sessions:123", "mine").Return(score)
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				pipe.EXPECT().Set(gomock.Any(), "users:ssid:mine", "", refreshTokenExpiration)
+				pipe.EXPECT().Del(gomock.Any(), "users:session:mine")
+				pipe.EXPECT().ZRem(gomock.Any(), "users:login_sessions:123", "mine")
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				cmd.EXPECT().Pipeline().Return(pipe)
+				return cmd
+			},
+		},
+		{
+			name: "别人的登录态不能踢",
+			ssid: "others",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				score := redis.NewFloatCmd(context.Background())
+				score.SetErr(redis.Nil)
+				// 不在自己的列表里面，不会拉黑
+				cmd.EXPECT().ZScore(gomock.Any(), "users:login_sessions:123", "others").Return(score)
+				return cmd
+			},
+			wantErr: ErrSessionNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			h := NewRedisJWTHandler(tc.mock(ctrl), 0)
+			err := h.RevokeSession(context.Background(), 123, tc.ssid)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisJWTHandler_ClearSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cmd := redismocks.NewMockCmdable(ctrl)
+	ssids := redis.NewStringSliceCmd(context.Background())
+	ssids.SetVal([]string{"a", "b"})
+	cmd.EXPECT().ZRange(gomock.Any(), "users:login_sessions:123", int64(0), int64(-1)).Return(ssids)
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	for _, ssid := range []string{"a", "b"} {
+		pipe.EXPECT().Set(gomock.Any(), "users:ssid:"+ssid, "", refreshTokenExpiration)
+		pipe.EXPECT().Del(gomock.Any(), "users:session:"+ssid)
+	}
+	pipe.EXPECT().Del(gomock.Any(), "users:login_sessions:123")
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+	cmd.EXPECT().Pipeline().Return(pipe)
+
+	err := NewRedisJWTHandler(cmd, 0).ClearSessions(context.Background(), 123)
+	assert.NoError(t, err)
+}
+
+// addSessionPipe 记下设备信息，放进用户的有序集合
+func addSessionPipe(ctrl *gomock.Controller) redis.Pipeliner {
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	pipe.EXPECT().HSet(gomock.Any(), "users:session:new", gomock.Any())
+	pipe.EXPECT().Expire(gomock.Any(), "users:session:new", refreshTokenExpiration)
+	pipe.EXPECT().ZRemRangeByScore(gomock.Any(), "users:login_sessions:123", "-inf", gomock.Any())
+	pipe.EXPECT().ZAdd(gomock.Any(), "users:login_sessions:123", gomock.Any())
+	pipe.EXPECT().Expire(gomock.Any(), "users:login_sessions:123", refreshTokenExpiration)
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+	return pipe
+}
@@ -1,16 +1,37 @@
 package jwt
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"time"
 )
 
+//go:generate mockgen -source=types.go -package=jwtmocks -destination=mocks/types.mock.go JwtHandler
 type JwtHandler interface {
 	SetLoginToken(ctx *gin.Context, uid int64) error
 	SetJWTToken(ctx *gin.Context, uid int64, ssid string) error
 	ClearToken(ctx *gin.Context) error
 	CheckSession(ctx *gin.Context, ssid string) error
 	ExtractToken(ctx *gin.Context) string
+	// ClearSessions 让这个用户所有登录过的设备都失效，比如说重置密码之后
+	ClearSessions(ctx context.Context, uid int64) error
+	// ListSessions 还有效的登录态，最近登录的在前面
+	ListSessions(ctx context.Context, uid int64) ([]Session, error)
+	// RevokeSession 踢掉一个登录态，不是这个用户的返回 ErrSessionNotFound
+	RevokeSession(ctx context.Context, uid int64, ssid string) error
+}
+
+// Session 一次登录，刷新短 token 不会产生新的 Session
+type Session struct {
+	Ssid      string
+	Device    string
+	UserAgent string
+	IP        string
+	// Ctime 登录的时间
+	Ctime time.Time
+	// Rtime 最后一次刷新短 token 的时间
+	Rtime time.Time
 }
 
 type RefreshClaims struct {
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+	ijwt "webookpro/internal/web/jwt"
+)
+
+// AdminMiddlewareBuilder 管理后台的接口只有管理员能访问，要放在登录校验的后面
+type AdminMiddlewareBuilder struct {
+	prefix string
+	uids   map[int64]struct{}
+}
+
+func NewAdminMiddlewareBuilder(uids []int64) *AdminMiddlewareBuilder {
+	m := make(map[int64]struct{}, len(uids))
+	for _, uid := range uids {
+		m[uid] = struct{}{}
+	}
+	return &AdminMiddlewareBuilder{
+		prefix: "/admin/",
+		uids:   m,
+	}
+}
+
+// Prefix 这个前缀下面的路径才需要校验，默认是 /admin/
+func (a *AdminMiddlewareBuilder) Prefix(prefix string) *AdminMiddlewareBuilder {
+	a.prefix = prefix
+	return a
+}
+
+func (a *AdminMiddlewareBuilder) Build() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !strings.HasPrefix(ctx.Request.URL.Path, a.prefix) {
+			return
+		}
+		uc, ok := ctx.Get("claims")
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		claims, ok := uc.(ijwt.UserClaims)
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if _, ok = a.uids[claims.Uid]; !ok {
+			ctx.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"net/http"
+	"strings"
 	ijwt "webookpro/internal/web/jwt"
 )
 
 type LoginJWTMiddlewareBuilder struct {
 	paths []string
+	// prefixes 这些前缀下面的路径都不需要登录
+	prefixes []string
 	ijwt.JwtHandler
 }
 
@@ -23,6 +26,12 @@ func (l *LoginJWTMiddlewareBuilder) IgorePath(path string) *LoginJWTMiddlewareBu
 	return l
 }
 
+// IgorePathPrefix 路径里面带参数的接口，比如说 /attachments/files/*key
+func (l *LoginJWTMiddlewareBuilder) IgorePathPrefix(prefix string) *LoginJWTMiddlewareBuilder {
+	l.prefixes = append(l.prefixes, prefix)
+	return l
+}
+
 func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		for _, path := range l.paths {
@@ -30,6 +39,11 @@ func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 				return
 			}
 		}
+		for _, prefix := range l.prefixes {
+			if strings.HasPrefix(ctx.Request.URL.Path, prefix) {
+				return
+			}
+		}
 		tokenStr := l.JwtHandler.ExtractToken(ctx)
 		var uc ijwt.UserClaims
 		token, err := jwt.ParseWithClaims(tokenStr, &uc, func(token *jwt.Token) (interface{}, error) {
@@ -0,0 +1,186 @@
+package web
+
+import (
+	"errors"
+	regexp "github.com/dlclark/regexp2"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*PasswordHandler)(nil)
+
+// PasswordHandler 忘记密码的时候用邮箱验证码重置，这几个接口都不需要登录
+type PasswordHandler struct {
+	emailExp    *regexp.Regexp
+	passwordExp *regexp.Regexp
+	svc         service.PasswordResetService
+	jwtHdl      ijwt.JwtHandler
+	l           logger.Logger
+}
+
+func NewPasswordHandler(svc service.PasswordResetService, jwtHdl ijwt.JwtHandler, l logger.Logger) *PasswordHandler {
+	return &PasswordHandler{
+		emailExp:    regexp.MustCompile(emailRegexPattern, regexp.None),
+		passwordExp: regexp.MustCompile(passwordRegexPattern, regexp.None),
+		svc:         svc,
+		jwtHdl:      jwtHdl,
+		l:           l,
+	}
+}
+
+func (h *PasswordHandler) RegisterRoutes(server *gin.Engine) {
+	pg := server.Group("/users/password/reset")
+	pg.POST("/code/send", h.SendCode)
+	pg.POST("/code/verify", h.VerifyCode)
+	pg.POST("", h.Reset)
+}
+
+// SendCode 邮箱没有注册过也返回发送成功
+func (h *PasswordHandler) SendCode(ctx *gin.Context) {
+	type Req struct {
+		Email string `json:"email"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	ok, err := h.emailExp.MatchString(req.Email)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "邮箱格式不对",
+		})
+		return
+	}
+	err = h.svc.SendCode(ctx, req.Email)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Msg: "发送成功",
+		})
+	case errors.Is(err, service.ErrCodeSendTooMany):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "发送太频繁，请稍后再试",
+		})
+	default:
+		h.l.Error("发送重置密码验证码失败", logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+// VerifyCode 验证码对了返回重置密码用的 token
+func (h *PasswordHandler) VerifyCode(ctx *gin.Context) {
+	type Req struct {
+		Email string `json:"email"`
+		Code  string `json:"code"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Email == "" || req.Code == "" {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "邮箱和验证码不能为空",
+		})
+		return
+	}
+	token, err := h.svc.VerifyCode(ctx, req.Email, req.Code)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Data: token,
+		})
+	case errors.Is(err, service.ErrInvalidResetCode):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证码有误",
+		})
+	case errors.Is(err, service.ErrCodeVerifyTooManyTimes):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证次数太多，请重新发送验证码",
+		})
+	default:
+		h.l.Error("校验重置密码验证码失败", logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+// Reset 设置新密码，之前登录过的设备全部要重新登录
+func (h *PasswordHandler) Reset(ctx *gin.Context) {
+	type Req struct {
+		Token           string `json:"token"`
+		Password        string `json:"password"`
+		ConfirmPassword string `json:"confirmPassword"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Password != req.ConfirmPassword {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "两次输入的密码不一致",
+		})
+		return
+	}
+	ok, err := h.passwordExp.MatchString(req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "密码必须大于8位，包含数字、特殊字符",
+		})
+		return
+	}
+	uid, err := h.svc.Reset(ctx, req.Token, req.Password)
+	if errors.Is(err, service.ErrInvalidResetToken) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "重置密码的请求已经过期，请重新获取验证码",
+		})
+		return
+	}
+	if err != nil {
+		h.l.Error("重置密码失败", logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	err = h.jwtHdl.ClearSessions(ctx, uid)
+	if err != nil {
+		// 密码已经改掉了，只是旧的登录态还要等过期
+		h.l.Error("重置密码之后清除登录态失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "密码已经重置，请重新登录",
+	})
+}
@@ -0,0 +1,148 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+func TestPasswordHandler_SendCode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reqBody string
+		mock    func(ctrl *gomock.Controller) service.PasswordResetService
+		wantRes Result
+	}{
+		{
+			name:    "发送成功",
+			reqBody: `{"email":"123@qq.com"}`,
+			mock: func(ctrl *gomock.Controller) service.PasswordResetService {
+				svc := svcmocks.NewMockPasswordResetService(ctrl)
+				svc.EXPECT().SendCode(gomock.Any(), "123@qq.com").Return(nil)
+				return svc
+			},
+			wantRes: Result{Msg: "发送成功"},
+		},
+		{
+			name:    "邮箱格式不对",
+			reqBody: `{"email":"123"}`,
+			wantRes: Result{Code: 4, Msg: "邮箱格式不对"},
+		},
+		{
+			name:    "发送太频繁",
+			reqBody: `{"email":"123@qq.com"}`,
+			mock: func(ctrl *gomock.Controller) service.PasswordResetService {
+				svc := svcmocks.NewMockPasswordResetService(ctrl)
+				svc.EXPECT().SendCode(gomock.Any(), "123@qq.com").Return(service.ErrCodeSendTooMany)
+				return svc
+			},
+			wantRes: Result{Code: 4, Msg: "发送太频繁，请稍后再试"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var svc service.PasswordResetService = svcmocks.NewMockPasswordResetService(ctrl)
+			if tc.mock != nil {
+				svc = tc.mock(ctrl)
+			}
+			res := doPasswordReq(t, NewPasswordHandler(svc, jwtmocks.NewMockJwtHandler(ctrl), &logger.NopLogger{}),
+				"/users/password/reset/code/send", tc.reqBody)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestPasswordHandler_Reset(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reqBody string
+		mock    func(ctrl *gomock.Controller) (service.PasswordResetService, ijwt.JwtHandler)
+		wantRes Result
+	}{
+		{
+			name:    "重置成功，清除所有登录态",
+			reqBody: `{"token":"abc","password":"hello#world123","confirmPassword":"hello#world123"}`,
+			mock: func(ctrl *gomock.Controller) (service.PasswordResetService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockPasswordResetService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Reset(gomock.Any(), "abc", "hello#world123").Return(int64(123), nil)
+				jwtHdl.EXPECT().ClearSessions(gomock.Any(), int64(123)).Return(nil)
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "密码已经重置，请重新登录"},
+		},
+		{
+			name:    "清除登录态失败，密码还是重置成功了",
+			reqBody: `{"token":"abc","password":"hello#world123","confirmPassword":"hello#world123"}`,
+			mock: func(ctrl *gomock.Controller) (service.PasswordResetService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockPasswordResetService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().Reset(gomock.Any(), "abc", "hello#world123").Return(int64(123), nil)
+				jwtHdl.EXPECT().ClearSessions(gomock.Any(), int64(123)).Return(errors.New("redis 错误"))
+				return svc, jwtHdl
+			},
+			wantRes: Result{Msg: "密码已经重置，请重新登录"},
+		},
+		{
+			name:    "两次密码不一致",
+			reqBody: `{"token":"abc","password":"hello#world123","confirmPassword":"hello#world12"}`,
+			wantRes: Result{Code: 4, Msg: "两次输入的密码不一致"},
+		},
+		{
+			name:    "密码太简单",
+			reqBody: `{"token":"abc","password":"hello","confirmPassword":"hello"}`,
+			wantRes: Result{Code: 4, Msg: "密码必须大于8位，包含数字、特殊字符"},
+		},
+		{
+			name:    "凭证无效",
+			reqBody: `{"token":"abc","password":"hello#world123","confirmPassword":"hello#world123"}`,
+			mock: func(ctrl *gomock.Controller) (service.PasswordResetService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockPasswordResetService(ctrl)
+				svc.EXPECT().Reset(gomock.Any(), "abc", "hello#world123").Return(int64(0), service.ErrInvalidResetToken)
+				return svc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "重置密码的请求已经过期，请重新获取验证码"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var svc service.PasswordResetService = svcmocks.NewMockPasswordResetService(ctrl)
+			var jwtHdl ijwt.JwtHandler = jwtmocks.NewMockJwtHandler(ctrl)
+			if tc.mock != nil {
+				svc, jwtHdl = tc.mock(ctrl)
+			}
+			res := doPasswordReq(t, NewPasswordHandler(svc, jwtHdl, &logger.NopLogger{}),
+				"/users/password/reset", tc.reqBody)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func doPasswordReq(t *testing.T, hdl *PasswordHandler, path, body string) Result {
+	server := gin.Default()
+	hdl.RegisterRoutes(server)
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer([]byte(body)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	server.ServeHTTP(recorder, req)
+	var res Result
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	return res
+}
@@ -0,0 +1,180 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+// maxRejectReasonLen 驳回理由的最大长度，按照字符算
+const maxRejectReasonLen = 256
+
+var _ handler = (*ReviewHandler)(nil)
+
+// ReviewHandler 帖子的人工审核，/admin/ 下面的接口只有管理员能访问
+type ReviewHandler struct {
+	svc service.ReviewService
+	l   logger.Logger
+}
+
+func NewReviewHandler(svc service.ReviewService, l logger.Logger) *ReviewHandler {
+	return &ReviewHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ReviewHandler) RegisterRoutes(server *gin.Engine) {
+	ag := server.Group("/admin/reviews")
+	ag.POST("/pending", ginx.WrapBodyAndToken[ReviewListReq, ijwt.UserClaims](h.ListPending))
+	ag.POST("/approve", ginx.WrapBodyAndToken[ReviewReq, ijwt.UserClaims](h.Approve))
+	ag.POST("/reject", ginx.WrapBodyAndToken[ReviewReq, ijwt.UserClaims](h.Reject))
+
+	server.GET("/articles/reviews/:id", ginx.WrapToken[ijwt.UserClaims](h.ListByArticle))
+}
+
+// ListPending 待审核的帖子，最早提交的在前面
+func (h *ReviewHandler) ListPending(ctx *gin.Context, req ReviewListReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 100
+	}
+	res, err := h.svc.ListPending(ctx, req.Offset, req.Limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(res, func(idx int, src domain.ArticleReview) ReviewVO {
+			return toReviewVO(src, true)
+		}),
+	}, nil
+}
+
+func (h *ReviewHandler) Approve(ctx *gin.Context, req ReviewReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Approve(ctx, uc.Uid, req.Id)
+	return h.result(err)
+}
+
+// Reject 驳回一定要写理由，作者能看到
+func (h *ReviewHandler) Reject(ctx *gin.Context, req ReviewReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" || utf8.RuneCountInString(reason) > maxRejectReasonLen {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "驳回理由不能为空，也不能太长",
+		}, nil
+	}
+	err := h.svc.Reject(ctx, uc.Uid, req.Id, reason)
+	return h.result(err)
+}
+
+// ListByArticle 作者查看自己帖子的审核记录，包括没有通过的原因
+func (h *ReviewHandler) ListByArticle(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	artId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	res, err := h.svc.ListByArticle(ctx, uc.Uid, artId)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(res, func(idx int, src domain.ArticleReview) ReviewVO {
+			return toReviewVO(src, false)
+		}),
+	}, nil
+}
+
+func (h *ReviewHandler) result(err error) (ginx.Result, error) {
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrReviewNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "审核记录不存在",
+		}, nil
+	case errors.Is(err, service.ErrReviewNotPending):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "已经审核过了",
+		}, nil
+	case errors.Is(err, service.ErrReviewOutdated):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "作者已经修改了帖子，这条审核记录作废了",
+		}, nil
+	case errors.Is(err, service.ErrReviewAuthorInactive):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "作者已经停用或者注销了账号",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type ReviewListReq struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+type ReviewReq struct {
+	Id int64 `json:"id"`
+	// Reason 驳回的理由，审核通过的时候不用传
+	Reason string `json:"reason"`
+}
+
+type ReviewVO struct {
+	Id        int64  `json:"id"`
+	ArticleId int64  `json:"article_id"`
+	AuthorId  int64  `json:"author_id"`
+	Title     string `json:"title"`
+	// Content 只有管理员审核的时候才返回
+	Content string `json:"content,omitempty"`
+	// Status pending、approved、rejected、blocked 或者 outdated
+	Status  string   `json:"status"`
+	Reasons []string `json:"reasons"`
+	// Reason 管理员驳回的理由
+	Reason string `json:"reason,omitempty"`
+	Ctime  string `json:"ctime"`
+	Utime  string `json:"utime"`
+}
+
+func toReviewVO(r domain.ArticleReview, withContent bool) ReviewVO {
+	vo := ReviewVO{
+		Id:        r.Id,
+		ArticleId: r.ArticleId,
+		AuthorId:  r.Author.Id,
+		Title:     r.Title,
+		Status:    r.Status.String(),
+		Reasons:   r.Reasons,
+		Reason:    r.Reason,
+		Ctime:     r.Ctime.Format(time.DateTime),
+		Utime:     r.Utime.Format(time.DateTime),
+	}
+	if withContent {
+		vo.Content = r.Content
+	}
+	return vo
+}
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"strings"
+	"unicode/utf8"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+// 搜索词太长没有意义，还浪费 CPU
+const maxQueryLen = 64
+
+var _ handler = (*SearchHandler)(nil)
+
+type SearchHandler struct {
+	svc service.SearchService
+	l   logger.Logger
+}
+
+func NewSearchHandler(svc service.SearchService, l logger.Logger) *SearchHandler {
+	return &SearchHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *SearchHandler) RegisterRoutes(server *gin.Engine) {
+	// 搜索不需要登录
+	server.GET("/articles/pub/search", ginx.Wrap(h.SearchArticles))
+}
+
+// SearchArticles 搜索已发表的帖子
+func (h *SearchHandler) SearchArticles(ctx *gin.Context) (ginx.Result, error) {
+	q := strings.TrimSpace(ctx.Query("q"))
+	if q == "" || utf8.RuneCountInString(q) > maxQueryLen {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	offset, limit := pageQuery(ctx)
+	res, err := h.svc.SearchArticles(ctx, q, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: SearchResultVO{
+			Total: res.Total,
+			Hits: slice.Map(res.Hits, func(idx int, src domain.ArticleSearchHit) SearchHitVO {
+				return SearchHitVO{
+					Id:      src.Id,
+					Title:   src.Title,
+					Snippet: src.Snippet,
+					Score:   src.Score,
+				}
+			}),
+		},
+	}, nil
+}
+
+type SearchResultVO struct {
+	Total int           `json:"total"`
+	Hits  []SearchHitVO `json:"hits"`
+}
+
+type SearchHitVO struct {
+	Id int64 `json:"id"`
+	// 命中的部分用 <em></em> 包起来了
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Score   float64 `json:"score"`
+}
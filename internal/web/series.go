@@ -0,0 +1,292 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+const (
+	maxSeriesTitleLen = 256
+	maxSeriesDescLen  = 1024
+)
+
+var _ handler = (*SeriesHandler)(nil)
+
+type SeriesHandler struct {
+	svc service.SeriesService
+	l   logger.Logger
+}
+
+func NewSeriesHandler(svc service.SeriesService, l logger.Logger) *SeriesHandler {
+	return &SeriesHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *SeriesHandler) RegisterRoutes(server *gin.Engine) {
+	sg := server.Group("/series")
+	sg.POST("/create", ginx.WrapBodyAndToken[SeriesReq, ijwt.UserClaims](h.Create))
+	sg.POST("/update", ginx.WrapBodyAndToken[SeriesReq, ijwt.UserClaims](h.Update))
+	sg.POST("/delete", ginx.WrapBodyAndToken[SeriesReq, ijwt.UserClaims](h.Delete))
+	sg.GET("/list", ginx.WrapToken[ijwt.UserClaims](h.List))
+	sg.GET("/detail/:id", ginx.WrapToken[ijwt.UserClaims](h.Detail))
+
+	ag := sg.Group("/articles")
+	ag.POST("/add", ginx.WrapBodyAndToken[SeriesArticleReq, ijwt.UserClaims](h.AddArticle))
+	ag.POST("/remove", ginx.WrapBodyAndToken[SeriesArticleReq, ijwt.UserClaims](h.RemoveArticle))
+	ag.POST("/reorder", ginx.WrapBodyAndToken[SeriesReorderReq, ijwt.UserClaims](h.Reorder))
+}
+
+// Create 新建一个空的系列，再通过 /series/articles/add 往里面加帖子
+func (h *SeriesHandler) Create(ctx *gin.Context, req SeriesReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if !req.valid() {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	id, err := h.svc.Create(ctx, req.toDomain(uc.Uid))
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: id,
+	}, nil
+}
+
+func (h *SeriesHandler) Update(ctx *gin.Context, req SeriesReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if !req.valid() {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	err := h.svc.Update(ctx, req.toDomain(uc.Uid))
+	return h.result(err)
+}
+
+// Delete 删除系列，里面的帖子不受影响
+func (h *SeriesHandler) Delete(ctx *gin.Context, req SeriesReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Delete(ctx, uc.Uid, req.Id)
+	return h.result(err)
+}
+
+// List 自己的系列，最近更新的在前面
+func (h *SeriesHandler) List(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	offset, limit := pageQuery(ctx)
+	res, err := h.svc.ListByAuthor(ctx, uc.Uid, offset, limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(res, func(idx int, src domain.Series) SeriesVO {
+			return toSeriesVO(src)
+		}),
+	}, nil
+}
+
+// Detail 系列详情和目录，作者自己能看到撤回了的帖子
+func (h *SeriesHandler) Detail(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	s, err := h.svc.GetById(ctx, uc.Uid, id)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: toSeriesVO(s),
+		}, nil
+	case errors.Is(err, service.ErrSeriesNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "系列不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// AddArticle 把自己已经发表的帖子加到系列的最后面
+func (h *SeriesHandler) AddArticle(ctx *gin.Context, req SeriesArticleReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.AddArticle(ctx, uc.Uid, req.SeriesId, req.ArticleId)
+	switch {
+	case errors.Is(err, service.ErrArticleInSeries):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "帖子已经在系列里面了",
+		}, nil
+	case errors.Is(err, service.ErrSeriesArticleNotPublished):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "只能添加自己已经发表的帖子",
+		}, nil
+	default:
+		return h.result(err)
+	}
+}
+
+func (h *SeriesHandler) RemoveArticle(ctx *gin.Context, req SeriesArticleReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.RemoveArticle(ctx, uc.Uid, req.SeriesId, req.ArticleId)
+	return h.result(err)
+}
+
+// Reorder 调整系列里面帖子的顺序，要带上系列里面全部的帖子
+func (h *SeriesHandler) Reorder(ctx *gin.Context, req SeriesReorderReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Reorder(ctx, uc.Uid, req.SeriesId, req.ArticleIds)
+	if errors.Is(err, service.ErrSeriesOrderMismatch) {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "帖子和系列里面的对不上，请刷新之后再试",
+		}, nil
+	}
+	return h.result(err)
+}
+
+// result 修改类接口的通用返回
+func (h *SeriesHandler) result(err error) (ginx.Result, error) {
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrSeriesNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "系列不存在",
+		}, err
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type SeriesReq struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func (req SeriesReq) valid() bool {
+	title := strings.TrimSpace(req.Title)
+	return title != "" &&
+		utf8.RuneCountInString(title) <= maxSeriesTitleLen &&
+		utf8.RuneCountInString(req.Description) <= maxSeriesDescLen
+}
+
+func (req SeriesReq) toDomain(uid int64) domain.Series {
+	return domain.Series{
+		Id:          req.Id,
+		Author:      domain.Author{Id: uid},
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+	}
+}
+
+type SeriesArticleReq struct {
+	SeriesId  int64 `json:"series_id"`
+	ArticleId int64 `json:"article_id"`
+}
+
+type SeriesReorderReq struct {
+	SeriesId int64 `json:"series_id"`
+	// ArticleIds 系列里面全部的帖子，按照新的顺序排好
+	ArticleIds []int64 `json:"article_ids"`
+}
+
+type SeriesVO struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// Articles 列表页不带
+	Articles []SeriesArticleVO `json:"articles,omitempty"`
+	Ctime    string            `json:"ctime"`
+	Utime    string            `json:"utime"`
+}
+
+type SeriesArticleVO struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+	// Status 作者自己看的时候用来区分撤回了的帖子
+	Status uint8 `json:"status"`
+}
+
+// ArticleSeriesVO 读者看帖子的时候带上的系列导航
+type ArticleSeriesVO struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+	// Prev 和 Next 是上一篇和下一篇，第一篇没有上一篇，最后一篇没有下一篇
+	Prev     *SeriesArticleVO  `json:"prev,omitempty"`
+	Next     *SeriesArticleVO  `json:"next,omitempty"`
+	Articles []SeriesArticleVO `json:"articles"`
+}
+
+func toSeriesVO(s domain.Series) SeriesVO {
+	return SeriesVO{
+		Id:          s.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		Articles:    toSeriesArticleVOs(s.Articles),
+		Ctime:       s.Ctime.Format(time.DateTime),
+		Utime:       s.Utime.Format(time.DateTime),
+	}
+}
+
+// toArticleSeriesVO 帖子不属于任何系列的时候返回 nil
+func toArticleSeriesVO(s domain.Series, artId int64) *ArticleSeriesVO {
+	if s.Id == 0 {
+		return nil
+	}
+	res := &ArticleSeriesVO{
+		Id:       s.Id,
+		Title:    s.Title,
+		Articles: toSeriesArticleVOs(s.Articles),
+	}
+	prev, next := s.Neighbors(artId)
+	if prev != nil {
+		vo := toSeriesArticleVO(*prev)
+		res.Prev = &vo
+	}
+	if next != nil {
+		vo := toSeriesArticleVO(*next)
+		res.Next = &vo
+	}
+	return res
+}
+
+func toSeriesArticleVOs(arts []domain.SeriesArticle) []SeriesArticleVO {
+	return slice.Map(arts, func(idx int, src domain.SeriesArticle) SeriesArticleVO {
+		return toSeriesArticleVO(src)
+	})
+}
+
+func toSeriesArticleVO(art domain.SeriesArticle) SeriesArticleVO {
+	return SeriesArticleVO{
+		Id:     art.Id,
+		Title:  art.Title,
+		Status: art.Status.ToUint8(),
+	}
+}
@@ -0,0 +1,127 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*SessionHandler)(nil)
+
+// SessionHandler 查看和踢掉自己在别的设备上的登录
+type SessionHandler struct {
+	jwtHdl ijwt.JwtHandler
+	l      logger.Logger
+}
+
+func NewSessionHandler(jwtHdl ijwt.JwtHandler, l logger.Logger) *SessionHandler {
+	return &SessionHandler{
+		jwtHdl: jwtHdl,
+		l:      l,
+	}
+}
+
+func (h *SessionHandler) RegisterRoutes(server *gin.Engine) {
+	sg := server.Group("/users/sessions")
+	sg.GET("", h.List)
+	sg.POST("/revoke", h.Revoke)
+	sg.POST("/revoke_all", h.RevokeAll)
+}
+
+func (h *SessionHandler) List(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	sessions, err := h.jwtHdl.ListSessions(ctx, uc.Uid)
+	if err != nil {
+		h.l.Error("查询登录设备失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	res := make([]SessionVO, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, SessionVO{
+			Ssid:      s.Ssid,
+			Device:    s.Device,
+			UserAgent: s.UserAgent,
+			IP:        s.IP,
+			Current:   s.Ssid == uc.Ssid,
+			Ctime:     s.Ctime.Format(time.DateTime),
+			Rtime:     s.Rtime.Format(time.DateTime),
+		})
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Data: res,
+	})
+}
+
+// Revoke 踢掉当前设备的话和退出登录一样
+func (h *SessionHandler) Revoke(ctx *gin.Context) {
+	type Req struct {
+		Ssid string `json:"ssid"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.jwtHdl.RevokeSession(ctx, uc.Uid, req.Ssid)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Msg: "OK",
+		})
+	case errors.Is(err, ijwt.ErrSessionNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "登录设备不存在",
+		})
+	default:
+		h.l.Error("踢掉登录设备失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+// RevokeAll 所有设备都退出登录，包括当前这个
+func (h *SessionHandler) RevokeAll(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.jwtHdl.ClearSessions(ctx, uc.Uid)
+	if err != nil {
+		h.l.Error("退出所有登录设备失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.Header("x-jwt-token", "")
+	ctx.Header("x-refresh-token", "")
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "OK",
+	})
+}
+
+type SessionVO struct {
+	Ssid      string `json:"ssid"`
+	Device    string `json:"device"`
+	UserAgent string `json:"user_agent"`
+	IP        string `json:"ip"`
+	// Current 是不是当前正在用的这个
+	Current bool   `json:"current"`
+	Ctime   string `json:"ctime"`
+	// Rtime 最后一次刷新登录态的时间，大概就是最后一次活跃的时间
+	Rtime string `json:"rtime"`
+}
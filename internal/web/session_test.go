@@ -0,0 +1,99 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+func TestSessionHandler_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local)
+	jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+	jwtHdl.EXPECT().ListSessions(gomock.Any(), int64(123)).Return([]ijwt.Session{
+		{Ssid: "ssid-2", Device: "iPhone", IP: "127.0.0.2", Ctime: now, Rtime: now},
+		{Ssid: "ssid-1", Device: "Mac", IP: "127.0.0.1", Ctime: now, Rtime: now},
+	}, nil)
+	server := gin.Default()
+	server.Use(func(ctx *gin.Context) {
+		ctx.Set("claims", ijwt.UserClaims{Uid: 123, Ssid: "ssid-1"})
+	})
+	NewSessionHandler(jwtHdl, &logger.NopLogger{}).RegisterRoutes(server)
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/users/sessions", nil)
+	require.NoError(t, err)
+	server.ServeHTTP(recorder, req)
+
+	var res struct {
+		Code int         `json:"code"`
+		Data []SessionVO `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	assert.Equal(t, 0, res.Code)
+	assert.Equal(t, []SessionVO{
+		{Ssid: "ssid-2", Device: "iPhone", IP: "127.0.0.2",
+			Ctime: "2024-01-02 03:04:05", Rtime: "2024-01-02 03:04:05"},
+		{Ssid: "ssid-1", Device: "Mac", IP: "127.0.0.1", Current: true,
+			Ctime: "2024-01-02 03:04:05", Rtime: "2024-01-02 03:04:05"},
+	}, res.Data)
+}
+
+func TestSessionHandler_Revoke(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) ijwt.JwtHandler
+
+		wantRes Result
+	}{
+		{
+			name: "踢掉成功",
+			mock: func(ctrl *gomock.Controller) ijwt.JwtHandler {
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				jwtHdl.EXPECT().RevokeSession(gomock.Any(), int64(123), "ssid-2").Return(nil)
+				return jwtHdl
+			},
+			wantRes: Result{Msg: "OK"},
+		},
+		{
+			name: "不是自己的登录设备",
+			mock: func(ctrl *gomock.Controller) ijwt.JwtHandler {
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				jwtHdl.EXPECT().RevokeSession(gomock.Any(), int64(123), "ssid-2").
+					Return(ijwt.ErrSessionNotFound)
+				return jwtHdl
+			},
+			wantRes: Result{Code: 4, Msg: "登录设备不存在"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", ijwt.UserClaims{Uid: 123, Ssid: "ssid-1"})
+			})
+			NewSessionHandler(tc.mock(ctrl), &logger.NopLogger{}).RegisterRoutes(server)
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/sessions/revoke",
+				bytes.NewBuffer([]byte(`{"ssid":"ssid-2"}`)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			server.ServeHTTP(recorder, req)
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"strconv"
+	"time"
+	"webookpro/internal/domain"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/ginx"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*ShareHandler)(nil)
+
+// ShareHandler 帖子的分享链接，链接里面的 token 是签过名的，只带分享链接的 Id
+type ShareHandler struct {
+	svc      service.ShareService
+	shareKey []byte
+	l        logger.Logger
+}
+
+func NewShareHandler(svc service.ShareService, l logger.Logger) *ShareHandler {
+	return &ShareHandler{
+		svc:      svc,
+		shareKey: []byte("95osj3fUD7fo0mlYdDbncXz4VD2igvf2"),
+		l:        l,
+	}
+}
+
+func (h *ShareHandler) RegisterRoutes(server *gin.Engine) {
+	sg := server.Group("/articles/share")
+	sg.POST("/create", ginx.WrapBodyAndToken[ShareReq, ijwt.UserClaims](h.Create))
+	sg.POST("/revoke", ginx.WrapBodyAndToken[ShareReq, ijwt.UserClaims](h.Revoke))
+	sg.GET("/list/:id", ginx.WrapToken[ijwt.UserClaims](h.List))
+
+	// 拿到链接的人不需要登录，和 /articles/pub/:id 放在一起
+	server.GET("/articles/pub/share/:token", ginx.Wrap(h.View))
+}
+
+// Create 给自己还没有公开的帖子生成分享链接
+func (h *ShareHandler) Create(ctx *gin.Context, req ShareReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if req.ExpireHours < 0 || req.MaxViews < 0 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	share, err := h.svc.Create(ctx, uc.Uid, req.ArticleId,
+		time.Duration(req.ExpireHours)*time.Hour, req.MaxViews)
+	switch {
+	case err == nil:
+		vo, err := h.toShareVO(share)
+		if err != nil {
+			return ginx.Result{
+				Code: 5,
+				Msg:  "系统错误",
+			}, err
+		}
+		return ginx.Result{
+			Data: vo,
+		}, nil
+	case errors.Is(err, service.ErrArticleNotShareable):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "只能分享自己还没有公开的帖子",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// List 帖子的分享链接，包括已经失效了的，方便创作者撤销或者重新生成
+func (h *ShareHandler) List(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	artId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, err
+	}
+	shares, err := h.svc.List(ctx, uc.Uid, artId)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	res := make([]ShareVO, 0, len(shares))
+	for _, share := range shares {
+		vo, err := h.toShareVO(share)
+		if err != nil {
+			return ginx.Result{
+				Code: 5,
+				Msg:  "系统错误",
+			}, err
+		}
+		res = append(res, vo)
+	}
+	return ginx.Result{
+		Data: res,
+	}, nil
+}
+
+// Revoke 撤销之后链接马上失效，不能再恢复
+func (h *ShareHandler) Revoke(ctx *gin.Context, req ShareReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Revoke(ctx, uc.Uid, req.Id)
+	switch {
+	case err == nil:
+		return ginx.Result{Msg: "OK"}, nil
+	case errors.Is(err, service.ErrShareNotFound):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分享链接不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// View 通过分享链接看帖子，签名不对、过期、撤销了或者次数用完了都统一返回链接失效
+func (h *ShareHandler) View(ctx *gin.Context) (ginx.Result, error) {
+	var claims ShareClaims
+	_, err := jwt.ParseWithClaims(ctx.Param("token"), &claims, func(token *jwt.Token) (interface{}, error) {
+		return h.shareKey, nil
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分享链接已经失效",
+		}, nil
+	}
+	art, err := h.svc.View(ctx, claims.ShareId)
+	switch {
+	case err == nil:
+		return ginx.Result{
+			Data: ArticleVO{
+				Id:       art.Id,
+				Title:    art.Title,
+				Status:   art.Status.ToUint8(),
+				Content:  art.Content,
+				Html:     art.HTML,
+				Toc:      toTocVO(art.TOC),
+				Category: art.Category,
+				Tags:     art.Tags,
+				Ctime:    art.Ctime.Format(time.DateTime),
+				Utime:    art.Utime.Format(time.DateTime),
+			},
+		}, nil
+	case errors.Is(err, service.ErrShareUnavailable):
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分享链接已经失效",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// token 过期时间和分享链接一致，撤销和次数限制还是要查库
+func (h *ShareHandler) token(share domain.ArticleShare) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, ShareClaims{
+		ShareId: share.Id,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(share.Expire),
+		},
+	})
+	return token.SignedString(h.shareKey)
+}
+
+func (h *ShareHandler) toShareVO(share domain.ArticleShare) (ShareVO, error) {
+	token, err := h.token(share)
+	if err != nil {
+		return ShareVO{}, err
+	}
+	return ShareVO{
+		Id:        share.Id,
+		ArticleId: share.ArticleId,
+		Token:     token,
+		MaxViews:  share.MaxViews,
+		Views:     share.Views,
+		Available: share.Available(time.Now()),
+		Revoked:   share.Revoked,
+		Expire:    share.Expire.Format(time.DateTime),
+		Ctime:     share.Ctime.Format(time.DateTime),
+	}, nil
+}
+
+type ShareClaims struct {
+	jwt.RegisteredClaims
+	ShareId int64
+}
+
+type ShareReq struct {
+	// Id 撤销的时候用
+	Id        int64 `json:"id"`
+	ArticleId int64 `json:"article_id"`
+	// ExpireHours 多少小时之后失效，0 代表用默认的有效期
+	ExpireHours int64 `json:"expire_hours"`
+	// MaxViews 最多能看多少次，0 代表不限制
+	MaxViews int64 `json:"max_views"`
+}
+
+type ShareVO struct {
+	Id        int64 `json:"id"`
+	ArticleId int64 `json:"article_id"`
+	// Token 前端拼成 /articles/pub/share/{token} 发给别人
+	Token     string `json:"token"`
+	MaxViews  int64  `json:"max_views"`
+	Views     int64  `json:"views"`
+	Available bool   `json:"available"`
+	Revoked   bool   `json:"revoked"`
+	Expire    string `json:"expire"`
+	Ctime     string `json:"ctime"`
+}
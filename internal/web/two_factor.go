@@ -0,0 +1,217 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"webookpro/internal/service"
+	ijwt "webookpro/internal/web/jwt"
+	"webookpro/pkg/logger"
+)
+
+var _ handler = (*TwoFactorHandler)(nil)
+
+// TwoFactorHandler 开启、关闭两步验证，以及密码登录之后的第二步
+type TwoFactorHandler struct {
+	svc service.TwoFactorService
+	// accountSvc 停用或者注销中的账号通过两步验证之后，发的是恢复凭证
+	accountSvc service.AccountService
+	jwtHdl     ijwt.JwtHandler
+	l          logger.Logger
+}
+
+func NewTwoFactorHandler(svc service.TwoFactorService,
+	accountSvc service.AccountService,
+	jwtHdl ijwt.JwtHandler,
+	l logger.Logger) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		svc:        svc,
+		accountSvc: accountSvc,
+		jwtHdl:     jwtHdl,
+		l:          l,
+	}
+}
+
+func (h *TwoFactorHandler) RegisterRoutes(server *gin.Engine) {
+	tg := server.Group("/users/2fa")
+	tg.POST("/enroll", h.Enroll)
+	tg.POST("/confirm", h.Confirm)
+	tg.POST("/disable", h.Disable)
+	// 这一步还没有登录态，拿的是密码登录返回的挑战
+	server.POST("/users/login/2fa", h.Login)
+}
+
+// Enroll 恢复码只返回这一次，前端要提醒用户保存好
+func (h *TwoFactorHandler) Enroll(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	res, err := h.svc.Enroll(ctx, uc.Uid)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Data: TwoFactorEnrollmentVO{
+				URI:           res.URI,
+				Secret:        res.Secret,
+				RecoveryCodes: res.RecoveryCodes,
+			},
+		})
+	case errors.Is(err, service.ErrTwoFactorEnabled):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "已经开启了两步验证，请先关闭",
+		})
+	default:
+		h.l.Error("生成两步验证的密钥失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+func (h *TwoFactorHandler) Confirm(ctx *gin.Context) {
+	type Req struct {
+		Code string `json:"code"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.Confirm(ctx, uc.Uid, req.Code)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Msg: "已开启两步验证",
+		})
+	case errors.Is(err, service.ErrInvalidTwoFactorCode):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证码不对",
+		})
+	case errors.Is(err, service.ErrTwoFactorNotEnrolled):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "请先绑定认证器",
+		})
+	case errors.Is(err, service.ErrTwoFactorEnabled):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "已经开启了两步验证",
+		})
+	default:
+		h.l.Error("确认两步验证失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+// Disable 验证码或者恢复码都可以
+func (h *TwoFactorHandler) Disable(ctx *gin.Context) {
+	type Req struct {
+		Code string `json:"code"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	err := h.svc.Disable(ctx, uc.Uid, req.Code)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Msg: "已关闭两步验证",
+		})
+	case errors.Is(err, service.ErrInvalidTwoFactorCode):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证码不对",
+		})
+	case errors.Is(err, service.ErrTwoFactorNotEnabled):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "没有开启两步验证",
+		})
+	case errors.Is(err, service.ErrTwoFactorLocked):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "输错太多次，请稍后再试",
+		})
+	default:
+		h.l.Error("关闭两步验证失败",
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+	}
+}
+
+// Login 用挑战和验证码换登录态，恢复码也可以
+// 停用或者注销中的账号换到的是恢复凭证，跟没有开两步验证的时候一样
+func (h *TwoFactorHandler) Login(ctx *gin.Context) {
+	type Req struct {
+		Challenge string `json:"challenge"`
+		Code      string `json:"code"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uid, err := h.svc.VerifyChallenge(ctx, req.Challenge, req.Code)
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrUserInactive):
+		ctx.JSON(http.StatusOK, inactiveResult(ctx, h.accountSvc, uid))
+		return
+	case errors.Is(err, service.ErrInvalidTwoFactorCode):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证码不对",
+		})
+		return
+	case errors.Is(err, service.ErrTwoFactorLocked):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "输错太多次，请稍后再试",
+		})
+		return
+	case errors.Is(err, service.ErrInvalidChallenge):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "验证超时或者输错太多次，请重新登录",
+		})
+		return
+	default:
+		h.l.Error("两步验证登录失败", logger.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	err = h.jwtHdl.SetLoginToken(ctx, uid)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "登录成功",
+	})
+}
+
+type TwoFactorEnrollmentVO struct {
+	// URI 前端转成二维码
+	URI           string   `json:"uri"`
+	Secret        string   `json:"secret"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
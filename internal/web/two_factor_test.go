@@ -0,0 +1,99 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webookpro/internal/service"
+	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
+	"webookpro/pkg/logger"
+)
+
+func TestTwoFactorHandler_Login(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler)
+
+		wantRes Result
+	}{
+		{
+			name: "登录成功",
+			mock: func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockTwoFactorService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				svc.EXPECT().VerifyChallenge(gomock.Any(), "challenge", "123456").Return(int64(123), nil)
+				jwtHdl.EXPECT().SetLoginToken(gomock.Any(), int64(123)).Return(nil)
+				return svc, svcmocks.NewMockAccountService(ctrl), jwtHdl
+			},
+			wantRes: Result{Msg: "登录成功"},
+		},
+		{
+			name: "验证码不对",
+			mock: func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockTwoFactorService(ctrl)
+				svc.EXPECT().VerifyChallenge(gomock.Any(), "challenge", "123456").
+					Return(int64(0), service.ErrInvalidTwoFactorCode)
+				return svc, svcmocks.NewMockAccountService(ctrl), jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "验证码不对"},
+		},
+		{
+			name: "挑战无效",
+			mock: func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockTwoFactorService(ctrl)
+				svc.EXPECT().VerifyChallenge(gomock.Any(), "challenge", "123456").
+					Return(int64(0), service.ErrInvalidChallenge)
+				return svc, svcmocks.NewMockAccountService(ctrl), jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "验证超时或者输错太多次，请重新登录"},
+		},
+		{
+			name: "输错太多次被锁住了",
+			mock: func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockTwoFactorService(ctrl)
+				svc.EXPECT().VerifyChallenge(gomock.Any(), "challenge", "123456").
+					Return(int64(0), service.ErrTwoFactorLocked)
+				return svc, svcmocks.NewMockAccountService(ctrl), jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "输错太多次，请稍后再试"},
+		},
+		{
+			name: "账号停用了，验证通过之后才发恢复凭证",
+			mock: func(ctrl *gomock.Controller) (service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				svc := svcmocks.NewMockTwoFactorService(ctrl)
+				accountSvc := svcmocks.NewMockAccountService(ctrl)
+				svc.EXPECT().VerifyChallenge(gomock.Any(), "challenge", "123456").
+					Return(int64(123), service.ErrUserInactive)
+				accountSvc.EXPECT().RestoreTicket(gomock.Any(), int64(123)).Return("ticket", nil)
+				return svc, accountSvc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantRes: Result{Code: 4, Msg: "账号已停用或者正在注销，确认之后可以恢复", Data: "ticket"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc, accountSvc, jwtHdl := tc.mock(ctrl)
+			server := gin.Default()
+			NewTwoFactorHandler(svc, accountSvc, jwtHdl, &logger.NopLogger{}).RegisterRoutes(server)
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/login/2fa",
+				bytes.NewBuffer([]byte(`{"challenge":"challenge","code":"123456"}`)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			server.ServeHTTP(recorder, req)
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
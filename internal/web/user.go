@@ -1,13 +1,14 @@
 package web
 
 import (
-	"fmt"
+	"errors"
 	regexp "github.com/dlclark/regexp2"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"go.opentelemetry.io/otel/trace"
 	"net/http"
+	"time"
 	"webookpro/internal/domain"
 	"webookpro/internal/errs"
 	"webookpro/internal/service"
@@ -18,31 +19,40 @@ const biz = "login"
 
 var _ handler = (*UserHandler)(nil)
 
+// 重置密码的时候也要用同样的规则校验
+const (
+	emailRegexPattern    = "^\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*$"
+	passwordRegexPattern = `^(?=.*[A-Za-z])(?=.*\d)(?=.*[$@$!%*#?&])[A-Za-z\d$@$!%*#?&]{8,}$`
+	phoneRegexPattern    = "^1[0-9]{10}$"
+)
+
 type UserHandler struct {
 	emailExp    *regexp.Regexp
 	passwordExp *regexp.Regexp
 	phoneExp    *regexp.Regexp
 	svc         service.UserService
 	codeSvc     service.CodeService
+	accountSvc  service.AccountService
+	// twoFactorSvc 开启了两步验证的用户，密码登录之后还要输验证码
+	twoFactorSvc service.TwoFactorService
 	ijwt.JwtHandler
 }
 
-func NewUserHandler(svc service.UserService, codeSvc service.CodeService, jwtHdl ijwt.JwtHandler) *UserHandler {
-	const (
-		emailRegexPattern    = "^\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*$"
-		passwordRegexPattern = `^(?=.*[A-Za-z])(?=.*\d)(?=.*[$@$!%*#?&])[A-Za-z\d$@$!%*#?&]{8,}$`
-		phoneRegexPattern    = "^1[0-9]{10}$"
-	)
+func NewUserHandler(svc service.UserService, codeSvc service.CodeService,
+	accountSvc service.AccountService, twoFactorSvc service.TwoFactorService,
+	jwtHdl ijwt.JwtHandler) *UserHandler {
 	emailExp := regexp.MustCompile(emailRegexPattern, regexp.None)
 	passwordExp := regexp.MustCompile(passwordRegexPattern, regexp.None)
 	phoneExp := regexp.MustCompile(phoneRegexPattern, regexp.None)
 	return &UserHandler{
-		emailExp:    emailExp,
-		passwordExp: passwordExp,
-		phoneExp:    phoneExp,
-		svc:         svc,
-		codeSvc:     codeSvc,
-		JwtHandler:  jwtHdl,
+		emailExp:     emailExp,
+		passwordExp:  passwordExp,
+		phoneExp:     phoneExp,
+		svc:          svc,
+		codeSvc:      codeSvc,
+		accountSvc:   accountSvc,
+		twoFactorSvc: twoFactorSvc,
+		JwtHandler:   jwtHdl,
 	}
 }
 
@@ -51,6 +61,7 @@ func (u *UserHandler) RegisterRoutes(server *gin.Engine) {
 	ug.POST("/signup", u.SignUp)
 	ug.POST("/login", u.LoginJWT)
 	ug.GET("/profile", u.ProfileJWT)
+	ug.POST("/edit", u.Edit)
 	ug.POST("/login_sms/code/send", u.SendLoginSMSCode)
 	ug.POST("/login_sms", u.LoginSMS)
 	ug.POST("/refresh_token", u.RefreshToken)
@@ -210,6 +221,10 @@ func (u *UserHandler) LoginSMS(ctx *gin.Context) {
 	}
 	// 校验通过，拿到user 对象
 	user, err := u.svc.FindOrCreate(ctx, req.Phone)
+	if errors.Is(err, service.ErrUserInactive) {
+		ctx.JSON(http.StatusOK, inactiveResult(ctx, u.accountSvc, user.Id))
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -254,10 +269,35 @@ func (u *UserHandler) LoginJWT(ctx *gin.Context) {
 		})
 		return
 	}
+	inactive := errors.Is(err, service.ErrUserInactive)
+	if err != nil && !inactive {
+		ctx.String(http.StatusOK, "系统错误")
+		return
+	}
+	// 停用或者注销中的账号也要先过两步验证，恢复凭证在 /users/login/2fa 里面发
+	enabled, err := u.twoFactorSvc.Enabled(ctx, user.Id)
 	if err != nil {
 		ctx.String(http.StatusOK, "系统错误")
 		return
 	}
+	if enabled {
+		// 先不给登录态，带着挑战去 /users/login/2fa 输验证码
+		challenge, er := u.twoFactorSvc.Challenge(ctx, user.Id)
+		if er != nil {
+			ctx.String(http.StatusOK, "系统错误")
+			return
+		}
+		ctx.JSON(http.StatusOK, Result{
+			Code: 3,
+			Msg:  "请输入两步验证码",
+			Data: challenge,
+		})
+		return
+	}
+	if inactive {
+		ctx.JSON(http.StatusOK, inactiveResult(ctx, u.accountSvc, user.Id))
+		return
+	}
 	// 生成 jwt  access token 和 refresh token
 	err = u.SetLoginToken(ctx, user.Id)
 	if err != nil {
@@ -285,6 +325,10 @@ func (u *UserHandler) Login(ctx *gin.Context) {
 		ctx.String(http.StatusOK, "用户名或密码不对")
 		return
 	}
+	if errors.Is(err, service.ErrUserInactive) {
+		ctx.JSON(http.StatusOK, inactiveResult(ctx, u.accountSvc, user.Id))
+		return
+	}
 	if err != nil {
 		ctx.String(http.StatusOK, "系统错误")
 		return
@@ -335,7 +379,48 @@ func (u *UserHandler) ProfileJWT(ctx *gin.Context) {
 		ctx.String(http.StatusOK, "系统错误")
 		return
 	}
-	ctx.String(http.StatusOK, fmt.Sprintf("这是user:%#v 的profile", user))
+	ctx.JSON(http.StatusOK, Result{
+		Data: toProfileVO(user),
+	})
+}
+
+// Edit 修改昵称、简介、生日和头像，每次都是整体覆盖，没有传的字段会被清空
+func (u *UserHandler) Edit(ctx *gin.Context) {
+	var req UserEditReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(ijwt.UserClaims)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	user, msg := req.toDomain(claims.Uid, time.Now())
+	if msg != "" {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  msg,
+		})
+		return
+	}
+	err := u.svc.UpdateProfile(ctx, user)
+	if errors.Is(err, service.ErrUserDuplicateNickname) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "昵称已经被别人用了，请换一个",
+		})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Msg: "OK",
+	})
 }
 
 // Profile 用户信息
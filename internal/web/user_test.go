@@ -2,6 +2,7 @@ package web
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -9,10 +10,14 @@ import (
 	"go.uber.org/mock/gomock"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 	"webookpro/internal/domain"
 	"webookpro/internal/service"
 	svcmocks "webookpro/internal/service/mock"
+	ijwt "webookpro/internal/web/jwt"
+	jwtmocks "webookpro/internal/web/jwt/mocks"
 )
 
 // TestUserHandler_SignUp
@@ -149,7 +154,7 @@ func TestUserHandler_SignUp(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			userSvc := tc.mock(ctrl)
-			userHdl := NewUserHandler(userSvc, nil, nil)
+			userHdl := NewUserHandler(userSvc, nil, nil, nil, nil)
 			userHdl.RegisterRoutes(server)
 
 			recorder := httptest.NewRecorder()
@@ -163,3 +168,166 @@ func TestUserHandler_SignUp(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_Edit(t *testing.T) {
+	testcases := []struct {
+		name    string
+		reqBody string
+		mock    func(controller *gomock.Controller) service.UserService
+		wantRes Result
+	}{
+		{
+			name:    "修改成功",
+			reqBody: `{"nickname":" 大明 ","bio":"写 Go 的","birthday":"1990-01-02","avatar":"/attachments/files/a.png"}`,
+			mock: func(controller *gomock.Controller) service.UserService {
+				userSvc := svcmocks.NewMockUserService(controller)
+				userSvc.EXPECT().UpdateProfile(gomock.Any(), domain.User{
+					Id:       123,
+					Nickname: "大明",
+					Bio:      "写 Go 的",
+					Birthday: time.Date(1990, 1, 2, 0, 0, 0, 0, time.Local),
+					Avatar:   "/attachments/files/a.png",
+				}).Return(nil)
+				return userSvc
+			},
+			wantRes: Result{Msg: "OK"},
+		},
+		{
+			name:    "昵称重复",
+			reqBody: `{"nickname":"大明","avatar":"https://example.com/a.png"}`,
+			mock: func(controller *gomock.Controller) service.UserService {
+				userSvc := svcmocks.NewMockUserService(controller)
+				userSvc.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).
+					Return(service.ErrUserDuplicateNickname)
+				return userSvc
+			},
+			wantRes: Result{Code: 4, Msg: "昵称已经被别人用了，请换一个"},
+		},
+		{
+			name:    "昵称为空",
+			reqBody: `{"nickname":"  "}`,
+			wantRes: Result{Code: 4, Msg: "昵称不能为空，也不能超过 24 个字"},
+		},
+		{
+			name:    "生日在将来",
+			reqBody: `{"nickname":"大明","birthday":"` + time.Now().AddDate(0, 0, 2).Format(time.DateOnly) + `"}`,
+			wantRes: Result{Code: 4, Msg: "生日不合法"},
+		},
+		{
+			name:    "头像不是 http 链接",
+			reqBody: `{"nickname":"大明","avatar":"javascript:alert(1)"}`,
+			wantRes: Result{Code: 4, Msg: "头像地址不合法"},
+		},
+		{
+			name:    "简介太长",
+			reqBody: `{"nickname":"大明","bio":"` + strings.Repeat("长", 257) + `"}`,
+			wantRes: Result{Code: 4, Msg: "简介不能超过 256 个字"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", ijwt.UserClaims{Uid: 123})
+			})
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var userSvc service.UserService = svcmocks.NewMockUserService(ctrl)
+			if tc.mock != nil {
+				userSvc = tc.mock(ctrl)
+			}
+			NewUserHandler(userSvc, nil, nil, nil, nil).RegisterRoutes(server)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/edit", bytes.NewBuffer([]byte(tc.reqBody)))
+			req.Header.Set("Content-Type", "application/json")
+			require.NoError(t, err)
+			server.ServeHTTP(recorder, req)
+
+			var res Result
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUserHandler_LoginJWT(t *testing.T) {
+	testcases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.UserService, service.TwoFactorService, service.AccountService, ijwt.JwtHandler)
+
+		wantBody string
+	}{
+		{
+			name: "没有开启两步验证",
+			mock: func(ctrl *gomock.Controller) (service.UserService, service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				jwtHdl := jwtmocks.NewMockJwtHandler(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{Id: 123}, nil)
+				tfSvc.EXPECT().Enabled(gomock.Any(), int64(123)).Return(false, nil)
+				jwtHdl.EXPECT().SetLoginToken(gomock.Any(), int64(123)).Return(nil)
+				return userSvc, tfSvc, svcmocks.NewMockAccountService(ctrl), jwtHdl
+			},
+			wantBody: "登录成功",
+		},
+		{
+			name: "开启了两步验证，先发挑战",
+			mock: func(ctrl *gomock.Controller) (service.UserService, service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{Id: 123}, nil)
+				tfSvc.EXPECT().Enabled(gomock.Any(), int64(123)).Return(true, nil)
+				tfSvc.EXPECT().Challenge(gomock.Any(), int64(123)).Return("challenge", nil)
+				return userSvc, tfSvc, svcmocks.NewMockAccountService(ctrl), jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantBody: `{"code":3,"msg":"请输入两步验证码","data":"challenge"}`,
+		},
+		{
+			name: "停用的账号开启了两步验证，先发挑战，不发恢复凭证",
+			mock: func(ctrl *gomock.Controller) (service.UserService, service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{Id: 123}, service.ErrUserInactive)
+				tfSvc.EXPECT().Enabled(gomock.Any(), int64(123)).Return(true, nil)
+				tfSvc.EXPECT().Challenge(gomock.Any(), int64(123)).Return("challenge", nil)
+				return userSvc, tfSvc, svcmocks.NewMockAccountService(ctrl), jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantBody: `{"code":3,"msg":"请输入两步验证码","data":"challenge"}`,
+		},
+		{
+			name: "停用的账号没有开启两步验证，直接发恢复凭证",
+			mock: func(ctrl *gomock.Controller) (service.UserService, service.TwoFactorService, service.AccountService, ijwt.JwtHandler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				accountSvc := svcmocks.NewMockAccountService(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{Id: 123}, service.ErrUserInactive)
+				tfSvc.EXPECT().Enabled(gomock.Any(), int64(123)).Return(false, nil)
+				accountSvc.EXPECT().RestoreTicket(gomock.Any(), int64(123)).Return("ticket", nil)
+				return userSvc, tfSvc, accountSvc, jwtmocks.NewMockJwtHandler(ctrl)
+			},
+			wantBody: `{"code":4,"msg":"账号已停用或者正在注销，确认之后可以恢复","data":"ticket"}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userSvc, tfSvc, accountSvc, jwtHdl := tc.mock(ctrl)
+			server := gin.Default()
+			NewUserHandler(userSvc, nil, accountSvc, tfSvc, jwtHdl).RegisterRoutes(server)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/users/login",
+				bytes.NewBuffer([]byte(`{"email":"123@qq.com","password":"hello#world123"}`)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			server.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.wantBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package web
+
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+	"webookpro/internal/domain"
+)
+
+const (
+	maxNicknameLen = 24
+	maxBioLen      = 256
+	maxAvatarLen   = 1024
+)
+
+// minBirthday 再早的生日肯定是乱填的
+var minBirthday = time.Date(1900, 1, 1, 0, 0, 0, 0, time.Local)
+
+type UserEditReq struct {
+	Nickname string `json:"nickname"`
+	Bio      string `json:"bio"`
+	// Birthday 2006-01-02 这种格式，空字符串就是不填
+	Birthday string `json:"birthday"`
+	// Avatar 上传之后拿到的 /attachments/files/ 地址，或者 http(s) 的链接
+	Avatar string `json:"avatar"`
+}
+
+// toDomain 校验不通过的时候返回给用户看的原因
+func (req UserEditReq) toDomain(uid int64, now time.Time) (domain.User, string) {
+	nickname := strings.TrimSpace(req.Nickname)
+	if nickname == "" || utf8.RuneCountInString(nickname) > maxNicknameLen ||
+		strings.IndexFunc(nickname, unicode.IsControl) >= 0 {
+		return domain.User{}, "昵称不能为空，也不能超过 24 个字"
+	}
+	bio := strings.TrimSpace(req.Bio)
+	if utf8.RuneCountInString(bio) > maxBioLen {
+		return domain.User{}, "简介不能超过 256 个字"
+	}
+	var birthday time.Time
+	if req.Birthday != "" {
+		var err error
+		birthday, err = time.ParseInLocation(time.DateOnly, req.Birthday, time.Local)
+		if err != nil || !birthday.Before(now) || birthday.Before(minBirthday) {
+			return domain.User{}, "生日不合法"
+		}
+	}
+	avatar := strings.TrimSpace(req.Avatar)
+	if !validAvatar(avatar) {
+		return domain.User{}, "头像地址不合法"
+	}
+	return domain.User{
+		Id:       uid,
+		Nickname: nickname,
+		Bio:      bio,
+		Birthday: birthday,
+		Avatar:   avatar,
+	}, ""
+}
+
+// validAvatar 空的就是用默认头像
+func validAvatar(avatar string) bool {
+	if avatar == "" {
+		return true
+	}
+	if len(avatar) > maxAvatarLen {
+		return false
+	}
+	if strings.HasPrefix(avatar, domain.AttachmentURLPrefix) {
+		return !strings.Contains(avatar, "..")
+	}
+	u, err := url.Parse(avatar)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+type ProfileVO struct {
+	Id       int64  `json:"id"`
+	Email    string `json:"email"`
+	Phone    string `json:"phone"`
+	Nickname string `json:"nickname"`
+	Bio      string `json:"bio"`
+	// Birthday 没有填就是空字符串
+	Birthday string `json:"birthday"`
+	Avatar   string `json:"avatar"`
+	// Wechat 有没有绑定微信
+	Wechat bool   `json:"wechat"`
+	Ctime  string `json:"ctime"`
+}
+
+func toProfileVO(u domain.User) ProfileVO {
+	vo := ProfileVO{
+		Id:       u.Id,
+		Email:    u.Email,
+		Phone:    u.Phone,
+		Nickname: u.Nickname,
+		Bio:      u.Bio,
+		Avatar:   u.Avatar,
+		Wechat:   u.Bound(domain.LoginMethodWechat),
+		Ctime:    u.Ctime.Format(time.DateTime),
+	}
+	if !u.Birthday.IsZero() {
+		vo.Birthday = u.Birthday.Format(time.DateOnly)
+	}
+	return vo
+}
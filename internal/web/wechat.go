@@ -7,23 +7,28 @@ import (
 	"github.com/google/uuid"
 	"net/http"
 	"time"
+	"webookpro/internal/domain"
 	"webookpro/internal/service"
 	"webookpro/internal/service/oauth2/wechat"
 	ijwt "webookpro/internal/web/jwt"
 )
 
 type OAuth2WechatHandler struct {
-	svc      wechat.Oauth2Service
-	userSvc  service.UserService
-	stateKey []byte
+	svc        wechat.Oauth2Service
+	userSvc    service.UserService
+	bindingSvc service.BindingService
+	accountSvc service.AccountService
+	stateKey   []byte
 	ijwt.JwtHandler
 }
 
 func NewOAuth2WechatHandler(svc wechat.Oauth2Service, userSvc service.UserService,
-	jwtHdl ijwt.JwtHandler) *OAuth2WechatHandler {
+	bindingSvc service.BindingService, accountSvc service.AccountService, jwtHdl ijwt.JwtHandler) *OAuth2WechatHandler {
 	return &OAuth2WechatHandler{
 		svc:        svc,
 		userSvc:    userSvc,
+		bindingSvc: bindingSvc,
+		accountSvc: accountSvc,
 		stateKey:   []byte("95osj3fUD7fo0mlYdDbncXz4VD2igvf1"),
 		JwtHandler: jwtHdl,
 	}
@@ -32,11 +37,23 @@ func NewOAuth2WechatHandler(svc wechat.Oauth2Service, userSvc service.UserServic
 func (h *OAuth2WechatHandler) RegisterRoutes(server *gin.Engine) {
 	g := server.Group("/oauth2/wechat")
 	g.GET("/authurl", h.AuthUrl)
+	// 已经登录的用户绑定微信，扫码之后回调的还是 /callback
+	g.GET("/bind/authurl", h.BindAuthUrl)
 	g.Any("/callback", h.CallBack)
 }
 
 // AuthUrl 构造跳转到微信扫码登录页面的url 并返回给前端跳转
 func (h *OAuth2WechatHandler) AuthUrl(ctx *gin.Context) {
+	h.authUrl(ctx, 0)
+}
+
+// BindAuthUrl 和登录一样扫码，state 里面记下当前登录的用户，回调的时候就是绑定
+func (h *OAuth2WechatHandler) BindAuthUrl(ctx *gin.Context) {
+	uc := ctx.MustGet("claims").(ijwt.UserClaims)
+	h.authUrl(ctx, uc.Uid)
+}
+
+func (h *OAuth2WechatHandler) authUrl(ctx *gin.Context, uid int64) {
 	// 生成一个state token 塞到 cookie 在微信的callback中校验
 	state := uuid.New().String()
 	url, err := h.svc.AuthURL(ctx, state)
@@ -47,7 +64,7 @@ func (h *OAuth2WechatHandler) AuthUrl(ctx *gin.Context) {
 		})
 		return
 	}
-	err = h.SetStateCookie(ctx, state)
+	err = h.SetStateCookie(ctx, state, uid)
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -69,7 +86,7 @@ func (h *OAuth2WechatHandler) CallBack(ctx *gin.Context) {
 	code := ctx.Query("code")
 	state := ctx.Query("state")
 	// 校验state是否正确
-	err := h.VerifyStateCookie(ctx, state)
+	claims, err := h.VerifyStateCookie(ctx, state)
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -86,8 +103,16 @@ func (h *OAuth2WechatHandler) CallBack(ctx *gin.Context) {
 		})
 		return
 	}
+	if claims.Uid > 0 {
+		h.bind(ctx, claims.Uid, wechatInfo)
+		return
+	}
 	// 查找或新建用户
 	user, err := h.userSvc.FindOrCreateByWechat(ctx, wechatInfo)
+	if errors.Is(err, service.ErrUserInactive) {
+		ctx.JSON(http.StatusOK, inactiveResult(ctx, h.accountSvc, user.Id))
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -111,10 +136,32 @@ func (h *OAuth2WechatHandler) CallBack(ctx *gin.Context) {
 	})
 }
 
+// bind 绑定微信，不用重新登录
+func (h *OAuth2WechatHandler) bind(ctx *gin.Context, uid int64, wechatInfo domain.WeChatInfo) {
+	err := h.bindingSvc.BindWechat(ctx, uid, wechatInfo)
+	if err == nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 2,
+			Msg:  "绑定成功",
+		})
+		return
+	}
+	if res, ok := bindingErrResult(err); ok {
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 5,
+		Msg:  "系统错误",
+	})
+}
+
 // SetStateCookie authurl请求到微信时，将state数据写到jwttoken并设置到cookie
-func (h *OAuth2WechatHandler) SetStateCookie(ctx *gin.Context, state string) error {
+// uid 不是 0 说明是绑定微信
+func (h *OAuth2WechatHandler) SetStateCookie(ctx *gin.Context, state string, uid int64) error {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, StateClaims{
 		State: state,
+		Uid:   uid,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute * 5)), // 我预计用户在5分钟内登录完成
 		},
@@ -131,27 +178,29 @@ func (h *OAuth2WechatHandler) SetStateCookie(ctx *gin.Context, state string) err
 }
 
 // VerifyStateCookie 校验微信callback回来带的cookie是否和拿到的一致
-func (h *OAuth2WechatHandler) VerifyStateCookie(ctx *gin.Context, state string) error {
+func (h *OAuth2WechatHandler) VerifyStateCookie(ctx *gin.Context, state string) (StateClaims, error) {
 	// 从cookie中拿token
 	tokenStr, err := ctx.Cookie("jwt-state")
 	if err != nil {
-		return err
+		return StateClaims{}, err
 	}
 	var claims StateClaims
 	_, err = jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (interface{}, error) {
 		return h.stateKey, nil
 	})
 	if err != nil {
-		return err
+		return StateClaims{}, err
 	}
 	// 校验 state 是否相等
 	if state != claims.State {
-		return errors.New("state不一致")
+		return StateClaims{}, errors.New("state不一致")
 	}
-	return nil
+	return claims, nil
 }
 
 type StateClaims struct {
 	jwt.RegisteredClaims
 	State string
+	// Uid 绑定微信的用户，登录的时候是 0
+	Uid int64
 }
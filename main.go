@@ -30,6 +30,15 @@ func main() {
 	}
 	// 开启所有定时任务
 	app.cron.Start()
+	// 开启分布式任务调度，定时发表之类的任务都在这里
+	schedulerCtx, schedulerCancel := context.WithCancel(context.Background())
+	defer schedulerCancel()
+	go func() {
+		err := app.scheduler.Schedule(schedulerCtx)
+		if err != nil && err != context.Canceled {
+			zap.L().Error("任务调度退出", zap.Error(err))
+		}
+	}()
 
 	// 开启web服务
 	server := app.web
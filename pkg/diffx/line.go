@@ -0,0 +1,92 @@
+package diffx
+
+import "strings"
+
+type Op uint8
+
+const (
+	OpEqual Op = iota
+	OpInsert
+	OpDelete
+)
+
+func (o Op) String() string {
+	switch o {
+	case OpInsert:
+		return "+"
+	case OpDelete:
+		return "-"
+	default:
+		return " "
+	}
+}
+
+// Line 行级 diff 的一行
+type Line struct {
+	Op   Op
+	Text string
+	// OldNo 和 NewNo 是在旧文本和新文本里面的行号，从 1 开始
+	// 0 代表这一行在对应的文本里面不存在
+	OldNo int
+	NewNo int
+}
+
+// Lines 按行比较 a 和 b，返回把 a 变成 b 的编辑过程
+// 用的是最朴素的 LCS 算法，复杂度 O(m*n)，帖子的行数一般也就几百行，够用了
+func Lines(a, b string) []Line {
+	as, bs := splitLines(a), splitLines(b)
+	m, n := len(as), len(bs)
+	// lcs[i][j] 代表 as[i:] 和 bs[j:] 的最长公共子序列长度
+	lcs := make([][]int, m+1)
+	for i := range lcs {
+		lcs[i] = make([]int, n+1)
+	}
+	for i := m - 1; i >= 0; i-- {
+		for j := n - 1; j >= 0; j-- {
+			if as[i] == bs[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	res := make([]Line, 0, maxInt(m, n))
+	i, j := 0, 0
+	for i < m && j < n {
+		switch {
+		case as[i] == bs[j]:
+			res = append(res, Line{Op: OpEqual, Text: as[i], OldNo: i + 1, NewNo: j + 1})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, Line{Op: OpDelete, Text: as[i], OldNo: i + 1})
+			i++
+		default:
+			res = append(res, Line{Op: OpInsert, Text: bs[j], NewNo: j + 1})
+			j++
+		}
+	}
+	for ; i < m; i++ {
+		res = append(res, Line{Op: OpDelete, Text: as[i], OldNo: i + 1})
+	}
+	for ; j < n; j++ {
+		res = append(res, Line{Op: OpInsert, Text: bs[j], NewNo: j + 1})
+	}
+	return res
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(s, "\n")
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+package diffx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	testcases := []struct {
+		name string
+		a    string
+		b    string
+		want []Line
+	}{
+		{
+			name: "完全一样",
+			a:    "第一行\n第二行",
+			b:    "第一行\n第二行",
+			want: []Line{
+				{Op: OpEqual, Text: "第一行", OldNo: 1, NewNo: 1},
+				{Op: OpEqual, Text: "第二行", OldNo: 2, NewNo: 2},
+			},
+		},
+		{
+			name: "旧文本为空",
+			a:    "",
+			b:    "abc",
+			want: []Line{
+				{Op: OpInsert, Text: "abc", NewNo: 1},
+			},
+		},
+		{
+			name: "修改了中间一行",
+			a:    "a\nb\nc",
+			b:    "a\nB\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a", OldNo: 1, NewNo: 1},
+				{Op: OpDelete, Text: "b", OldNo: 2},
+				{Op: OpInsert, Text: "B", NewNo: 2},
+				{Op: OpEqual, Text: "c", OldNo: 3, NewNo: 3},
+			},
+		},
+		{
+			name: "删除末尾，兼容 windows 换行",
+			a:    "a\r\nb\r\nc",
+			b:    "a\nb",
+			want: []Line{
+				{Op: OpEqual, Text: "a", OldNo: 1, NewNo: 1},
+				{Op: OpEqual, Text: "b", OldNo: 2, NewNo: 2},
+				{Op: OpDelete, Text: "c", OldNo: 3},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Lines(tc.a, tc.b))
+		})
+	}
+}
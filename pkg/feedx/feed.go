@@ -0,0 +1,158 @@
+package feedx
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Feed 同一份数据既可以输出 RSS 2.0，也可以输出 Atom
+type Feed struct {
+	Title       string
+	Link        string
+	Description string
+	// Self 订阅地址本身，Atom 要求带上
+	Self    string
+	Author  string
+	Updated time.Time
+	Items   []Item
+}
+
+type Item struct {
+	// Id 全局唯一而且不能变，一般直接用链接
+	Id        string
+	Title     string
+	Link      string
+	Summary   string
+	Author    string
+	Published time.Time
+	Updated   time.Time
+}
+
+// RSS 输出 RSS 2.0
+func RSS(f Feed) ([]byte, error) {
+	ch := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Description,
+		LastBuildDate: f.Updated.Format(time.RFC1123Z),
+		Items:         make([]rssItem, 0, len(f.Items)),
+	}
+	for _, item := range f.Items {
+		ch.Items = append(ch.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Guid:        rssGuid{IsPermaLink: item.Id == item.Link, Value: item.Id},
+			Description: item.Summary,
+			Creator:     item.Author,
+			PubDate:     item.Published.Format(time.RFC1123Z),
+		})
+	}
+	return marshal(rss{
+		Version: "2.0",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: ch,
+	})
+}
+
+// Atom 输出 Atom 1.0，条目没有作者的时候用 Feed 的作者
+func Atom(f Feed) ([]byte, error) {
+	res := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Id:      f.Self,
+		Title:   f.Title,
+		Updated: f.Updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link},
+			{Href: f.Self, Rel: "self"},
+		},
+		Subtitle: f.Description,
+		Entries:  make([]atomEntry, 0, len(f.Items)),
+	}
+	if f.Author != "" {
+		res.Author = &atomAuthor{Name: f.Author}
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			Id:        item.Id,
+			Title:     item.Title,
+			Link:      atomLink{Href: item.Link},
+			Summary:   item.Summary,
+			Published: item.Published.Format(time.RFC3339),
+			Updated:   item.Updated.Format(time.RFC3339),
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		res.Entries = append(res.Entries, entry)
+	}
+	return marshal(res)
+}
+
+func marshal(v any) ([]byte, error) {
+	bs, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), bs...), nil
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Guid        rssGuid `xml:"guid"`
+	Description string  `xml:"description"`
+	// Creator RSS 自己的 author 要求是邮箱，所以用 dc:creator 放昵称
+	Creator string `xml:"dc:creator,omitempty"`
+	PubDate string `xml:"pubDate"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	Id       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   *atomAuthor `xml:"author,omitempty"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Id        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Summary   string      `xml:"summary,omitempty"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+}
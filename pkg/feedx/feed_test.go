@@ -0,0 +1,96 @@
+package feedx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var testFeed = Feed{
+	Title:       "webook",
+	Link:        "https://example.com",
+	Description: "最新发表的帖子",
+	Self:        "https://example.com/feeds/atom",
+	Author:      "webook",
+	Updated:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	Items: []Item{
+		{
+			Id:        "https://example.com/articles/1",
+			Title:     "a < b & c",
+			Link:      "https://example.com/articles/1",
+			Summary:   "摘要",
+			Author:    "大明",
+			Published: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Updated:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	},
+}
+
+func TestRSS(t *testing.T) {
+	bs, err := RSS(testFeed)
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>webook</title>
+    <link>https://example.com</link>
+    <description>最新发表的帖子</description>
+    <lastBuildDate>Tue, 02 Jan 2024 03:04:05 +0000</lastBuildDate>
+    <item>
+      <title>a &lt; b &amp; c</title>
+      <link>https://example.com/articles/1</link>
+      <guid isPermaLink="true">https://example.com/articles/1</guid>
+      <description>摘要</description>
+      <dc:creator>大明</dc:creator>
+      <pubDate>Mon, 01 Jan 2024 00:00:00 +0000</pubDate>
+    </item>
+  </channel>
+</rss>`, string(bs))
+}
+
+func TestAtom(t *testing.T) {
+	bs, err := Atom(testFeed)
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://example.com/feeds/atom</id>
+  <title>webook</title>
+  <subtitle>最新发表的帖子</subtitle>
+  <updated>2024-01-02T03:04:05Z</updated>
+  <link href="https://example.com"></link>
+  <link href="https://example.com/feeds/atom" rel="self"></link>
+  <author>
+    <name>webook</name>
+  </author>
+  <entry>
+    <id>https://example.com/articles/1</id>
+    <title>a &lt; b &amp; c</title>
+    <link href="https://example.com/articles/1"></link>
+    <summary>摘要</summary>
+    <author>
+      <name>大明</name>
+    </author>
+    <published>2024-01-01T00:00:00Z</published>
+    <updated>2024-01-02T03:04:05Z</updated>
+  </entry>
+</feed>`, string(bs))
+}
+
+func TestSitemap(t *testing.T) {
+	bs, err := Sitemap([]URL{
+		{Loc: "https://example.com/articles/1", LastMod: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{Loc: "https://example.com/articles/2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url>
+    <loc>https://example.com/articles/1</loc>
+    <lastmod>2024-01-02T03:04:05Z</lastmod>
+  </url>
+  <url>
+    <loc>https://example.com/articles/2</loc>
+  </url>
+</urlset>`, string(bs))
+}
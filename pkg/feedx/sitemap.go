@@ -0,0 +1,44 @@
+package feedx
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxSitemapURLs 协议规定一个 sitemap 文件最多这么多条
+const MaxSitemapURLs = 50000
+
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Sitemap 输出 sitemap 协议的 XML，超过 MaxSitemapURLs 的部分直接丢掉
+func Sitemap(urls []URL) ([]byte, error) {
+	if len(urls) > MaxSitemapURLs {
+		urls = urls[:MaxSitemapURLs]
+	}
+	set := urlSet{
+		Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  make([]sitemapURL, 0, len(urls)),
+	}
+	for _, u := range urls {
+		su := sitemapURL{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			su.LastMod = u.LastMod.Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, su)
+	}
+	return marshal(set)
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
@@ -70,7 +70,7 @@ func WrapBodyAndToken[Req any, C jwt.UserClaims](fn func(ctx *gin.Context, req R
 			return
 		}
 
-		val, ok := ctx.Get("users")
+		val, ok := ctx.Get("claims")
 		if !ok {
 			ctx.AbortWithStatus(http.StatusUnauthorized)
 			return
@@ -0,0 +1,52 @@
+package imagex
+
+import (
+	"image"
+	"image/color"
+)
+
+// Thumbnail 等比缩小到宽和高都不超过 maxSize
+// 本来就比 maxSize 小的图片原样返回，不会放大
+// 用的是区域平均，每个目标像素取原图里面对应区域所有像素的平均值，缩略图够用了
+func Thumbnail(src image.Image, maxSize int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSize && h <= maxSize {
+		return src
+	}
+	tw, th := maxSize, maxSize
+	if w > h {
+		th = h * maxSize / w
+	} else {
+		tw = w * maxSize / h
+	}
+	if tw < 1 {
+		tw = 1
+	}
+	if th < 1 {
+		th = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+(y+1)*h/th
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+(x+1)*w/tw
+			var r, g, bl, a, cnt uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					// RGBA 返回的是预乘过 alpha 的 16 位颜色
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					cnt++
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / cnt),
+				G: uint16(g / cnt),
+				B: uint16(bl / cnt),
+				A: uint16(a / cnt),
+			})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,66 @@
+package imagex
+
+import (
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestThumbnail(t *testing.T) {
+	testcases := []struct {
+		name     string
+		src      image.Image
+		maxSize  int
+		wantSize image.Point
+	}{
+		{
+			name:     "横图按照宽度缩小",
+			src:      image.NewRGBA(image.Rect(0, 0, 800, 400)),
+			maxSize:  200,
+			wantSize: image.Pt(200, 100),
+		},
+		{
+			name:     "竖图按照高度缩小",
+			src:      image.NewRGBA(image.Rect(0, 0, 300, 900)),
+			maxSize:  300,
+			wantSize: image.Pt(100, 300),
+		},
+		{
+			name:     "小图不放大",
+			src:      image.NewRGBA(image.Rect(0, 0, 50, 20)),
+			maxSize:  200,
+			wantSize: image.Pt(50, 20),
+		},
+		{
+			name:     "特别细长的图至少一个像素",
+			src:      image.NewRGBA(image.Rect(0, 0, 1000, 1)),
+			maxSize:  100,
+			wantSize: image.Pt(100, 1),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Thumbnail(tc.src, tc.maxSize)
+			assert.Equal(t, tc.wantSize, res.Bounds().Size())
+		})
+	}
+}
+
+func TestThumbnail_Color(t *testing.T) {
+	// 左边一半黑色，右边一半白色，缩成 2x1 之后还是一黑一白
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{A: 255}
+			if x >= 2 {
+				c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			}
+			src.SetRGBA(x, y, c)
+		}
+	}
+	res := Thumbnail(src, 2)
+	assert.Equal(t, image.Pt(2, 1), res.Bounds().Size())
+	assert.Equal(t, color.RGBA{A: 255}, color.RGBAModel.Convert(res.At(0, 0)))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(res.At(1, 0)))
+}
@@ -0,0 +1,350 @@
+package markdownx
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxInlineDepth 行内语法最多嵌套这么多层，再往里面的当成普通文字
+const maxInlineDepth = 8
+
+// renderInline 渲染行内的语法，其余的字符全部转义
+func renderInline(src string) string {
+	return newInlineParser(src, 0).render()
+}
+
+// inlineParser 渲染一段行内文字
+// 括号的配对一次算好，找不到的分隔符、反引号也记下来，
+// 这样没有闭合的 [ * ` < 不用每一个都扫到结尾，整段只扫常数遍
+type inlineParser struct {
+	src   string
+	depth int
+	// pairs [ 和 ( 的位置对应的 ] 和 ) 的位置，用到的时候才算
+	pairs map[int]int
+	// missing 从这个位置开始已经找不到这个分隔符了，再往后找也一样找不到
+	missing map[string]int
+}
+
+func newInlineParser(src string, depth int) *inlineParser {
+	return &inlineParser{src: src, depth: depth, missing: map[string]int{}}
+}
+
+func (p *inlineParser) render() string {
+	src := p.src
+	if p.depth >= maxInlineDepth {
+		return escape(src)
+	}
+	var sb strings.Builder
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch c {
+		case '\\':
+			// 反斜杠转义标点符号
+			if i+1 < len(src) && isPunct(src[i+1]) {
+				sb.WriteString(escape(src[i+1 : i+2]))
+				i += 2
+				continue
+			}
+		case '`':
+			n, _ := p.renderCode(&sb, i)
+			i += n
+			continue
+		case '!':
+			if strings.HasPrefix(src[i+1:], "[") {
+				if n, ok := p.renderLink(&sb, i+1, true); ok {
+					i += n + 1
+					continue
+				}
+			}
+		case '[':
+			if n, ok := p.renderLink(&sb, i, false); ok {
+				i += n
+				continue
+			}
+		case '<':
+			if n, ok := p.renderAutoLink(&sb, i); ok {
+				i += n
+				continue
+			}
+		case '*', '_', '~':
+			if n, ok := p.renderEmphasis(&sb, i); ok {
+				i += n
+				continue
+			}
+		}
+		_, size := utf8.DecodeRuneInString(src[i:])
+		sb.WriteString(escape(src[i : i+size]))
+		i += size
+	}
+	return sb.String()
+}
+
+// renderInner 渲染嵌套在里面的文字
+func (p *inlineParser) renderInner(src string) string {
+	return newInlineParser(src, p.depth+1).render()
+}
+
+// renderCode 行内代码，反引号的数量要一样
+// 匹配不上的话整串反引号原样输出，返回的 bool 代表是不是代码
+func (p *inlineParser) renderCode(sb *strings.Builder, i int) (int, bool) {
+	ticks := countTicks(p.src[i:])
+	fence := p.src[i : i+ticks]
+	end := p.index(i+ticks, fence)
+	if end < 0 {
+		sb.WriteString(fence)
+		return ticks, false
+	}
+	code := strings.TrimSpace(p.src[i+ticks : end])
+	sb.WriteString("<code>")
+	sb.WriteString(escape(code))
+	sb.WriteString("</code>")
+	return end + ticks - i, true
+}
+
+// renderLink 处理 [text](url "title")，image 代表是 ![alt](url)
+// 不安全的链接只保留文字
+func (p *inlineParser) renderLink(sb *strings.Builder, i int, image bool) (int, bool) {
+	src := p.src
+	textEnd := p.matchBracket(i)
+	if textEnd < 0 || textEnd+1 >= len(src) || src[textEnd+1] != '(' {
+		return 0, false
+	}
+	destEnd := p.matchBracket(textEnd + 1)
+	if destEnd < 0 {
+		return 0, false
+	}
+	text := src[i+1 : textEnd]
+	dest := strings.TrimSpace(src[textEnd+2 : destEnd])
+	var title string
+	if idx := strings.IndexAny(dest, " \t"); idx >= 0 {
+		title = strings.Trim(strings.TrimSpace(dest[idx:]), `"'`)
+		dest = dest[:idx]
+	}
+	dest = strings.TrimSuffix(strings.TrimPrefix(dest, "<"), ">")
+	n := destEnd + 1 - i
+	if image {
+		if !safeURL(dest, false) {
+			sb.WriteString(escape(text))
+			return n, true
+		}
+		sb.WriteString(`<img src="` + escape(dest) + `" alt="` + escape(stripTags(p.renderInner(text))) + `"`)
+		if title != "" {
+			sb.WriteString(` title="` + escape(title) + `"`)
+		}
+		sb.WriteString(">")
+		return n, true
+	}
+	content := p.renderInner(text)
+	if !safeURL(dest, true) {
+		sb.WriteString(content)
+		return n, true
+	}
+	writeAnchor(sb, dest, title, content)
+	return n, true
+}
+
+// renderAutoLink 处理 <https://example.com>，原始的 HTML 标签不会匹配上，会被转义
+func (p *inlineParser) renderAutoLink(sb *strings.Builder, i int) (int, bool) {
+	// 链接里面不能有空白和尖括号，遇到了就不用往后找了
+	end := strings.IndexAny(p.src[i+1:], "<> \t\n")
+	if end < 0 || p.src[i+1+end] != '>' {
+		return 0, false
+	}
+	end += i + 1
+	dest := p.src[i+1 : end]
+	lower := strings.ToLower(dest)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") ||
+		!safeURL(dest, true) {
+		return 0, false
+	}
+	writeAnchor(sb, dest, "", escape(dest))
+	return end + 1 - i, true
+}
+
+func writeAnchor(sb *strings.Builder, dest, title, content string) {
+	sb.WriteString(`<a href="` + escape(dest) + `"`)
+	if title != "" {
+		sb.WriteString(` title="` + escape(title) + `"`)
+	}
+	if hasScheme(dest) || strings.HasPrefix(dest, "//") {
+		// 站外链接，不传递权重，也不让目标页面拿到 window.opener
+		sb.WriteString(` rel="nofollow noopener noreferrer"`)
+	}
+	sb.WriteString(">")
+	sb.WriteString(content)
+	sb.WriteString("</a>")
+}
+
+// renderEmphasis 处理 **粗体**、*斜体*、~~删除线~~
+// _ 只在单词边界上生效，避免把 snake_case 当成斜体
+func (p *inlineParser) renderEmphasis(sb *strings.Builder, i int) (int, bool) {
+	src := p.src
+	c := src[i]
+	delim := string(c)
+	if strings.HasPrefix(src[i:], delim+delim) {
+		delim += delim
+	} else if c == '~' {
+		return 0, false
+	}
+	if c == '_' && i > 0 && isWordByte(src[i-1]) {
+		return 0, false
+	}
+	start := i + len(delim)
+	// 开始的分隔符后面不能是空白
+	if start >= len(src) || src[start] == ' ' || src[start] == '\n' {
+		return 0, false
+	}
+	end := p.findCloser(start, delim)
+	if end < 0 {
+		return 0, false
+	}
+	tag := "em"
+	switch {
+	case c == '~':
+		tag = "del"
+	case len(delim) == 2:
+		tag = "strong"
+	}
+	sb.WriteString("<" + tag + ">")
+	sb.WriteString(p.renderInner(src[start:end]))
+	sb.WriteString("</" + tag + ">")
+	return end + len(delim) - i, true
+}
+
+// findCloser 找到结束的分隔符，跳过行内代码
+func (p *inlineParser) findCloser(from int, delim string) int {
+	if pos, ok := p.missing[delim]; ok && from >= pos {
+		return -1
+	}
+	src := p.src
+	for j := from; j < len(src); j++ {
+		switch {
+		case src[j] == '\\':
+			j++
+		case src[j] == '`':
+			ticks := countTicks(src[j:])
+			if end := p.index(j+ticks, src[j:j+ticks]); end >= 0 {
+				j = end + ticks - 1
+			} else {
+				j += ticks - 1
+			}
+		case strings.HasPrefix(src[j:], delim):
+			// 结束的分隔符前面不能是空白
+			if src[j-1] == ' ' || src[j-1] == '\n' {
+				continue
+			}
+			// 单个的 * 不能是 ** 的一部分
+			if len(delim) == 1 && j+1 < len(src) && src[j+1] == delim[0] {
+				j++
+				continue
+			}
+			if delim[0] == '_' && j+len(delim) < len(src) && isWordByte(src[j+len(delim)]) {
+				continue
+			}
+			return j
+		}
+	}
+	p.missing[delim] = from
+	return -1
+}
+
+// index 从 from 开始找 s，找不到的位置记下来
+func (p *inlineParser) index(from int, s string) int {
+	if pos, ok := p.missing[s]; ok && from >= pos {
+		return -1
+	}
+	idx := strings.Index(p.src[from:], s)
+	if idx < 0 {
+		p.missing[s] = from
+		return -1
+	}
+	return from + idx
+}
+
+// matchBracket i 是 [ 或者 ( 的位置，返回对应的 ] 或者 ) 的位置
+func (p *inlineParser) matchBracket(i int) int {
+	if p.pairs == nil {
+		p.pairs = pairBrackets(p.src)
+	}
+	if end, ok := p.pairs[i]; ok {
+		return end
+	}
+	return -1
+}
+
+// pairBrackets 扫一遍，把 [] 和 () 各自配对
+func pairBrackets(src string) map[int]int {
+	pairs := map[int]int{}
+	var squares, parens []int
+	for i := 0; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case '[':
+			squares = append(squares, i)
+		case '(':
+			parens = append(parens, i)
+		case ']':
+			if n := len(squares); n > 0 {
+				pairs[squares[n-1]] = i
+				squares = squares[:n-1]
+			}
+		case ')':
+			if n := len(parens); n > 0 {
+				pairs[parens[n-1]] = i
+				parens = parens[:n-1]
+			}
+		}
+	}
+	return pairs
+}
+
+func countTicks(src string) int {
+	return len(src) - len(strings.TrimLeft(src, "`"))
+}
+
+// safeURL 只允许 http、https 和相对路径，链接还允许 mailto
+// javascript:、data: 之类的全部拒绝
+func safeURL(dest string, link bool) bool {
+	if dest == "" {
+		return false
+	}
+	for _, c := range dest {
+		if unicode.IsSpace(c) || unicode.IsControl(c) {
+			return false
+		}
+	}
+	if !hasScheme(dest) {
+		// 相对路径、#锚点，还有协议相对的 //example.com
+		return true
+	}
+	scheme := strings.ToLower(dest[:strings.IndexByte(dest, ':')])
+	switch scheme {
+	case "http", "https":
+		return true
+	case "mailto":
+		return link
+	default:
+		return false
+	}
+}
+
+// hasScheme 冒号出现在 / ? # 之前，说明带了协议
+func hasScheme(dest string) bool {
+	idx := strings.IndexAny(dest, ":/?#")
+	return idx > 0 && dest[idx] == ':'
+}
+
+func escape(s string) string {
+	return html.EscapeString(s)
+}
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("`^<>|~+=$", c) >= 0
+}
+
+func isWordByte(c byte) bool {
+	return c >= utf8.RuneSelf || c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
@@ -0,0 +1,300 @@
+package markdownx
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Heading 目录里面的一项
+type Heading struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// Document 渲染的结果
+type Document struct {
+	// HTML 已经是安全的了，原始的 HTML 标签全部都会被转义，链接只允许 http、https、mailto 和相对路径
+	HTML string
+	// TOC 按照出现的顺序排列的标题
+	TOC []Heading
+	// Text 去掉了 Markdown 语法的纯文本，用来做摘要
+	Text string
+}
+
+// Render 把 Markdown 渲染成 HTML
+// 只支持常用的语法：标题、段落、引用、列表、代码块、分割线、强调、行内代码、链接和图片
+// 不支持内嵌 HTML，也不支持表格之类的扩展语法，它们会原样当成文本输出
+func Render(src string) Document {
+	r := &renderer{ids: map[string]int{}}
+	var sb strings.Builder
+	r.renderBlocks(&sb, splitLines(src))
+	res := sb.String()
+	return Document{
+		HTML: res,
+		TOC:  r.toc,
+		Text: stripTags(res),
+	}
+}
+
+var (
+	headingRegexp  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	hrRegexp       = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fenceRegexp    = regexp.MustCompile("^ {0,3}(```+|~~~+)[ \t]*([^`\\s]*)")
+	ulRegexp       = regexp.MustCompile(`^( {0,3})[-*+][ \t]+`)
+	olRegexp       = regexp.MustCompile(`^( {0,3})(\d{1,9})[.)][ \t]+`)
+	quoteRegexp    = regexp.MustCompile(`^ {0,3}> ?`)
+	languageRegexp = regexp.MustCompile(`^[A-Za-z0-9_+#-]+$`)
+)
+
+type renderer struct {
+	toc []Heading
+	// ids 用来给重复的标题生成不一样的锚点
+	ids map[string]int
+}
+
+func (r *renderer) renderBlocks(sb *strings.Builder, lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isBlank(line):
+			i++
+		case fenceRegexp.MatchString(line):
+			i = r.renderFence(sb, lines, i)
+		case headingRegexp.MatchString(line):
+			r.renderHeading(sb, line)
+			i++
+		case hrRegexp.MatchString(line):
+			sb.WriteString("<hr>\n")
+			i++
+		case quoteRegexp.MatchString(line):
+			i = r.renderQuote(sb, lines, i)
+		case ulRegexp.MatchString(line):
+			i = r.renderList(sb, lines, i, false)
+		case olRegexp.MatchString(line):
+			i = r.renderList(sb, lines, i, true)
+		default:
+			i = r.renderParagraph(sb, lines, i)
+		}
+	}
+}
+
+func (r *renderer) renderFence(sb *strings.Builder, lines []string, start int) int {
+	m := fenceRegexp.FindStringSubmatch(lines[start])
+	fence, lang := m[1], m[2]
+	i := start + 1
+	var code []string
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+	sb.WriteString("<pre><code")
+	if languageRegexp.MatchString(lang) {
+		sb.WriteString(` class="language-`)
+		sb.WriteString(escape(lang))
+		sb.WriteString(`"`)
+	}
+	sb.WriteString(">")
+	for _, l := range code {
+		sb.WriteString(escape(l))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("</code></pre>\n")
+	return i
+}
+
+func (r *renderer) renderHeading(sb *strings.Builder, line string) {
+	m := headingRegexp.FindStringSubmatch(line)
+	level := len(m[1])
+	content := renderInline(strings.TrimSpace(m[2]))
+	title := stripTags(content)
+	id := r.uniqueId(slugify(title))
+	r.toc = append(r.toc, Heading{Level: level, Id: id, Title: title})
+	tag := "h" + strconv.Itoa(level)
+	sb.WriteString("<" + tag + ` id="` + escape(id) + `">`)
+	sb.WriteString(content)
+	sb.WriteString("</" + tag + ">\n")
+}
+
+func (r *renderer) renderQuote(sb *strings.Builder, lines []string, start int) int {
+	var inner []string
+	i := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if loc := quoteRegexp.FindStringIndex(line); loc != nil {
+			inner = append(inner, line[loc[1]:])
+			continue
+		}
+		// 引用里面的段落可以偷懒不写 >
+		if isBlank(line) || len(inner) == 0 || isBlank(inner[len(inner)-1]) || startsBlock(line) {
+			break
+		}
+		inner = append(inner, line)
+	}
+	sb.WriteString("<blockquote>\n")
+	r.renderBlocks(sb, inner)
+	sb.WriteString("</blockquote>\n")
+	return i
+}
+
+func (r *renderer) renderList(sb *strings.Builder, lines []string, start int, ordered bool) int {
+	marker := ulRegexp
+	tag := "ul"
+	if ordered {
+		marker = olRegexp
+		tag = "ol"
+	}
+	sb.WriteString("<" + tag)
+	if ordered {
+		m := olRegexp.FindStringSubmatch(lines[start])
+		if n, _ := strconv.Atoi(m[2]); n != 1 {
+			sb.WriteString(` start="` + strconv.Itoa(n) + `"`)
+		}
+	}
+	sb.WriteString(">\n")
+	i := start
+	for i < len(lines) {
+		loc := marker.FindStringIndex(lines[i])
+		if loc == nil {
+			break
+		}
+		// 列表项的内容是标记后面的部分，加上后面缩进了的行
+		item := []string{lines[i][loc[1]:]}
+		i++
+		for i < len(lines) {
+			line := lines[i]
+			if isBlank(line) {
+				// 空行后面还有缩进的内容，说明还是这一项
+				if i+1 < len(lines) && indent(lines[i+1]) >= 2 {
+					item = append(item, "")
+					i++
+					continue
+				}
+				break
+			}
+			if indent(line) >= 2 {
+				item = append(item, dedent(line, loc[1]))
+				i++
+				continue
+			}
+			if marker.MatchString(line) || startsBlock(line) {
+				break
+			}
+			// 懒惰的续行
+			item = append(item, line)
+			i++
+		}
+		r.renderItem(sb, item)
+		// 列表项之间的空行
+		for i < len(lines) && isBlank(lines[i]) && i+1 < len(lines) && marker.MatchString(lines[i+1]) {
+			i++
+		}
+	}
+	sb.WriteString("</" + tag + ">\n")
+	return i
+}
+
+func (r *renderer) renderItem(sb *strings.Builder, item []string) {
+	var inner strings.Builder
+	r.renderBlocks(&inner, item)
+	content := strings.TrimSuffix(inner.String(), "\n")
+	// 紧凑的列表项不要 <p>，只有一个段落的时候才去掉，后面可能还跟着子列表
+	if strings.HasPrefix(content, "<p>") && strings.Count(content, "<p>") == 1 {
+		content = strings.Replace(strings.TrimPrefix(content, "<p>"), "</p>", "", 1)
+	}
+	sb.WriteString("<li>")
+	sb.WriteString(content)
+	sb.WriteString("</li>\n")
+}
+
+func (r *renderer) renderParagraph(sb *strings.Builder, lines []string, start int) int {
+	i := start
+	var para []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlank(line) || (i > start && startsBlock(line)) {
+			break
+		}
+		para = append(para, line)
+	}
+	var text strings.Builder
+	for j, line := range para {
+		hardBreak := strings.HasSuffix(line, "  ")
+		text.WriteString(strings.TrimSpace(line))
+		if j == len(para)-1 {
+			break
+		}
+		if hardBreak {
+			// 行尾两个空格是强制换行
+			text.WriteString("\x00")
+		}
+		text.WriteString("\n")
+	}
+	sb.WriteString("<p>")
+	sb.WriteString(strings.ReplaceAll(renderInline(text.String()), "\x00", "<br>"))
+	sb.WriteString("</p>\n")
+	return i
+}
+
+func (r *renderer) uniqueId(id string) string {
+	cnt := r.ids[id]
+	r.ids[id] = cnt + 1
+	if cnt == 0 {
+		return id
+	}
+	return id + "-" + strconv.Itoa(cnt)
+}
+
+// slugify 标题转成锚点，保留中文之类的字母和数字，空白变成 -
+func slugify(title string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_':
+			sb.WriteRune(c)
+		case c == '-' || unicode.IsSpace(c):
+			sb.WriteRune('-')
+		}
+	}
+	res := strings.Trim(sb.String(), "-")
+	if res == "" {
+		return "section"
+	}
+	return res
+}
+
+// startsBlock 这一行是不是会打断段落
+func startsBlock(line string) bool {
+	return fenceRegexp.MatchString(line) || headingRegexp.MatchString(line) ||
+		hrRegexp.MatchString(line) || quoteRegexp.MatchString(line) ||
+		ulRegexp.MatchString(line) || olRegexp.MatchString(line)
+}
+
+func splitLines(src string) []string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\t", "    ")
+	// \x00 在渲染段落的时候用来标记强制换行
+	src = strings.ReplaceAll(src, "\x00", "")
+	return strings.Split(src, "\n")
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func indent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// dedent 最多去掉 n 个前导空格
+func dedent(line string, n int) string {
+	if i := indent(line); i < n {
+		n = i
+	}
+	return line[n:]
+}
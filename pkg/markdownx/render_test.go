@@ -0,0 +1,156 @@
+package markdownx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	testcases := []struct {
+		name     string
+		src      string
+		wantHTML string
+		wantTOC  []Heading
+		wantText string
+	}{
+		{
+			name:     "空内容",
+			src:      "",
+			wantHTML: "",
+			wantText: "",
+		},
+		{
+			name:     "标题和目录，重复的标题锚点不一样",
+			src:      "# Hello *World*\n\n## 第一节\n\n## 第一节",
+			wantHTML: "<h1 id=\"hello-world\">Hello <em>World</em></h1>\n<h2 id=\"第一节\">第一节</h2>\n<h2 id=\"第一节-1\">第一节</h2>\n",
+			wantTOC: []Heading{
+				{Level: 1, Id: "hello-world", Title: "Hello World"},
+				{Level: 2, Id: "第一节", Title: "第一节"},
+				{Level: 2, Id: "第一节-1", Title: "第一节"},
+			},
+			wantText: "Hello World 第一节 第一节",
+		},
+		{
+			name:     "段落和行内语法",
+			src:      "**粗体** `a<b` ~~删除~~ snake_case_name\\*",
+			wantHTML: "<p><strong>粗体</strong> <code>a&lt;b</code> <del>删除</del> snake_case_name*</p>\n",
+			wantText: "粗体 a<b 删除 snake_case_name*",
+		},
+		{
+			name:     "HTML 全部转义",
+			src:      "<script>alert(1)</script>\n<img src=x onerror=alert(1)>",
+			wantHTML: "<p>&lt;script&gt;alert(1)&lt;/script&gt;\n&lt;img src=x onerror=alert(1)&gt;</p>\n",
+			wantText: "<script>alert(1)</script> <img src=x onerror=alert(1)>",
+		},
+		{
+			name: "不安全的链接只保留文字",
+			src:  "[a](javascript:alert(1)) [b](JavaScript:alert(1)) ![c](data:image/png;base64,xx) [d](/articles/1) [e](https://example.com)",
+			wantHTML: "<p>a b c <a href=\"/articles/1\">d</a> " +
+				"<a href=\"https://example.com\" rel=\"nofollow noopener noreferrer\">e</a></p>\n",
+			wantText: "a b c d e",
+		},
+		{
+			name:     "代码块",
+			src:      "```go\nfmt.Println(\"<hi>\")\n```",
+			wantHTML: "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;hi&gt;&#34;)\n</code></pre>\n",
+			wantText: "fmt.Println(\"<hi>\")",
+		},
+		{
+			name:     "列表和引用",
+			src:      "- a\n- b\n  - c\n\n1. x\n2. y\n\n> 引用",
+			wantHTML: "<ul>\n<li>a</li>\n<li>b\n<ul>\n<li>c</li>\n</ul></li>\n</ul>\n<ol>\n<li>x</li>\n<li>y</li>\n</ol>\n<blockquote>\n<p>引用</p>\n</blockquote>\n",
+			wantText: "a b c x y 引用",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := Render(tc.src)
+			assert.Equal(t, tc.wantHTML, doc.HTML)
+			assert.Equal(t, tc.wantTOC, doc.TOC)
+			assert.Equal(t, tc.wantText, doc.Text)
+		})
+	}
+}
+
+// TestRender_Unclosed 大量没有闭合的行内语法，每个都扫到结尾的话是平方级别的
+func TestRender_Unclosed(t *testing.T) {
+	testcases := []struct {
+		name     string
+		src      string
+		wantText string
+	}{
+		{
+			name:     "方括号",
+			src:      strings.Repeat("[", 1<<17),
+			wantText: strings.Repeat("[", 1<<17),
+		},
+		{
+			name:     "链接文字后面没有地址",
+			src:      strings.Repeat("[a]", 1<<15),
+			wantText: strings.Repeat("[a]", 1<<15),
+		},
+		{
+			name:     "强调",
+			src:      strings.Repeat("*a _a ~~a ", 1<<14),
+			wantText: strings.TrimSpace(strings.Repeat("*a _a ~~a ", 1<<14)),
+		},
+		{
+			name:     "尖括号",
+			src:      strings.Repeat("<", 1<<17) + ">",
+			wantText: strings.Repeat("<", 1<<17) + ">",
+		},
+		{
+			name:     "反引号",
+			src:      "*a" + strings.Repeat("`", 1<<17),
+			wantText: "*a" + strings.Repeat("`", 1<<17),
+		},
+		{
+			name:     "深层嵌套",
+			src:      strings.Repeat("[", 1<<12) + "a" + strings.Repeat("](/a)", 1<<12),
+			wantText: strings.Repeat("[", 1<<12-maxInlineDepth) + "a" + strings.Repeat("](/a)", 1<<12-maxInlineDepth),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			doc := Render(tc.src)
+			assert.Less(t, time.Since(start), time.Second)
+			assert.Equal(t, tc.wantText, doc.Text)
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	testcases := []struct {
+		name string
+		src  string
+		n    int
+		want string
+	}{
+		{
+			name: "不够长",
+			src:  "# 标题\n\n**正文**",
+			n:    100,
+			want: "标题 正文",
+		},
+		{
+			name: "按字截取",
+			src:  "一二三四五",
+			n:    3,
+			want: "一二三",
+		},
+		{
+			name: "只看开头一段，不会把字切开",
+			src:  "a" + strings.Repeat("中", summarySource),
+			n:    summarySource,
+			want: "a" + strings.Repeat("中", (summarySource-1)/3),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Summary(tc.src, tc.n))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package markdownx
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// summarySource 摘要只看 Markdown 开头这么多字节
+const summarySource = 4096
+
+// PlainText 去掉 Markdown 语法，只留下文字，连续的空白合并成一个空格
+func PlainText(src string) string {
+	return Render(src).Text
+}
+
+// Summary 取开头最多 n 个字的纯文字
+// 只处理 Markdown 开头的一段，列表页上每一篇都要算，不能渲染整篇
+func Summary(src string, n int) string {
+	if len(src) > summarySource {
+		end := summarySource
+		// 不要把一个字切成两半
+		for end > 0 && !utf8.RuneStart(src[end]) {
+			end--
+		}
+		src = src[:end]
+	}
+	cs := []rune(PlainText(src))
+	if len(cs) <= n {
+		return string(cs)
+	}
+	return string(cs[:n])
+}
+
+// blockTags 这些标签前后的文字不是连在一起的，去掉的时候要换成空白
+var blockTags = map[string]struct{}{
+	"p": {}, "br": {}, "hr": {}, "li": {}, "ul": {}, "ol": {}, "pre": {}, "blockquote": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+}
+
+// stripTags 去掉 Render 生成的 HTML 里面的标签
+// 只处理我们自己生成的 HTML，所以不需要考虑标签不闭合之类的情况
+func stripTags(src string) string {
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(src, '<')
+		if start < 0 {
+			sb.WriteString(src)
+			break
+		}
+		end := strings.IndexByte(src[start:], '>')
+		if end < 0 {
+			sb.WriteString(src)
+			break
+		}
+		sb.WriteString(src[:start])
+		name := strings.TrimPrefix(src[start+1:start+end], "/")
+		if idx := strings.IndexAny(name, " \t"); idx >= 0 {
+			name = name[:idx]
+		}
+		if _, ok := blockTags[name]; ok {
+			sb.WriteString(" ")
+		}
+		src = src[start+end+1:]
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(sb.String())), " ")
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"webookpro/pkg/ossx"
+)
+
+var _ ossx.Store = (*Store)(nil)
+
+// Store 进程内的对象存储，用来做测试和本地开发，重启之后数据就没了
+type Store struct {
+	mu      sync.RWMutex
+	objects map[string][]byte
+}
+
+func NewStore() *Store {
+	return &Store{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (s *Store) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	// 复制一份，免得调用者后面改了切片的内容
+	val := make([]byte, len(data))
+	copy(val, data)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = val
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	val, ok := s.objects[key]
+	if !ok {
+		return nil, ossx.ErrObjectNotFound
+	}
+	res := make([]byte, len(val))
+	copy(res, val)
+	return res, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
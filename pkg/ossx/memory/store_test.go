@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/pkg/ossx"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	_, err := s.Get(ctx, "abc")
+	assert.Equal(t, ossx.ErrObjectNotFound, err)
+
+	data := []byte("测试内容")
+	require.NoError(t, s.Put(ctx, "abc", data, "text/plain"))
+	// 调用者改了切片，不影响已经存进去的内容
+	data[0] = 'x'
+	val, err := s.Get(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "测试内容", string(val))
+
+	// 覆盖
+	require.NoError(t, s.Put(ctx, "abc", []byte("新内容"), "text/plain"))
+	val, err = s.Get(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "新内容", string(val))
+
+	require.NoError(t, s.Delete(ctx, "abc"))
+	_, err = s.Get(ctx, "abc")
+	assert.Equal(t, ossx.ErrObjectNotFound, err)
+	// 删除不存在的对象不是错误
+	assert.NoError(t, s.Delete(ctx, "abc"))
+}
@@ -0,0 +1,62 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/ecodeclub/ekit"
+	"io"
+	"webookpro/pkg/ossx"
+)
+
+var _ ossx.Store = (*Store)(nil)
+
+// Store 兼容 S3 协议的对象存储，腾讯云 COS、阿里云 OSS、本地的 MinIO 都可以用
+type Store struct {
+	client *s3.S3
+	bucket *string
+}
+
+func NewStore(client *s3.S3, bucket string) *Store {
+	return &Store{
+		client: client,
+		bucket: ekit.ToPtr[string](bucket),
+	}
+}
+
+func (s *Store) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      s.bucket,
+		Key:         ekit.ToPtr[string](key),
+		Body:        bytes.NewReader(data),
+		ContentType: ekit.ToPtr[string](contentType),
+	})
+	return err
+}
+
+func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
+	res, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: s.bucket,
+		Key:    ekit.ToPtr[string](key),
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, ossx.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	// S3 删除不存在的对象也是成功的
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: s.bucket,
+		Key:    ekit.ToPtr[string](key),
+	})
+	return err
+}
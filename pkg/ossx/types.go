@@ -0,0 +1,20 @@
+package ossx
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrObjectNotFound 对象不存在，各个实现都要转成这个错误
+var ErrObjectNotFound = errors.New("ossx: 对象不存在")
+
+// Store 对象存储的抽象，S3、OSS、COS 这些都兼容 S3 协议
+// 测试的时候用进程内的实现，不需要真的连上云服务
+type Store interface {
+	// Put 对象已经存在的话，整体覆盖
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get 对象不存在返回 ErrObjectNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete 对象不存在也不算错误
+	Delete(ctx context.Context, key string) error
+}
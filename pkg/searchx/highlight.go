@@ -0,0 +1,94 @@
+package searchx
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	highlightPre  = "<em>"
+	highlightPost = "</em>"
+)
+
+// Highlight 把 text 里面出现的 terms 用 <em></em> 包起来，其余部分做 HTML 转义
+// width > 0 的时候只截取第一个命中位置附近 width 个字符，用来做摘要
+func Highlight(text string, terms []string, width int) string {
+	runes := []rune(strings.ReplaceAll(text, "\n", " "))
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	marks := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		tr := []rune(term)
+		if len(tr) == 0 {
+			continue
+		}
+		for i := 0; i+len(tr) <= len(lower); i++ {
+			if !runesEqual(lower[i:i+len(tr)], tr) {
+				continue
+			}
+			for j := i; j < i+len(tr); j++ {
+				marks[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start, end := 0, len(runes)
+	if width > 0 && len(runes) > width {
+		if first > 0 {
+			// 命中的位置前面留一点上下文
+			start = first - width/4
+			if start < 0 {
+				start = 0
+			}
+		}
+		end = start + width
+		if end > len(runes) {
+			end = len(runes)
+			start = end - width
+		}
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("...")
+	}
+	i := start
+	for i < end {
+		j := i
+		for j < end && marks[j] == marks[i] {
+			j++
+		}
+		seg := html.EscapeString(string(runes[i:j]))
+		if marks[i] {
+			sb.WriteString(highlightPre)
+			sb.WriteString(seg)
+			sb.WriteString(highlightPost)
+		} else {
+			sb.WriteString(seg)
+		}
+		i = j
+	}
+	if end < len(runes) {
+		sb.WriteString("...")
+	}
+	return sb.String()
+}
+
+func runesEqual(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package searchx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	testcases := []struct {
+		name  string
+		text  string
+		terms []string
+		width int
+		want  string
+	}{
+		{
+			name:  "整段高亮，相邻的命中合并",
+			text:  "学习微服务架构",
+			terms: []string{"微服", "服务"},
+			want:  "学习<em>微服务</em>架构",
+		},
+		{
+			name:  "忽略大小写，转义 HTML",
+			text:  "<b>Go</b> 并发",
+			terms: []string{"go"},
+			want:  "&lt;b&gt;<em>Go</em>&lt;/b&gt; 并发",
+		},
+		{
+			name:  "截取命中位置附近",
+			text:  "一二三四五六七八九十",
+			terms: []string{"五六"},
+			width: 4,
+			want:  "...四<em>五六</em>七...",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Highlight(tc.text, tc.terms, tc.width))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"webookpro/pkg/searchx"
+)
+
+// 标题命中的权重要比内容高
+const titleWeight = 3
+
+// 摘要的长度，按照字符算
+const snippetWidth = 80
+
+var _ searchx.Index = (*InvertedIndex)(nil)
+
+// InvertedIndex 进程内的倒排索引，数据都在内存里面，重启之后要重建
+// 多实例部署的时候，每个实例都要收到全部的变更，各自维护一份
+type InvertedIndex struct {
+	lock sync.RWMutex
+	docs map[int64]searchx.Document
+	// postings 词 => 文档 ID => 词频
+	postings map[string]map[int64]*posting
+}
+
+type posting struct {
+	titleTf   int
+	contentTf int
+}
+
+func NewInvertedIndex() *InvertedIndex {
+	return &InvertedIndex{
+		docs:     make(map[int64]searchx.Document),
+		postings: make(map[string]map[int64]*posting),
+	}
+}
+
+func (idx *InvertedIndex) Upsert(ctx context.Context, doc searchx.Document) error {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	idx.delete(doc.Id)
+	idx.docs[doc.Id] = doc
+	for _, term := range searchx.Tokenize(doc.Title) {
+		idx.posting(term, doc.Id).titleTf++
+	}
+	for _, term := range searchx.Tokenize(doc.Content) {
+		idx.posting(term, doc.Id).contentTf++
+	}
+	return nil
+}
+
+func (idx *InvertedIndex) Delete(ctx context.Context, id int64) error {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	idx.delete(id)
+	return nil
+}
+
+// Search 所有的词都要命中，按照简化版的 TF-IDF 打分
+func (idx *InvertedIndex) Search(ctx context.Context, query string, offset, limit int) ([]searchx.Hit, int, error) {
+	terms := searchx.QueryTerms(query)
+	if len(terms) == 0 {
+		return nil, 0, nil
+	}
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	lists := make([]map[int64]*posting, 0, len(terms))
+	for _, term := range terms {
+		list, ok := idx.postings[term]
+		if !ok {
+			return nil, 0, nil
+		}
+		lists = append(lists, list)
+	}
+	// 从最短的倒排列表开始求交集
+	sort.Slice(lists, func(i, j int) bool {
+		return len(lists[i]) < len(lists[j])
+	})
+	total := float64(len(idx.docs))
+	var hits []searchx.Hit
+	for id := range lists[0] {
+		score := 0.0
+		matched := true
+		for _, list := range lists {
+			p, ok := list[id]
+			if !ok {
+				matched = false
+				break
+			}
+			idf := math.Log(1 + total/float64(len(list)))
+			score += idf * (titleWeight*saturate(p.titleTf) + saturate(p.contentTf))
+		}
+		if matched {
+			hits = append(hits, searchx.Hit{Id: id, Score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		// 分数一样的，新的在前面
+		return hits[i].Id > hits[j].Id
+	})
+
+	cnt := len(hits)
+	if offset >= cnt {
+		return nil, cnt, nil
+	}
+	end := offset + limit
+	if end > cnt {
+		end = cnt
+	}
+	res := hits[offset:end]
+	for i := range res {
+		doc := idx.docs[res[i].Id]
+		res[i].Title = searchx.Highlight(doc.Title, terms, 0)
+		res[i].Snippet = searchx.Highlight(doc.Content, terms, snippetWidth)
+	}
+	return res, cnt, nil
+}
+
+func (idx *InvertedIndex) posting(term string, id int64) *posting {
+	list, ok := idx.postings[term]
+	if !ok {
+		list = make(map[int64]*posting)
+		idx.postings[term] = list
+	}
+	p, ok := list[id]
+	if !ok {
+		p = &posting{}
+		list[id] = p
+	}
+	return p
+}
+
+// delete 调用者要持有写锁
+func (idx *InvertedIndex) delete(id int64) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	delete(idx.docs, id)
+	terms := append(searchx.Tokenize(doc.Title), searchx.Tokenize(doc.Content)...)
+	for _, term := range terms {
+		list, ok := idx.postings[term]
+		if !ok {
+			continue
+		}
+		delete(list, id)
+		if len(list) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+}
+
+// saturate 词频的收益是递减的，出现十次不应该比出现一次重要十倍
+func saturate(tf int) float64 {
+	if tf == 0 {
+		return 0
+	}
+	f := float64(tf)
+	return f / (f + 1.2)
+}
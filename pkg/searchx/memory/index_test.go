@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"webookpro/pkg/searchx"
+)
+
+func TestInvertedIndex_Search(t *testing.T) {
+	ctx := context.Background()
+	idx := NewInvertedIndex()
+	require.NoError(t, idx.Upsert(ctx, searchx.Document{Id: 1, Title: "微服务入门", Content: "介绍服务治理"}))
+	require.NoError(t, idx.Upsert(ctx, searchx.Document{Id: 2, Title: "Go 并发", Content: "用 Go 写微服务"}))
+	require.NoError(t, idx.Upsert(ctx, searchx.Document{Id: 3, Title: "MySQL 索引", Content: "B+ 树"}))
+
+	hits, total, err := idx.Search(ctx, "微服务", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	// 标题命中的排在前面
+	assert.Equal(t, int64(1), hits[0].Id)
+	assert.Equal(t, "<em>微服务</em>入门", hits[0].Title)
+	assert.Equal(t, int64(2), hits[1].Id)
+	assert.Equal(t, "用 Go 写<em>微服务</em>", hits[1].Snippet)
+
+	// 所有的词都要命中
+	_, total, err = idx.Search(ctx, "微服务 MySQL", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+
+	// 更新之后旧的内容就搜不到了
+	require.NoError(t, idx.Upsert(ctx, searchx.Document{Id: 2, Title: "Go 并发", Content: "channel"}))
+	_, total, err = idx.Search(ctx, "微服务", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+
+	require.NoError(t, idx.Delete(ctx, 1))
+	hits, total, err = idx.Search(ctx, "微服务", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+	assert.Empty(t, hits)
+	assert.NotContains(t, idx.postings, "微服")
+}
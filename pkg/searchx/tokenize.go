@@ -0,0 +1,83 @@
+package searchx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenize 建索引用的分词
+// 中文没有空格，所以按照相邻两个字切分（bigram），另外还会保留单个字，这样搜一个字也能搜到
+// 英文和数字按照单词切分，统一转成小写
+func Tokenize(text string) []string {
+	return tokenize(text, true)
+}
+
+// QueryTerms 搜索用的分词，和 Tokenize 的区别是中文只有一个字的时候才会用单字
+func QueryTerms(query string) []string {
+	terms := tokenize(query, false)
+	// 搜索词里面重复的词没有意义
+	res := make([]string, 0, len(terms))
+	seen := make(map[string]struct{}, len(terms))
+	for _, t := range terms {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	return res
+}
+
+func tokenize(text string, withUnigram bool) []string {
+	var (
+		res  []string
+		cjk  []rune
+		word strings.Builder
+	)
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			res = append(res, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i < len(cjk); i++ {
+				if withUnigram {
+					res = append(res, string(cjk[i]))
+				}
+				if i+1 < len(cjk) {
+					res = append(res, string(cjk[i:i+2]))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+	flushWord := func() {
+		if word.Len() > 0 {
+			res = append(res, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word.WriteRune(r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+	return res
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,39 @@
+package searchx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	testcases := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "中文按照 bigram 切分，保留单字",
+			text: "微服务",
+			want: []string{"微", "微服", "服", "服务", "务"},
+		},
+		{
+			name: "中英文混合",
+			text: "Go语言, MySQL 8",
+			want: []string{"go", "语", "语言", "言", "mysql", "8"},
+		},
+		{
+			name: "只有标点",
+			text: "，。! ",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Tokenize(tc.text))
+		})
+	}
+}
+
+func TestQueryTerms(t *testing.T) {
+	assert.Equal(t, []string{"微服", "服务", "go"}, QueryTerms("微服务 Go go"))
+	assert.Equal(t, []string{"库"}, QueryTerms("库"))
+}
@@ -0,0 +1,27 @@
+package searchx
+
+import "context"
+
+// Index 全文索引的抽象，进程内的倒排索引只是其中一种实现
+// 后面换成 Elasticsearch 之类的，只需要再实现一遍这个接口
+type Index interface {
+	// Upsert 文档已经存在的话，整体替换
+	Upsert(ctx context.Context, doc Document) error
+	Delete(ctx context.Context, id int64) error
+	// Search 返回按照相关度排序之后的第 offset 到 offset+limit 条，以及命中的总数
+	Search(ctx context.Context, query string, offset, limit int) ([]Hit, int, error)
+}
+
+type Document struct {
+	Id      int64
+	Title   string
+	Content string
+}
+
+type Hit struct {
+	Id    int64
+	Score float64
+	// Title 和 Snippet 里面命中的部分都用 <em></em> 包起来了，其余部分做了 HTML 转义
+	Title   string
+	Snippet string
+}
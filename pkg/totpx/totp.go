@@ -0,0 +1,92 @@
+package totpx
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits 验证码的位数，认证器 App 默认都是 6 位
+	Digits = 6
+	// Period 每个验证码的有效时间
+	Period = 30 * time.Second
+	// secretSize RFC 4226 建议至少 160 位
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret 随机生成一个密钥，base32 编码，认证器 App 里面手动输入的就是它
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretSize)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// URI 生成 otpauth:// 链接，前端转成二维码给认证器 App 扫
+func URI(issuer, account, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period.Seconds())))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Code 计算 t 所在时间窗口的验证码，算法见 RFC 6238
+func Code(secret string, t time.Time) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+	return code(key, uint64(t.Unix())/uint64(Period.Seconds())), nil
+}
+
+// Validate 前后各容忍一个时间窗口，手机和服务器的时钟差个几十秒也能用
+func Validate(secret, passcode string, t time.Time) bool {
+	_, ok := Match(secret, passcode, t)
+	return ok
+}
+
+// Match 和 Validate 一样，同时返回对上的是哪个时间窗口
+// 调用方记下用过的窗口，同一个验证码在容忍的时间里面就不能再用第二次
+func Match(secret, passcode string, t time.Time) (uint64, bool) {
+	if len(passcode) != Digits {
+		return 0, false
+	}
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	counter := uint64(t.Unix()) / uint64(Period.Seconds())
+	for _, c := range []uint64{counter - 1, counter, counter + 1} {
+		if hmac.Equal([]byte(code(key, c)), []byte(passcode)) {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func code(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	// 动态截断，取最后一个字节的低 4 位作为偏移量
+	offset := sum[len(sum)-1] & 0x0f
+	val := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, val%1000000)
+}
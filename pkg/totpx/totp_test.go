@@ -0,0 +1,58 @@
+package totpx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// rfcSecret RFC 6238 附录 B 里面 SHA1 用的密钥 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	testcases := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		// RFC 里面是 8 位的，取后 6 位
+		{name: "59", t: time.Unix(59, 0), want: "287082"},
+		{name: "1111111109", t: time.Unix(1111111109, 0), want: "081804"},
+		{name: "1234567890", t: time.Unix(1234567890, 0), want: "005924"},
+		{name: "2000000000", t: time.Unix(2000000000, 0), want: "279037"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, tc.t)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, code)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	assert.True(t, Validate(rfcSecret, "081804", now))
+	// 前后一个窗口都可以
+	assert.True(t, Validate(rfcSecret, "081804", now.Add(Period)))
+	assert.True(t, Validate(rfcSecret, "081804", now.Add(-Period)))
+	assert.False(t, Validate(rfcSecret, "081804", now.Add(Period*2)))
+	assert.False(t, Validate(rfcSecret, "000000", now))
+	assert.False(t, Validate(rfcSecret, "81804", now))
+}
+
+func TestMatch(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	counter, ok := Match(rfcSecret, "081804", now.Add(Period))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1111111109/30), counter)
+	_, ok = Match(rfcSecret, "000000", now)
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	assert.Equal(t,
+		"otpauth://totp/webook:123@qq.com?algorithm=SHA1&digits=6&issuer=webook&period=30&secret=ABC",
+		URI("webook", "123@qq.com", "ABC"))
+}
@@ -10,6 +10,7 @@ import (
 	dao2 "webookpro/interactive/repository/dao"
 	service2 "webookpro/interactive/service"
 	"webookpro/internal/events/article"
+	"webookpro/internal/events/search"
 	"webookpro/internal/ioc"
 	"webookpro/internal/repository"
 	article3 "webookpro/internal/repository/article"
@@ -18,7 +19,6 @@ import (
 	article2 "webookpro/internal/repository/dao/article"
 	"webookpro/internal/service"
 	"webookpro/internal/web"
-	ijwt "webookpro/internal/web/jwt"
 )
 
 func InitWebServer() *App {
@@ -26,10 +26,14 @@ func InitWebServer() *App {
 		// 第三方依赖
 		ioc.InitDB, ioc.InitRDB, ioc.InitRLockClient, ioc.InitLogger,
 		ioc.InitKafka, ioc.NewConsumers, ioc.NewSyncProducer,
+		ioc.InitOSS,
 		// 初始化job
 		ioc.InitJobs, ioc.InitRankingJob,
+		ioc.InitScheduler, ioc.InitLocalFuncExecutor, ioc.InitJobService,
 		// consumers
 		events.NewInteractiveReadEventBatchConsumer,
+		search.NewArticleIndexConsumer,
+		article.NewHistoryReadEventConsumer,
 		// grpc
 		ioc.InitIntrGRPCClient,
 		// producers
@@ -37,7 +41,19 @@ func InitWebServer() *App {
 		// dao 层
 		dao.NewGormUserDAO,
 		dao2.NewGORMInteractiveDAO,
-		article2.NewGORMArticleDAO,
+		ioc.InitArticleDAO,
+		dao.NewGORMJobDAO,
+		dao.NewGORMCommentDAO,
+		dao.NewGORMHistoryRecordDAO,
+		dao.NewGORMAttachmentDAO,
+		article2.NewGORMSeriesDAO,
+		article2.NewGORMShareDAO,
+		article2.NewGORMCollaboratorDAO,
+		article2.NewGORMExportDAO,
+		article2.NewGORMReviewDAO,
+		dao.NewGORMAccountMergeDAO,
+		dao.NewGORMAccountDAO,
+		dao.NewGORMTwoFactorDAO,
 		// cache 层
 		cache.NewRedisCodeCache,
 		cache.NewRedisUserCache,
@@ -45,24 +61,78 @@ func InitWebServer() *App {
 		cache.NewRedisArticleCache,
 		cache.NewRedisRankingCache,
 		cache.NewRankingLocalCache,
+		cache.NewRedisCommentCache,
+		cache.NewRedisSeriesCache,
+		cache.NewRedisFeedCache,
+		cache.NewRedisPasswordResetCache,
+		cache.NewRedisAccountMergeCache,
+		cache.NewRedisAccountCache,
+		cache.NewRedisTwoFactorCache,
 		// repo层
 		repository.NewCachedUserRepository,
 		repository.NewCachedCodeRepository,
 		repository2.NewCachedIntrRepository,
 		repository.NewCachedRankingRepository,
 		article3.NewCachedArticleRepository,
+		repository.NewCronJobRepository,
+		repository.NewCachedCommentRepository,
+		repository.NewHistoryRecordRepository,
+		repository.NewAttachmentRepository,
+		article3.NewCachedSeriesRepository,
+		article3.NewGORMShareRepository,
+		article3.NewCachedCollaboratorRepository,
+		article3.NewCachedFeedRepository,
+		article3.NewGORMExportRepository,
+		article3.NewGORMReviewRepository,
+		repository.NewCachedPasswordResetRepository,
+		repository.NewCachedAccountMergeRepository,
+		repository.NewCachedAccountRepository,
+		repository.NewCachedTwoFactorRepository,
 		// service 层
 		service.NewUserService,
 		service.NewSMSCodeService,
 		service.NewArticleService,
 		service2.NewInteractiveService,
 		service.NewBatchRankingService,
+		service.NewCommentService,
+		service.NewHistoryService,
+		service.NewAttachmentService,
+		service.NewSeriesService,
+		service.NewShareService,
+		service.NewCollaboratorService,
+		service.NewArchiveService,
+		service.NewReviewService,
+		service.NewPasswordResetService,
+		service.NewBindingService,
+		service.NewAccountMergeService,
+		service.NewAccountService,
+		service.NewTOTPService,
+		ioc.InitModerator,
+		ioc.InitSearchIndex, ioc.InitSearchService,
+		ioc.InitFeedService,
 		ioc.InitSMSService, ioc.InitWechatService,
+		ioc.InitEmailService,
 		// handlers
 		web.NewUserHandler,
 		web.NewOAuth2WechatHandler,
-		ijwt.NewRedisJWTHandler,
+		ioc.InitJWTHandler,
 		web.NewArticleHandler,
+		web.NewSearchHandler,
+		web.NewCommentHandler,
+		web.NewHistoryHandler,
+		web.NewAttachmentHandler,
+		web.NewSeriesHandler,
+		web.NewShareHandler,
+		web.NewCollaboratorHandler,
+		web.NewFeedHandler,
+		web.NewArchiveHandler,
+		web.NewReviewHandler,
+		web.NewPasswordHandler,
+		web.NewBindingHandler,
+		web.NewAccountMergeHandler,
+		web.NewAccountHandler,
+		web.NewTwoFactorHandler,
+		web.NewSessionHandler,
 		// middlewares
 		ioc.InitMiddlewares,
 		ioc.InitWebServer,
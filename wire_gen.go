@@ -13,6 +13,7 @@ import (
 	dao2 "webookpro/interactive/repository/dao"
 	service2 "webookpro/interactive/service"
 	article3 "webookpro/internal/events/article"
+	"webookpro/internal/events/search"
 	"webookpro/internal/ioc"
 	"webookpro/internal/repository"
 	article2 "webookpro/internal/repository/article"
@@ -21,7 +22,6 @@ import (
 	"webookpro/internal/repository/dao/article"
 	"webookpro/internal/service"
 	"webookpro/internal/web"
-	"webookpro/internal/web/jwt"
 )
 
 // Injectors from wire.go:
@@ -29,7 +29,7 @@ import (
 func InitWebServer() *App {
 	cmdable := ioc.InitRDB()
 	limiter := ioc.InitLimiter(cmdable)
-	jwtHandler := jwt.NewRedisJWTHandler(cmdable)
+	jwtHandler := ioc.InitJWTHandler(cmdable)
 	logger := ioc.InitLogger()
 	v := ioc.InitMiddlewares(limiter, jwtHandler, logger)
 	db := ioc.InitDB(logger)
@@ -41,25 +41,93 @@ func InitWebServer() *App {
 	codeRepository := repository.NewCachedCodeRepository(codeCache)
 	smsService := ioc.InitSMSService()
 	codeService := service.NewSMSCodeService(codeRepository, smsService)
-	userHandler := web.NewUserHandler(userService, codeService, jwtHandler)
-	oauth2Service := ioc.InitWechatService()
-	oAuth2WechatHandler := web.NewOAuth2WechatHandler(oauth2Service, userService, jwtHandler)
-	articleDAO := article.NewGORMArticleDAO(db)
+	accountDAO := dao.NewGORMAccountDAO(db)
+	accountCache := cache.NewRedisAccountCache(cmdable)
+	accountRepository := repository.NewCachedAccountRepository(accountDAO, accountCache, userCache)
+	store := ioc.InitOSS()
+	articleDAO := ioc.InitArticleDAO(db, store)
 	articleCache := cache.NewRedisArticleCache(cmdable)
-	articleRepository := article2.NewCachedArticleRepository(articleDAO, articleCache, userRepository, logger)
-	client := ioc.InitKafka()
-	syncProducer := ioc.NewSyncProducer(client)
-	producer := article3.NewKafkaProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, producer, logger)
+	feedCache := cache.NewRedisFeedCache(cmdable)
+	articleRepository := article2.NewCachedArticleRepository(articleDAO, articleCache, feedCache, userRepository, logger)
+	historyRecordDAO := dao.NewGORMHistoryRecordDAO(db)
+	historyRecordRepository := repository.NewHistoryRecordRepository(historyRecordDAO)
+	historyService := service.NewHistoryService(historyRecordRepository, articleRepository, logger)
 	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
 	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
 	interactiveRepository := repository2.NewCachedIntrRepository(interactiveDAO, interactiveCache, logger)
 	interactiveService := service2.NewInteractiveService(interactiveRepository, logger)
 	interactiveServiceClient := ioc.InitIntrGRPCClient(interactiveService)
-	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, logger)
-	engine := ioc.InitWebServer(v, userHandler, oAuth2WechatHandler, articleHandler)
+	client := ioc.InitKafka()
+	syncProducer := ioc.NewSyncProducer(client)
+	producer := article3.NewKafkaProducer(syncProducer)
+	accountService := service.NewAccountService(accountRepository, userRepository, articleRepository, historyService, interactiveServiceClient, producer, logger)
+	twoFactorDAO := dao.NewGORMTwoFactorDAO(db)
+	twoFactorCache := cache.NewRedisTwoFactorCache(cmdable)
+	twoFactorRepository := repository.NewCachedTwoFactorRepository(twoFactorDAO, twoFactorCache)
+	twoFactorService := service.NewTOTPService(twoFactorRepository, userRepository, logger)
+	userHandler := web.NewUserHandler(userService, codeService, accountService, twoFactorService, jwtHandler)
+	oauth2Service := ioc.InitWechatService()
+	emailService := ioc.InitEmailService(logger)
+	bindingService := service.NewBindingService(userRepository, codeRepository, codeService, emailService, logger)
+	oAuth2WechatHandler := web.NewOAuth2WechatHandler(oauth2Service, userService, bindingService, accountService, jwtHandler)
+	reviewDAO := article.NewGORMReviewDAO(db)
+	reviewRepository := article2.NewGORMReviewRepository(reviewDAO)
+	checker := ioc.InitModerator(logger)
+	articleService := service.NewArticleService(articleRepository, reviewRepository, checker, producer, interactiveServiceClient, logger)
+	attachmentDAO := dao.NewGORMAttachmentDAO(db)
+	attachmentRepository := repository.NewAttachmentRepository(attachmentDAO)
+	attachmentService := service.NewAttachmentService(attachmentRepository, store, logger)
+	seriesDAO := article.NewGORMSeriesDAO(db)
+	seriesCache := cache.NewRedisSeriesCache(cmdable)
+	seriesRepository := article2.NewCachedSeriesRepository(seriesDAO, seriesCache, logger)
+	seriesService := service.NewSeriesService(seriesRepository, articleRepository)
+	collaboratorDAO := article.NewGORMCollaboratorDAO(db)
+	collaboratorRepository := article2.NewCachedCollaboratorRepository(collaboratorDAO, articleCache, logger)
+	collaboratorService := service.NewCollaboratorService(collaboratorRepository, articleRepository)
+	articleHandler := web.NewArticleHandler(articleService, attachmentService, seriesService, collaboratorService, interactiveServiceClient, logger)
+	index := ioc.InitSearchIndex()
+	searchService := ioc.InitSearchService(index, articleRepository, logger)
+	searchHandler := web.NewSearchHandler(searchService, logger)
+	commentDAO := dao.NewGORMCommentDAO(db)
+	commentCache := cache.NewRedisCommentCache(cmdable)
+	commentRepository := repository.NewCachedCommentRepository(commentDAO, commentCache, logger)
+	commentService := service.NewCommentService(commentRepository, articleRepository, interactiveServiceClient, logger)
+	commentHandler := web.NewCommentHandler(commentService, logger)
+	historyHandler := web.NewHistoryHandler(historyService, logger)
+	attachmentHandler := web.NewAttachmentHandler(attachmentService, logger)
+	seriesHandler := web.NewSeriesHandler(seriesService, logger)
+	shareDAO := article.NewGORMShareDAO(db)
+	shareRepository := article2.NewGORMShareRepository(shareDAO)
+	shareService := service.NewShareService(shareRepository, articleRepository)
+	shareHandler := web.NewShareHandler(shareService, logger)
+	collaboratorHandler := web.NewCollaboratorHandler(collaboratorService, logger)
+	feedRepository := article2.NewCachedFeedRepository(feedCache)
+	feedService := ioc.InitFeedService(feedRepository, articleRepository, logger)
+	feedHandler := web.NewFeedHandler(feedService, logger)
+	exportDAO := article.NewGORMExportDAO(db)
+	exportRepository := article2.NewGORMExportRepository(exportDAO)
+	archiveService := service.NewArchiveService(exportRepository, articleRepository, attachmentService, store, logger)
+	archiveHandler := web.NewArchiveHandler(archiveService, logger)
+	reviewService := service.NewReviewService(reviewRepository, articleRepository, userRepository, producer, logger)
+	reviewHandler := web.NewReviewHandler(reviewService, logger)
+	passwordResetCache := cache.NewRedisPasswordResetCache(cmdable)
+	passwordResetRepository := repository.NewCachedPasswordResetRepository(passwordResetCache)
+	passwordResetService := service.NewPasswordResetService(userRepository, codeRepository, passwordResetRepository, emailService, logger)
+	passwordHandler := web.NewPasswordHandler(passwordResetService, jwtHandler, logger)
+	bindingHandler := web.NewBindingHandler(bindingService, logger)
+	accountMergeDAO := dao.NewGORMAccountMergeDAO(db)
+	accountMergeCache := cache.NewRedisAccountMergeCache(cmdable)
+	accountMergeRepository := repository.NewCachedAccountMergeRepository(accountMergeDAO, accountMergeCache, userCache, articleCache)
+	accountMergeService := service.NewAccountMergeService(accountMergeRepository, interactiveServiceClient, logger)
+	accountMergeHandler := web.NewAccountMergeHandler(accountMergeService, jwtHandler, logger)
+	accountHandler := web.NewAccountHandler(accountService, jwtHandler, logger)
+	twoFactorHandler := web.NewTwoFactorHandler(twoFactorService, accountService, jwtHandler, logger)
+	sessionHandler := web.NewSessionHandler(jwtHandler, logger)
+	engine := ioc.InitWebServer(v, userHandler, oAuth2WechatHandler, articleHandler, searchHandler, commentHandler, historyHandler, attachmentHandler, seriesHandler, shareHandler, collaboratorHandler, feedHandler, archiveHandler, reviewHandler, passwordHandler, bindingHandler, accountMergeHandler, accountHandler, twoFactorHandler, sessionHandler)
 	interactiveReadEventBatchConsumer := events.NewInteractiveReadEventBatchConsumer(client, interactiveRepository, logger)
-	v2 := ioc.NewConsumers(interactiveReadEventBatchConsumer)
+	articleIndexConsumer := search.NewArticleIndexConsumer(client, searchService, logger)
+	historyReadEventConsumer := article3.NewHistoryReadEventConsumer(client, historyRecordRepository, logger)
+	v2 := ioc.NewConsumers(interactiveReadEventBatchConsumer, articleIndexConsumer, historyReadEventConsumer)
 	rankingRedisCache := cache.NewRedisRankingCache(cmdable)
 	rankingLocalCache := cache.NewRankingLocalCache()
 	rankingRepository := repository.NewCachedRankingRepository(rankingRedisCache, rankingLocalCache)
@@ -67,10 +135,16 @@ func InitWebServer() *App {
 	rlockClient := ioc.InitRLockClient(cmdable)
 	rankingJob := ioc.InitRankingJob(rankingService, rlockClient, logger)
 	cron := ioc.InitJobs(logger, rankingJob)
+	localFuncExecutor := ioc.InitLocalFuncExecutor(rankingService, articleService, attachmentService, archiveService, accountMergeService, accountService, jwtHandler)
+	jobDAO := dao.NewGORMJobDAO(db)
+	jobRepository := repository.NewCronJobRepository(jobDAO)
+	jobService := ioc.InitJobService(jobRepository, logger)
+	scheduler := ioc.InitScheduler(logger, localFuncExecutor, jobService)
 	app := &App{
 		web:       engine,
 		consumers: v2,
 		cron:      cron,
+		scheduler: scheduler,
 	}
 	return app
 }